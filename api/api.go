@@ -9,9 +9,17 @@ import (
 )
 
 //go:embed neon.proto
-var proto []byte
+var protoFile []byte
+
+//go:embed neon.swagger.json
+var openAPIFile []byte
 
 // Proto() returns the proto file that describes the server interface.
 func Proto() []byte {
-	return proto
+	return protoFile
+}
+
+// OpenAPI() returns the OpenAPI document that describes the HTTP/JSON interface of the server.
+func OpenAPI() []byte {
+	return openAPIFile
 }
@@ -12,8 +12,10 @@
 package api
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -26,6 +28,331 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Rule_Field int32
+
+const (
+	Rule_FIELD_UNSPECIFIED Rule_Field = 0
+	Rule_FIELD_TITLE       Rule_Field = 1
+	// Matches either the description or the content of entries.
+	Rule_FIELD_CONTENT Rule_Field = 2
+	// Matches the name of any of the entry authors.
+	Rule_FIELD_AUTHOR Rule_Field = 3
+	Rule_FIELD_URL    Rule_Field = 4
+)
+
+// Enum value maps for Rule_Field.
+var (
+	Rule_Field_name = map[int32]string{
+		0: "FIELD_UNSPECIFIED",
+		1: "FIELD_TITLE",
+		2: "FIELD_CONTENT",
+		3: "FIELD_AUTHOR",
+		4: "FIELD_URL",
+	}
+	Rule_Field_value = map[string]int32{
+		"FIELD_UNSPECIFIED": 0,
+		"FIELD_TITLE":       1,
+		"FIELD_CONTENT":     2,
+		"FIELD_AUTHOR":      3,
+		"FIELD_URL":         4,
+	}
+)
+
+func (x Rule_Field) Enum() *Rule_Field {
+	p := new(Rule_Field)
+	*p = x
+	return p
+}
+
+func (x Rule_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_neon_proto_enumTypes[0].Descriptor()
+}
+
+func (Rule_Field) Type() protoreflect.EnumType {
+	return &file_neon_proto_enumTypes[0]
+}
+
+func (x Rule_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_Field.Descriptor instead.
+func (Rule_Field) EnumDescriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type Rule_MatchType int32
+
+const (
+	Rule_MATCH_TYPE_UNSPECIFIED Rule_MatchType = 0
+	// Case-insensitive substring match.
+	Rule_MATCH_TYPE_SUBSTRING Rule_MatchType = 1
+	// Regular expression match, using the RE2 syntax.
+	Rule_MATCH_TYPE_REGEX Rule_MatchType = 2
+)
+
+// Enum value maps for Rule_MatchType.
+var (
+	Rule_MatchType_name = map[int32]string{
+		0: "MATCH_TYPE_UNSPECIFIED",
+		1: "MATCH_TYPE_SUBSTRING",
+		2: "MATCH_TYPE_REGEX",
+	}
+	Rule_MatchType_value = map[string]int32{
+		"MATCH_TYPE_UNSPECIFIED": 0,
+		"MATCH_TYPE_SUBSTRING":   1,
+		"MATCH_TYPE_REGEX":       2,
+	}
+)
+
+func (x Rule_MatchType) Enum() *Rule_MatchType {
+	p := new(Rule_MatchType)
+	*p = x
+	return p
+}
+
+func (x Rule_MatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_MatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_neon_proto_enumTypes[1].Descriptor()
+}
+
+func (Rule_MatchType) Type() protoreflect.EnumType {
+	return &file_neon_proto_enumTypes[1]
+}
+
+func (x Rule_MatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_MatchType.Descriptor instead.
+func (Rule_MatchType) EnumDescriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{3, 1}
+}
+
+type Rule_Action int32
+
+const (
+	Rule_ACTION_UNSPECIFIED Rule_Action = 0
+	Rule_ACTION_MARK_READ   Rule_Action = 1
+	Rule_ACTION_BOOKMARK    Rule_Action = 2
+	Rule_ACTION_TAG         Rule_Action = 3
+	// Drops matching entries, so that they are never added.
+	Rule_ACTION_DROP Rule_Action = 4
+)
+
+// Enum value maps for Rule_Action.
+var (
+	Rule_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ACTION_MARK_READ",
+		2: "ACTION_BOOKMARK",
+		3: "ACTION_TAG",
+		4: "ACTION_DROP",
+	}
+	Rule_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ACTION_MARK_READ":   1,
+		"ACTION_BOOKMARK":    2,
+		"ACTION_TAG":         3,
+		"ACTION_DROP":        4,
+	}
+)
+
+func (x Rule_Action) Enum() *Rule_Action {
+	p := new(Rule_Action)
+	*p = x
+	return p
+}
+
+func (x Rule_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_neon_proto_enumTypes[2].Descriptor()
+}
+
+func (Rule_Action) Type() protoreflect.EnumType {
+	return &file_neon_proto_enumTypes[2]
+}
+
+func (x Rule_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_Action.Descriptor instead.
+func (Rule_Action) EnumDescriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{3, 2}
+}
+
+type ListFeedsRequest_SortOrder int32
+
+const (
+	ListFeedsRequest_SORT_ORDER_UPDATE_TIME_DESC ListFeedsRequest_SortOrder = 0
+	ListFeedsRequest_SORT_ORDER_UPDATE_TIME_ASC  ListFeedsRequest_SortOrder = 1
+	ListFeedsRequest_SORT_ORDER_TITLE_ASC        ListFeedsRequest_SortOrder = 2
+	ListFeedsRequest_SORT_ORDER_TITLE_DESC       ListFeedsRequest_SortOrder = 3
+)
+
+// Enum value maps for ListFeedsRequest_SortOrder.
+var (
+	ListFeedsRequest_SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UPDATE_TIME_DESC",
+		1: "SORT_ORDER_UPDATE_TIME_ASC",
+		2: "SORT_ORDER_TITLE_ASC",
+		3: "SORT_ORDER_TITLE_DESC",
+	}
+	ListFeedsRequest_SortOrder_value = map[string]int32{
+		"SORT_ORDER_UPDATE_TIME_DESC": 0,
+		"SORT_ORDER_UPDATE_TIME_ASC":  1,
+		"SORT_ORDER_TITLE_ASC":        2,
+		"SORT_ORDER_TITLE_DESC":       3,
+	}
+)
+
+func (x ListFeedsRequest_SortOrder) Enum() *ListFeedsRequest_SortOrder {
+	p := new(ListFeedsRequest_SortOrder)
+	*p = x
+	return p
+}
+
+func (x ListFeedsRequest_SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListFeedsRequest_SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_neon_proto_enumTypes[3].Descriptor()
+}
+
+func (ListFeedsRequest_SortOrder) Type() protoreflect.EnumType {
+	return &file_neon_proto_enumTypes[3]
+}
+
+func (x ListFeedsRequest_SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListFeedsRequest_SortOrder.Descriptor instead.
+func (ListFeedsRequest_SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type PullFeedsResponse_PullStatus int32
+
+const (
+	PullFeedsResponse_PULL_STATUS_UNSPECIFIED PullFeedsResponse_PullStatus = 0
+	PullFeedsResponse_PULL_STATUS_SUCCESS     PullFeedsResponse_PullStatus = 1
+	PullFeedsResponse_PULL_STATUS_FAIL        PullFeedsResponse_PullStatus = 2
+	// The feed source reported no changes since the previous pull.
+	PullFeedsResponse_PULL_STATUS_NOT_MODIFIED PullFeedsResponse_PullStatus = 3
+	// The feed was not pulled because it is backing off after failed pulls.
+	PullFeedsResponse_PULL_STATUS_SKIPPED PullFeedsResponse_PullStatus = 4
+)
+
+// Enum value maps for PullFeedsResponse_PullStatus.
+var (
+	PullFeedsResponse_PullStatus_name = map[int32]string{
+		0: "PULL_STATUS_UNSPECIFIED",
+		1: "PULL_STATUS_SUCCESS",
+		2: "PULL_STATUS_FAIL",
+		3: "PULL_STATUS_NOT_MODIFIED",
+		4: "PULL_STATUS_SKIPPED",
+	}
+	PullFeedsResponse_PullStatus_value = map[string]int32{
+		"PULL_STATUS_UNSPECIFIED":  0,
+		"PULL_STATUS_SUCCESS":      1,
+		"PULL_STATUS_FAIL":         2,
+		"PULL_STATUS_NOT_MODIFIED": 3,
+		"PULL_STATUS_SKIPPED":      4,
+	}
+)
+
+func (x PullFeedsResponse_PullStatus) Enum() *PullFeedsResponse_PullStatus {
+	p := new(PullFeedsResponse_PullStatus)
+	*p = x
+	return p
+}
+
+func (x PullFeedsResponse_PullStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullFeedsResponse_PullStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_neon_proto_enumTypes[4].Descriptor()
+}
+
+func (PullFeedsResponse_PullStatus) Type() protoreflect.EnumType {
+	return &file_neon_proto_enumTypes[4]
+}
+
+func (x PullFeedsResponse_PullStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullFeedsResponse_PullStatus.Descriptor instead.
+func (PullFeedsResponse_PullStatus) EnumDescriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type ListEntriesRequest_SortOrder int32
+
+const (
+	ListEntriesRequest_SORT_ORDER_UPDATE_TIME_DESC ListEntriesRequest_SortOrder = 0
+	ListEntriesRequest_SORT_ORDER_UPDATE_TIME_ASC  ListEntriesRequest_SortOrder = 1
+	ListEntriesRequest_SORT_ORDER_PUB_TIME_DESC    ListEntriesRequest_SortOrder = 2
+	ListEntriesRequest_SORT_ORDER_PUB_TIME_ASC     ListEntriesRequest_SortOrder = 3
+)
+
+// Enum value maps for ListEntriesRequest_SortOrder.
+var (
+	ListEntriesRequest_SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UPDATE_TIME_DESC",
+		1: "SORT_ORDER_UPDATE_TIME_ASC",
+		2: "SORT_ORDER_PUB_TIME_DESC",
+		3: "SORT_ORDER_PUB_TIME_ASC",
+	}
+	ListEntriesRequest_SortOrder_value = map[string]int32{
+		"SORT_ORDER_UPDATE_TIME_DESC": 0,
+		"SORT_ORDER_UPDATE_TIME_ASC":  1,
+		"SORT_ORDER_PUB_TIME_DESC":    2,
+		"SORT_ORDER_PUB_TIME_ASC":     3,
+	}
+)
+
+func (x ListEntriesRequest_SortOrder) Enum() *ListEntriesRequest_SortOrder {
+	p := new(ListEntriesRequest_SortOrder)
+	*p = x
+	return p
+}
+
+func (x ListEntriesRequest_SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListEntriesRequest_SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_neon_proto_enumTypes[5].Descriptor()
+}
+
+func (ListEntriesRequest_SortOrder) Type() protoreflect.EnumType {
+	return &file_neon_proto_enumTypes[5]
+}
+
+func (x ListEntriesRequest_SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListEntriesRequest_SortOrder.Descriptor instead.
+func (ListEntriesRequest_SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{32, 0}
+}
+
 type Feed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -41,7 +368,19 @@ type Feed struct {
 	SubTime      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sub_time,json=subTime,proto3" json:"sub_time,omitempty"`
 	LastPullTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_pull_time,json=lastPullTime,proto3" json:"last_pull_time,omitempty"`
 	IsStarred    bool                   `protobuf:"varint,10,opt,name=is_starred,json=isStarred,proto3" json:"is_starred,omitempty"`
-	Entries      []*Entry               `protobuf:"bytes,15,rep,name=entries,proto3" json:"entries,omitempty"`
+	// ID of the folder containing the feed, if any.
+	FolderId *uint32 `protobuf:"varint,11,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	// Whether the full content of new entries is fetched from their URL when the
+	// feed is pulled.
+	FetchContent bool `protobuf:"varint,12,opt,name=fetch_content,json=fetchContent,proto3" json:"fetch_content,omitempty"`
+	// Number of consecutive failed pulls of the feed.
+	PullFailures uint32 `protobuf:"varint,13,opt,name=pull_failures,json=pullFailures,proto3" json:"pull_failures,omitempty"`
+	// Error message of the latest failed pull, if the feed is failing.
+	LastPullError *string  `protobuf:"bytes,14,opt,name=last_pull_error,json=lastPullError,proto3,oneof" json:"last_pull_error,omitempty"`
+	Entries       []*Entry `protobuf:"bytes,15,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Earliest time the feed is pulled again, if the feed is backing off after
+	// failed pulls.
+	NextPullTime *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=next_pull_time,json=nextPullTime,proto3,oneof" json:"next_pull_time,omitempty"`
 }
 
 func (x *Feed) Reset() {
@@ -146,6 +485,34 @@ func (x *Feed) GetIsStarred() bool {
 	return false
 }
 
+func (x *Feed) GetFolderId() uint32 {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return 0
+}
+
+func (x *Feed) GetFetchContent() bool {
+	if x != nil {
+		return x.FetchContent
+	}
+	return false
+}
+
+func (x *Feed) GetPullFailures() uint32 {
+	if x != nil {
+		return x.PullFailures
+	}
+	return 0
+}
+
+func (x *Feed) GetLastPullError() string {
+	if x != nil && x.LastPullError != nil {
+		return *x.LastPullError
+	}
+	return ""
+}
+
 func (x *Feed) GetEntries() []*Entry {
 	if x != nil {
 		return x.Entries
@@ -153,6 +520,77 @@ func (x *Feed) GetEntries() []*Entry {
 	return nil
 }
 
+func (x *Feed) GetNextPullTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextPullTime
+	}
+	return nil
+}
+
+type Folder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// ID of the folder containing the folder, unset for top-level folders.
+	ParentId *uint32 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Folder) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder) GetParentId() uint32 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
 type Entry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -169,12 +607,17 @@ type Entry struct {
 	Description  *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	Content      *string                `protobuf:"bytes,10,opt,name=content,proto3,oneof" json:"content,omitempty"`
 	Url          *string                `protobuf:"bytes,11,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// Categories of the entry, together with the tags added by rules.
+	Tags []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Names of the entry authors.
+	Authors    []string           `protobuf:"bytes,13,rep,name=authors,proto3" json:"authors,omitempty"`
+	Enclosures []*Entry_Enclosure `protobuf:"bytes,14,rep,name=enclosures,proto3" json:"enclosures,omitempty"`
 }
 
 func (x *Entry) Reset() {
 	*x = Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[1]
+		mi := &file_neon_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -187,7 +630,7 @@ func (x *Entry) String() string {
 func (*Entry) ProtoMessage() {}
 
 func (x *Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[1]
+	mi := &file_neon_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +643,7 @@ func (x *Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entry.ProtoReflect.Descriptor instead.
 func (*Entry) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{1}
+	return file_neon_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Entry) GetId() uint32 {
@@ -280,35 +723,61 @@ func (x *Entry) GetUrl() string {
 	return ""
 }
 
-type AddFeedRequest struct {
+func (x *Entry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Entry) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Entry) GetEnclosures() []*Entry_Enclosure {
+	if x != nil {
+		return x.Enclosures
+	}
+	return nil
+}
+
+type Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url         string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Title       *string  `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description *string  `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Tags        []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	IsStarred   *bool    `protobuf:"varint,5,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"`
-}
-
-func (x *AddFeedRequest) Reset() {
-	*x = AddFeedRequest{}
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the feed to whose entries the rule applies, unset for all feeds.
+	FeedId    *uint32        `protobuf:"varint,2,opt,name=feed_id,json=feedId,proto3,oneof" json:"feed_id,omitempty"`
+	Field     Rule_Field     `protobuf:"varint,3,opt,name=field,proto3,enum=neon.Rule_Field" json:"field,omitempty"`
+	MatchType Rule_MatchType `protobuf:"varint,4,opt,name=match_type,json=matchType,proto3,enum=neon.Rule_MatchType" json:"match_type,omitempty"`
+	Pattern   string         `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Action    Rule_Action    `protobuf:"varint,6,opt,name=action,proto3,enum=neon.Rule_Action" json:"action,omitempty"`
+	// Tag added to matching entries, set only for tag rules.
+	Tag        *string                `protobuf:"bytes,7,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[2]
+		mi := &file_neon_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddFeedRequest) String() string {
+func (x *Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFeedRequest) ProtoMessage() {}
+func (*Rule) ProtoMessage() {}
 
-func (x *AddFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[2]
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,42 +788,142 @@ func (x *AddFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFeedRequest.ProtoReflect.Descriptor instead.
-func (*AddFeedRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *AddFeedRequest) GetUrl() string {
+func (x *Rule) GetId() uint32 {
 	if x != nil {
-		return x.Url
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *AddFeedRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *Rule) GetFeedId() uint32 {
+	if x != nil && x.FeedId != nil {
+		return *x.FeedId
 	}
-	return ""
+	return 0
 }
 
-func (x *AddFeedRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *Rule) GetField() Rule_Field {
+	if x != nil {
+		return x.Field
 	}
-	return ""
+	return Rule_FIELD_UNSPECIFIED
 }
 
-func (x *AddFeedRequest) GetTags() []string {
+func (x *Rule) GetMatchType() Rule_MatchType {
 	if x != nil {
-		return x.Tags
+		return x.MatchType
 	}
-	return nil
+	return Rule_MATCH_TYPE_UNSPECIFIED
 }
 
-func (x *AddFeedRequest) GetIsStarred() bool {
-	if x != nil && x.IsStarred != nil {
-		return *x.IsStarred
+func (x *Rule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Rule) GetAction() Rule_Action {
+	if x != nil {
+		return x.Action
+	}
+	return Rule_ACTION_UNSPECIFIED
+}
+
+func (x *Rule) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *Rule) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type AddFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url         string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title       *string  `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string  `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Tags        []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsStarred   *bool    `protobuf:"varint,5,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"`
+}
+
+func (x *AddFeedRequest) Reset() {
+	*x = AddFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFeedRequest) ProtoMessage() {}
+
+func (x *AddFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFeedRequest.ProtoReflect.Descriptor instead.
+func (*AddFeedRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddFeedRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddFeedRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *AddFeedRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *AddFeedRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AddFeedRequest) GetIsStarred() bool {
+	if x != nil && x.IsStarred != nil {
+		return *x.IsStarred
 	}
 	return false
 }
@@ -371,7 +940,7 @@ type AddFeedResponse struct {
 func (x *AddFeedResponse) Reset() {
 	*x = AddFeedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[3]
+		mi := &file_neon_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -384,7 +953,7 @@ func (x *AddFeedResponse) String() string {
 func (*AddFeedResponse) ProtoMessage() {}
 
 func (x *AddFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[3]
+	mi := &file_neon_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +966,7 @@ func (x *AddFeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFeedResponse.ProtoReflect.Descriptor instead.
 func (*AddFeedResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{3}
+	return file_neon_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AddFeedResponse) GetFeed() *Feed {
@@ -414,6 +983,100 @@ func (x *AddFeedResponse) GetIsAdded() bool {
 	return false
 }
 
+type DiscoverFeedsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *DiscoverFeedsRequest) Reset() {
+	*x = DiscoverFeedsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverFeedsRequest) ProtoMessage() {}
+
+func (x *DiscoverFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverFeedsRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DiscoverFeedsRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type DiscoverFeedsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candidates []*DiscoverFeedsResponse_Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (x *DiscoverFeedsResponse) Reset() {
+	*x = DiscoverFeedsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverFeedsResponse) ProtoMessage() {}
+
+func (x *DiscoverFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverFeedsResponse.ProtoReflect.Descriptor instead.
+func (*DiscoverFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiscoverFeedsResponse) GetCandidates() []*DiscoverFeedsResponse_Candidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
 type EditFeedsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -425,7 +1088,7 @@ type EditFeedsRequest struct {
 func (x *EditFeedsRequest) Reset() {
 	*x = EditFeedsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[4]
+		mi := &file_neon_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -438,7 +1101,7 @@ func (x *EditFeedsRequest) String() string {
 func (*EditFeedsRequest) ProtoMessage() {}
 
 func (x *EditFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[4]
+	mi := &file_neon_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +1114,7 @@ func (x *EditFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditFeedsRequest.ProtoReflect.Descriptor instead.
 func (*EditFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{4}
+	return file_neon_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EditFeedsRequest) GetOps() []*EditFeedsRequest_Op {
@@ -472,7 +1135,7 @@ type EditFeedsResponse struct {
 func (x *EditFeedsResponse) Reset() {
 	*x = EditFeedsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[5]
+		mi := &file_neon_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -485,7 +1148,7 @@ func (x *EditFeedsResponse) String() string {
 func (*EditFeedsResponse) ProtoMessage() {}
 
 func (x *EditFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[5]
+	mi := &file_neon_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +1161,7 @@ func (x *EditFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditFeedsResponse.ProtoReflect.Descriptor instead.
 func (*EditFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{5}
+	return file_neon_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EditFeedsResponse) GetFeeds() []*Feed {
@@ -514,12 +1177,23 @@ type ListFeedsRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	MaxEntriesPerFeed *uint32 `protobuf:"varint,1,opt,name=max_entries_per_feed,json=maxEntriesPerFeed,proto3,oneof" json:"max_entries_per_feed,omitempty"`
+	// Restricts the listing to these feeds, if not empty.
+	FeedIds []uint32 `protobuf:"varint,2,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
+	// Restricts the listing to feeds with at least one of these tags, if not empty.
+	Tags      []string                   `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsStarred *bool                      `protobuf:"varint,4,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"`
+	SortOrder ListFeedsRequest_SortOrder `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3,enum=neon.ListFeedsRequest_SortOrder" json:"sort_order,omitempty"`
+	// Maximum number of feeds returned. Zero means all feeds.
+	PageSize uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from a previous response for fetching the next page. Requests for
+	// subsequent pages must use the same filters and sort order.
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *ListFeedsRequest) Reset() {
 	*x = ListFeedsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[6]
+		mi := &file_neon_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -532,7 +1206,7 @@ func (x *ListFeedsRequest) String() string {
 func (*ListFeedsRequest) ProtoMessage() {}
 
 func (x *ListFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[6]
+	mi := &file_neon_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +1219,7 @@ func (x *ListFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedsRequest.ProtoReflect.Descriptor instead.
 func (*ListFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{6}
+	return file_neon_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListFeedsRequest) GetMaxEntriesPerFeed() uint32 {
@@ -555,18 +1229,62 @@ func (x *ListFeedsRequest) GetMaxEntriesPerFeed() uint32 {
 	return 0
 }
 
+func (x *ListFeedsRequest) GetFeedIds() []uint32 {
+	if x != nil {
+		return x.FeedIds
+	}
+	return nil
+}
+
+func (x *ListFeedsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListFeedsRequest) GetIsStarred() bool {
+	if x != nil && x.IsStarred != nil {
+		return *x.IsStarred
+	}
+	return false
+}
+
+func (x *ListFeedsRequest) GetSortOrder() ListFeedsRequest_SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ListFeedsRequest_SORT_ORDER_UPDATE_TIME_DESC
+}
+
+func (x *ListFeedsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFeedsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListFeedsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Feeds []*Feed `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	// Token for fetching the next page, empty if there are no more feeds.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListFeedsResponse) Reset() {
 	*x = ListFeedsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[7]
+		mi := &file_neon_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -579,7 +1297,7 @@ func (x *ListFeedsResponse) String() string {
 func (*ListFeedsResponse) ProtoMessage() {}
 
 func (x *ListFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[7]
+	mi := &file_neon_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +1310,7 @@ func (x *ListFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedsResponse.ProtoReflect.Descriptor instead.
 func (*ListFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{7}
+	return file_neon_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListFeedsResponse) GetFeeds() []*Feed {
@@ -602,6 +1320,13 @@ func (x *ListFeedsResponse) GetFeeds() []*Feed {
 	return nil
 }
 
+func (x *ListFeedsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type PullFeedsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -609,12 +1334,14 @@ type PullFeedsRequest struct {
 
 	FeedIds           []uint32 `protobuf:"varint,1,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
 	MaxEntriesPerFeed *uint32  `protobuf:"varint,2,opt,name=max_entries_per_feed,json=maxEntriesPerFeed,proto3,oneof" json:"max_entries_per_feed,omitempty"`
+	// Whether feeds backing off after failed pulls are pulled as well.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 }
 
 func (x *PullFeedsRequest) Reset() {
 	*x = PullFeedsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[8]
+		mi := &file_neon_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -627,7 +1354,7 @@ func (x *PullFeedsRequest) String() string {
 func (*PullFeedsRequest) ProtoMessage() {}
 
 func (x *PullFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[8]
+	mi := &file_neon_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +1367,7 @@ func (x *PullFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullFeedsRequest.ProtoReflect.Descriptor instead.
 func (*PullFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{8}
+	return file_neon_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PullFeedsRequest) GetFeedIds() []uint32 {
@@ -657,6 +1384,13 @@ func (x *PullFeedsRequest) GetMaxEntriesPerFeed() uint32 {
 	return 0
 }
 
+func (x *PullFeedsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type PullFeedsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -665,12 +1399,15 @@ type PullFeedsResponse struct {
 	Url   string  `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	Feed  *Feed   `protobuf:"bytes,2,opt,name=feed,proto3,oneof" json:"feed,omitempty"`
 	Error *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// URL the feed has permanently moved to, if the pull detected a move.
+	NewUrl *string                      `protobuf:"bytes,4,opt,name=new_url,json=newUrl,proto3,oneof" json:"new_url,omitempty"`
+	Status PullFeedsResponse_PullStatus `protobuf:"varint,5,opt,name=status,proto3,enum=neon.PullFeedsResponse_PullStatus" json:"status,omitempty"`
 }
 
 func (x *PullFeedsResponse) Reset() {
 	*x = PullFeedsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[9]
+		mi := &file_neon_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -683,7 +1420,7 @@ func (x *PullFeedsResponse) String() string {
 func (*PullFeedsResponse) ProtoMessage() {}
 
 func (x *PullFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[9]
+	mi := &file_neon_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,7 +1433,7 @@ func (x *PullFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullFeedsResponse.ProtoReflect.Descriptor instead.
 func (*PullFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{9}
+	return file_neon_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PullFeedsResponse) GetUrl() string {
@@ -720,10 +1457,24 @@ func (x *PullFeedsResponse) GetError() string {
 	return ""
 }
 
-type DeleteFeedsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *PullFeedsResponse) GetNewUrl() string {
+	if x != nil && x.NewUrl != nil {
+		return *x.NewUrl
+	}
+	return ""
+}
+
+func (x *PullFeedsResponse) GetStatus() PullFeedsResponse_PullStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullFeedsResponse_PULL_STATUS_UNSPECIFIED
+}
+
+type DeleteFeedsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	FeedIds []uint32 `protobuf:"varint,1,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
 }
@@ -731,7 +1482,7 @@ type DeleteFeedsRequest struct {
 func (x *DeleteFeedsRequest) Reset() {
 	*x = DeleteFeedsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[10]
+		mi := &file_neon_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -744,7 +1495,7 @@ func (x *DeleteFeedsRequest) String() string {
 func (*DeleteFeedsRequest) ProtoMessage() {}
 
 func (x *DeleteFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[10]
+	mi := &file_neon_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,7 +1508,7 @@ func (x *DeleteFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFeedsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{10}
+	return file_neon_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteFeedsRequest) GetFeedIds() []uint32 {
@@ -776,7 +1527,7 @@ type DeleteFeedsResponse struct {
 func (x *DeleteFeedsResponse) Reset() {
 	*x = DeleteFeedsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[11]
+		mi := &file_neon_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -789,7 +1540,7 @@ func (x *DeleteFeedsResponse) String() string {
 func (*DeleteFeedsResponse) ProtoMessage() {}
 
 func (x *DeleteFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[11]
+	mi := &file_neon_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,35 +1553,36 @@ func (x *DeleteFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFeedsResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{11}
+	return file_neon_proto_rawDescGZIP(), []int{15}
 }
 
-type ListEntriesRequest struct {
+type CreateFolderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FeedIds      []uint32 `protobuf:"varint,1,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
-	IsBookmarked *bool    `protobuf:"varint,2,opt,name=is_bookmarked,json=isBookmarked,proto3,oneof" json:"is_bookmarked,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// ID of the folder to create the folder in, unset for top-level folders.
+	ParentId *uint32 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
 }
 
-func (x *ListEntriesRequest) Reset() {
-	*x = ListEntriesRequest{}
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[12]
+		mi := &file_neon_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListEntriesRequest) String() string {
+func (x *CreateFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEntriesRequest) ProtoMessage() {}
+func (*CreateFolderRequest) ProtoMessage() {}
 
-func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[12]
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,50 +1593,50 @@ func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEntriesRequest.ProtoReflect.Descriptor instead.
-func (*ListEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListEntriesRequest) GetFeedIds() []uint32 {
+func (x *CreateFolderRequest) GetName() string {
 	if x != nil {
-		return x.FeedIds
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEntriesRequest) GetIsBookmarked() bool {
-	if x != nil && x.IsBookmarked != nil {
-		return *x.IsBookmarked
+func (x *CreateFolderRequest) GetParentId() uint32 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
 	}
-	return false
+	return 0
 }
 
-type ListEntriesResponse struct {
+type CreateFolderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Folder *Folder `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
 }
 
-func (x *ListEntriesResponse) Reset() {
-	*x = ListEntriesResponse{}
+func (x *CreateFolderResponse) Reset() {
+	*x = CreateFolderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[13]
+		mi := &file_neon_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListEntriesResponse) String() string {
+func (x *CreateFolderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEntriesResponse) ProtoMessage() {}
+func (*CreateFolderResponse) ProtoMessage() {}
 
-func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[13]
+func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,43 +1647,41 @@ func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEntriesResponse.ProtoReflect.Descriptor instead.
-func (*ListEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateFolderResponse.ProtoReflect.Descriptor instead.
+func (*CreateFolderResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListEntriesResponse) GetEntries() []*Entry {
+func (x *CreateFolderResponse) GetFolder() *Folder {
 	if x != nil {
-		return x.Entries
+		return x.Folder
 	}
 	return nil
 }
 
-type EditEntriesRequest struct {
+type ListFoldersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Ops []*EditEntriesRequest_Op `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"`
 }
 
-func (x *EditEntriesRequest) Reset() {
-	*x = EditEntriesRequest{}
+func (x *ListFoldersRequest) Reset() {
+	*x = ListFoldersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[14]
+		mi := &file_neon_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EditEntriesRequest) String() string {
+func (x *ListFoldersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditEntriesRequest) ProtoMessage() {}
+func (*ListFoldersRequest) ProtoMessage() {}
 
-func (x *EditEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[14]
+func (x *ListFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,43 +1692,36 @@ func (x *EditEntriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditEntriesRequest.ProtoReflect.Descriptor instead.
-func (*EditEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *EditEntriesRequest) GetOps() []*EditEntriesRequest_Op {
-	if x != nil {
-		return x.Ops
-	}
-	return nil
+// Deprecated: Use ListFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{18}
 }
 
-type EditEntriesResponse struct {
+type ListFoldersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Folders []*Folder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
 }
 
-func (x *EditEntriesResponse) Reset() {
-	*x = EditEntriesResponse{}
+func (x *ListFoldersResponse) Reset() {
+	*x = ListFoldersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[15]
+		mi := &file_neon_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EditEntriesResponse) String() string {
+func (x *ListFoldersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditEntriesResponse) ProtoMessage() {}
+func (*ListFoldersResponse) ProtoMessage() {}
 
-func (x *EditEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[15]
+func (x *ListFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,43 +1732,43 @@ func (x *EditEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditEntriesResponse.ProtoReflect.Descriptor instead.
-func (*EditEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *EditEntriesResponse) GetEntries() []*Entry {
+func (x *ListFoldersResponse) GetFolders() []*Folder {
 	if x != nil {
-		return x.Entries
+		return x.Folders
 	}
 	return nil
 }
 
-type StreamEntriesRequest struct {
+type EditFoldersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FeedId uint32 `protobuf:"varint,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	Ops []*EditFoldersRequest_Op `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"`
 }
 
-func (x *StreamEntriesRequest) Reset() {
-	*x = StreamEntriesRequest{}
+func (x *EditFoldersRequest) Reset() {
+	*x = EditFoldersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[16]
+		mi := &file_neon_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamEntriesRequest) String() string {
+func (x *EditFoldersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamEntriesRequest) ProtoMessage() {}
+func (*EditFoldersRequest) ProtoMessage() {}
 
-func (x *StreamEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[16]
+func (x *EditFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,43 +1779,43 @@ func (x *StreamEntriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamEntriesRequest.ProtoReflect.Descriptor instead.
-func (*StreamEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use EditFoldersRequest.ProtoReflect.Descriptor instead.
+func (*EditFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *StreamEntriesRequest) GetFeedId() uint32 {
+func (x *EditFoldersRequest) GetOps() []*EditFoldersRequest_Op {
 	if x != nil {
-		return x.FeedId
+		return x.Ops
 	}
-	return 0
+	return nil
 }
 
-type StreamEntriesResponse struct {
+type EditFoldersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entry *Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Folders []*Folder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
 }
 
-func (x *StreamEntriesResponse) Reset() {
-	*x = StreamEntriesResponse{}
+func (x *EditFoldersResponse) Reset() {
+	*x = EditFoldersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[17]
+		mi := &file_neon_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamEntriesResponse) String() string {
+func (x *EditFoldersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamEntriesResponse) ProtoMessage() {}
+func (*EditFoldersResponse) ProtoMessage() {}
 
-func (x *StreamEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[17]
+func (x *EditFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,43 +1826,43 @@ func (x *StreamEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamEntriesResponse.ProtoReflect.Descriptor instead.
-func (*StreamEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use EditFoldersResponse.ProtoReflect.Descriptor instead.
+func (*EditFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *StreamEntriesResponse) GetEntry() *Entry {
+func (x *EditFoldersResponse) GetFolders() []*Folder {
 	if x != nil {
-		return x.Entry
+		return x.Folders
 	}
 	return nil
 }
 
-type GetEntryRequest struct {
+type DeleteFoldersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FolderIds []uint32 `protobuf:"varint,1,rep,packed,name=folder_ids,json=folderIds,proto3" json:"folder_ids,omitempty"`
 }
 
-func (x *GetEntryRequest) Reset() {
-	*x = GetEntryRequest{}
+func (x *DeleteFoldersRequest) Reset() {
+	*x = DeleteFoldersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[18]
+		mi := &file_neon_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetEntryRequest) String() string {
+func (x *DeleteFoldersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntryRequest) ProtoMessage() {}
+func (*DeleteFoldersRequest) ProtoMessage() {}
 
-func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[18]
+func (x *DeleteFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,43 +1873,41 @@ func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntryRequest.ProtoReflect.Descriptor instead.
-func (*GetEntryRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteFoldersRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetEntryRequest) GetId() uint32 {
+func (x *DeleteFoldersRequest) GetFolderIds() []uint32 {
 	if x != nil {
-		return x.Id
+		return x.FolderIds
 	}
-	return 0
+	return nil
 }
 
-type GetEntryResponse struct {
+type DeleteFoldersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Entry *Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *GetEntryResponse) Reset() {
-	*x = GetEntryResponse{}
+func (x *DeleteFoldersResponse) Reset() {
+	*x = DeleteFoldersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[19]
+		mi := &file_neon_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetEntryResponse) String() string {
+func (x *DeleteFoldersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntryResponse) ProtoMessage() {}
+func (*DeleteFoldersResponse) ProtoMessage() {}
 
-func (x *GetEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[19]
+func (x *DeleteFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,43 +1918,37 @@ func (x *GetEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntryResponse.ProtoReflect.Descriptor instead.
-func (*GetEntryResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *GetEntryResponse) GetEntry() *Entry {
-	if x != nil {
-		return x.Entry
-	}
-	return nil
+// Deprecated: Use DeleteFoldersResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{23}
 }
 
-type ExportOPMLRequest struct {
+type CreateRuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title *string `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// Rule to create; its ID and creation time are ignored.
+	Rule *Rule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 }
 
-func (x *ExportOPMLRequest) Reset() {
-	*x = ExportOPMLRequest{}
+func (x *CreateRuleRequest) Reset() {
+	*x = CreateRuleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[20]
+		mi := &file_neon_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExportOPMLRequest) String() string {
+func (x *CreateRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportOPMLRequest) ProtoMessage() {}
+func (*CreateRuleRequest) ProtoMessage() {}
 
-func (x *ExportOPMLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[20]
+func (x *CreateRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1224,43 +1959,43 @@ func (x *ExportOPMLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportOPMLRequest.ProtoReflect.Descriptor instead.
-func (*ExportOPMLRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CreateRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRuleRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ExportOPMLRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *CreateRuleRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-type ExportOPMLResponse struct {
+type CreateRuleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Rule *Rule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 }
 
-func (x *ExportOPMLResponse) Reset() {
-	*x = ExportOPMLResponse{}
+func (x *CreateRuleResponse) Reset() {
+	*x = CreateRuleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[21]
+		mi := &file_neon_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExportOPMLResponse) String() string {
+func (x *CreateRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportOPMLResponse) ProtoMessage() {}
+func (*CreateRuleResponse) ProtoMessage() {}
 
-func (x *ExportOPMLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[21]
+func (x *CreateRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,43 +2006,41 @@ func (x *ExportOPMLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportOPMLResponse.ProtoReflect.Descriptor instead.
-func (*ExportOPMLResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CreateRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRuleResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ExportOPMLResponse) GetPayload() []byte {
+func (x *CreateRuleResponse) GetRule() *Rule {
 	if x != nil {
-		return x.Payload
+		return x.Rule
 	}
 	return nil
 }
 
-type ImportOPMLRequest struct {
+type ListRulesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (x *ImportOPMLRequest) Reset() {
-	*x = ImportOPMLRequest{}
+func (x *ListRulesRequest) Reset() {
+	*x = ListRulesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[22]
+		mi := &file_neon_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportOPMLRequest) String() string {
+func (x *ListRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportOPMLRequest) ProtoMessage() {}
+func (*ListRulesRequest) ProtoMessage() {}
 
-func (x *ImportOPMLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[22]
+func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,44 +2051,36 @@ func (x *ImportOPMLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportOPMLRequest.ProtoReflect.Descriptor instead.
-func (*ImportOPMLRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ImportOPMLRequest) GetPayload() []byte {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
+// Deprecated: Use ListRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{26}
 }
 
-type ImportOPMLResponse struct {
+type ListRulesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumProcessed uint32 `protobuf:"varint,1,opt,name=num_processed,json=numProcessed,proto3" json:"num_processed,omitempty"`
-	NumImported  uint32 `protobuf:"varint,2,opt,name=num_imported,json=numImported,proto3" json:"num_imported,omitempty"`
+	Rules []*Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
-func (x *ImportOPMLResponse) Reset() {
-	*x = ImportOPMLResponse{}
+func (x *ListRulesResponse) Reset() {
+	*x = ListRulesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[23]
+		mi := &file_neon_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportOPMLResponse) String() string {
+func (x *ListRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportOPMLResponse) ProtoMessage() {}
+func (*ListRulesResponse) ProtoMessage() {}
 
-func (x *ImportOPMLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[23]
+func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,48 +2091,43 @@ func (x *ImportOPMLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportOPMLResponse.ProtoReflect.Descriptor instead.
-func (*ImportOPMLResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *ImportOPMLResponse) GetNumProcessed() uint32 {
-	if x != nil {
-		return x.NumProcessed
-	}
-	return 0
+// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ImportOPMLResponse) GetNumImported() uint32 {
+func (x *ListRulesResponse) GetRules() []*Rule {
 	if x != nil {
-		return x.NumImported
+		return x.Rules
 	}
-	return 0
+	return nil
 }
 
-type GetStatsRequest struct {
+type DeleteRulesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RuleIds []uint32 `protobuf:"varint,1,rep,packed,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
 }
 
-func (x *GetStatsRequest) Reset() {
-	*x = GetStatsRequest{}
+func (x *DeleteRulesRequest) Reset() {
+	*x = DeleteRulesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[24]
+		mi := &file_neon_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatsRequest) String() string {
+func (x *DeleteRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsRequest) ProtoMessage() {}
+func (*DeleteRulesRequest) ProtoMessage() {}
 
-func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[24]
+func (x *DeleteRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,36 +2138,41 @@ func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DeleteRulesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRulesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{28}
 }
 
-type GetStatsResponse struct {
+func (x *DeleteRulesRequest) GetRuleIds() []uint32 {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+type DeleteRulesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Global *GetStatsResponse_Stats `protobuf:"bytes,1,opt,name=global,proto3,oneof" json:"global,omitempty"`
 }
 
-func (x *GetStatsResponse) Reset() {
-	*x = GetStatsResponse{}
+func (x *DeleteRulesResponse) Reset() {
+	*x = DeleteRulesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[25]
+		mi := &file_neon_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatsResponse) String() string {
+func (x *DeleteRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsResponse) ProtoMessage() {}
+func (*DeleteRulesResponse) ProtoMessage() {}
 
-func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[25]
+func (x *DeleteRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,41 +2183,37 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *GetStatsResponse) GetGlobal() *GetStatsResponse_Stats {
-	if x != nil {
-		return x.Global
-	}
-	return nil
+// Deprecated: Use DeleteRulesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRulesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{29}
 }
 
-type GetInfoRequest struct {
+type MatchRuleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Rule to match; its ID and creation time are ignored.
+	Rule *Rule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 }
 
-func (x *GetInfoRequest) Reset() {
-	*x = GetInfoRequest{}
+func (x *MatchRuleRequest) Reset() {
+	*x = MatchRuleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[26]
+		mi := &file_neon_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoRequest) String() string {
+func (x *MatchRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoRequest) ProtoMessage() {}
+func (*MatchRuleRequest) ProtoMessage() {}
 
-func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[26]
+func (x *MatchRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1503,38 +2224,43 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MatchRuleRequest.ProtoReflect.Descriptor instead.
+func (*MatchRuleRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{30}
 }
 
-type GetInfoResponse struct {
+func (x *MatchRuleRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type MatchRuleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	GitCommit string `protobuf:"bytes,3,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	Entries []*Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *GetInfoResponse) Reset() {
-	*x = GetInfoResponse{}
+func (x *MatchRuleResponse) Reset() {
+	*x = MatchRuleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[27]
+		mi := &file_neon_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoResponse) String() string {
+func (x *MatchRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoResponse) ProtoMessage() {}
+func (*MatchRuleResponse) ProtoMessage() {}
 
-func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[27]
+func (x *MatchRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,58 +2271,67 @@ func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GetInfoResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GetInfoResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+// Deprecated: Use MatchRuleResponse.ProtoReflect.Descriptor instead.
+func (*MatchRuleResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetInfoResponse) GetGitCommit() string {
+func (x *MatchRuleResponse) GetEntries() []*Entry {
 	if x != nil {
-		return x.GitCommit
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type EditFeedsRequest_Op struct {
+type ListEntriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Fields *EditFeedsRequest_Op_Fields `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	FeedIds      []uint32 `protobuf:"varint,1,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
+	IsBookmarked *bool    `protobuf:"varint,2,opt,name=is_bookmarked,json=isBookmarked,proto3,oneof" json:"is_bookmarked,omitempty"`
+	IsRead       *bool    `protobuf:"varint,3,opt,name=is_read,json=isRead,proto3,oneof" json:"is_read,omitempty"`
+	// Restricts the listing to entries of feeds with at least one of these tags,
+	// if not empty.
+	FeedTags      []string `protobuf:"bytes,4,rep,name=feed_tags,json=feedTags,proto3" json:"feed_tags,omitempty"`
+	IsFeedStarred *bool    `protobuf:"varint,5,opt,name=is_feed_starred,json=isFeedStarred,proto3,oneof" json:"is_feed_starred,omitempty"`
+	// Time ranges include their lower bounds and exclude their upper bounds.
+	PublishedAfter  *timestamppb.Timestamp       `protobuf:"bytes,6,opt,name=published_after,json=publishedAfter,proto3,oneof" json:"published_after,omitempty"`
+	PublishedBefore *timestamppb.Timestamp       `protobuf:"bytes,7,opt,name=published_before,json=publishedBefore,proto3,oneof" json:"published_before,omitempty"`
+	UpdatedAfter    *timestamppb.Timestamp       `protobuf:"bytes,8,opt,name=updated_after,json=updatedAfter,proto3,oneof" json:"updated_after,omitempty"`
+	UpdatedBefore   *timestamppb.Timestamp       `protobuf:"bytes,9,opt,name=updated_before,json=updatedBefore,proto3,oneof" json:"updated_before,omitempty"`
+	SortOrder       ListEntriesRequest_SortOrder `protobuf:"varint,10,opt,name=sort_order,json=sortOrder,proto3,enum=neon.ListEntriesRequest_SortOrder" json:"sort_order,omitempty"`
+	// Maximum number of entries returned. Zero means all entries.
+	PageSize uint32 `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from a previous response for fetching the next page. Requests for
+	// subsequent pages must use the same filters and sort order.
+	PageToken string `protobuf:"bytes,12,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Restricts the listing to entries with at least one of these authors,
+	// ignoring case, if not empty.
+	Authors []string `protobuf:"bytes,13,rep,name=authors,proto3" json:"authors,omitempty"`
+	// Restricts the listing to entries with at least one of these tags, if not
+	// empty.
+	Tags          []string `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	HasEnclosures *bool    `protobuf:"varint,15,opt,name=has_enclosures,json=hasEnclosures,proto3,oneof" json:"has_enclosures,omitempty"`
 }
 
-func (x *EditFeedsRequest_Op) Reset() {
-	*x = EditFeedsRequest_Op{}
+func (x *ListEntriesRequest) Reset() {
+	*x = ListEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[28]
+		mi := &file_neon_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EditFeedsRequest_Op) String() string {
+func (x *ListEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditFeedsRequest_Op) ProtoMessage() {}
+func (*ListEntriesRequest) ProtoMessage() {}
 
-func (x *EditFeedsRequest_Op) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[28]
+func (x *ListEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,125 +2342,143 @@ func (x *EditFeedsRequest_Op) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditFeedsRequest_Op.ProtoReflect.Descriptor instead.
-func (*EditFeedsRequest_Op) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use ListEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *EditFeedsRequest_Op) GetId() uint32 {
+func (x *ListEntriesRequest) GetFeedIds() []uint32 {
 	if x != nil {
-		return x.Id
+		return x.FeedIds
 	}
-	return 0
+	return nil
 }
 
-func (x *EditFeedsRequest_Op) GetFields() *EditFeedsRequest_Op_Fields {
+func (x *ListEntriesRequest) GetIsBookmarked() bool {
+	if x != nil && x.IsBookmarked != nil {
+		return *x.IsBookmarked
+	}
+	return false
+}
+
+func (x *ListEntriesRequest) GetIsRead() bool {
+	if x != nil && x.IsRead != nil {
+		return *x.IsRead
+	}
+	return false
+}
+
+func (x *ListEntriesRequest) GetFeedTags() []string {
 	if x != nil {
-		return x.Fields
+		return x.FeedTags
 	}
 	return nil
 }
 
-type EditFeedsRequest_Op_Fields struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ListEntriesRequest) GetIsFeedStarred() bool {
+	if x != nil && x.IsFeedStarred != nil {
+		return *x.IsFeedStarred
+	}
+	return false
+}
 
-	Title       *string `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// NOTE: This means an empty fields message in an op request will delete
-	//
-	//	existing tags.
-	Tags      []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
-	IsStarred *bool    `protobuf:"varint,4,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"`
+func (x *ListEntriesRequest) GetPublishedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAfter
+	}
+	return nil
 }
 
-func (x *EditFeedsRequest_Op_Fields) Reset() {
-	*x = EditFeedsRequest_Op_Fields{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListEntriesRequest) GetPublishedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedBefore
 	}
+	return nil
 }
 
-func (x *EditFeedsRequest_Op_Fields) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListEntriesRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
 }
 
-func (*EditFeedsRequest_Op_Fields) ProtoMessage() {}
+func (x *ListEntriesRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
 
-func (x *EditFeedsRequest_Op_Fields) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListEntriesRequest) GetSortOrder() ListEntriesRequest_SortOrder {
+	if x != nil {
+		return x.SortOrder
 	}
-	return mi.MessageOf(x)
+	return ListEntriesRequest_SORT_ORDER_UPDATE_TIME_DESC
 }
 
-// Deprecated: Use EditFeedsRequest_Op_Fields.ProtoReflect.Descriptor instead.
-func (*EditFeedsRequest_Op_Fields) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{4, 0, 0}
+func (x *ListEntriesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *EditFeedsRequest_Op_Fields) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *ListEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *EditFeedsRequest_Op_Fields) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ListEntriesRequest) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
 	}
-	return ""
+	return nil
 }
 
-func (x *EditFeedsRequest_Op_Fields) GetTags() []string {
+func (x *ListEntriesRequest) GetTags() []string {
 	if x != nil {
 		return x.Tags
 	}
 	return nil
 }
 
-func (x *EditFeedsRequest_Op_Fields) GetIsStarred() bool {
-	if x != nil && x.IsStarred != nil {
-		return *x.IsStarred
+func (x *ListEntriesRequest) GetHasEnclosures() bool {
+	if x != nil && x.HasEnclosures != nil {
+		return *x.HasEnclosures
 	}
 	return false
 }
 
-type EditEntriesRequest_Op struct {
+type ListEntriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint32                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Fields *EditEntriesRequest_Op_Fields `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+	Entries []*Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Token for fetching the next page, empty if there are no more entries.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *EditEntriesRequest_Op) Reset() {
-	*x = EditEntriesRequest_Op{}
+func (x *ListEntriesResponse) Reset() {
+	*x = ListEntriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[30]
+		mi := &file_neon_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EditEntriesRequest_Op) String() string {
+func (x *ListEntriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditEntriesRequest_Op) ProtoMessage() {}
+func (*ListEntriesResponse) ProtoMessage() {}
 
-func (x *EditEntriesRequest_Op) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[30]
+func (x *ListEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1736,51 +2489,50 @@ func (x *EditEntriesRequest_Op) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditEntriesRequest_Op.ProtoReflect.Descriptor instead.
-func (*EditEntriesRequest_Op) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use ListEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *EditEntriesRequest_Op) GetId() uint32 {
+func (x *ListEntriesResponse) GetEntries() []*Entry {
 	if x != nil {
-		return x.Id
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-func (x *EditEntriesRequest_Op) GetFields() *EditEntriesRequest_Op_Fields {
+func (x *ListEntriesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Fields
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type EditEntriesRequest_Op_Fields struct {
+type EditEntriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsRead       *bool `protobuf:"varint,1,opt,name=is_read,json=isRead,proto3,oneof" json:"is_read,omitempty"`
-	IsBookmarked *bool `protobuf:"varint,2,opt,name=is_bookmarked,json=isBookmarked,proto3,oneof" json:"is_bookmarked,omitempty"`
+	Ops []*EditEntriesRequest_Op `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"`
 }
 
-func (x *EditEntriesRequest_Op_Fields) Reset() {
-	*x = EditEntriesRequest_Op_Fields{}
+func (x *EditEntriesRequest) Reset() {
+	*x = EditEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[31]
+		mi := &file_neon_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EditEntriesRequest_Op_Fields) String() string {
+func (x *EditEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditEntriesRequest_Op_Fields) ProtoMessage() {}
+func (*EditEntriesRequest) ProtoMessage() {}
 
-func (x *EditEntriesRequest_Op_Fields) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[31]
+func (x *EditEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,55 +2543,95 @@ func (x *EditEntriesRequest_Op_Fields) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditEntriesRequest_Op_Fields.ProtoReflect.Descriptor instead.
-func (*EditEntriesRequest_Op_Fields) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{14, 0, 0}
+// Deprecated: Use EditEntriesRequest.ProtoReflect.Descriptor instead.
+func (*EditEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *EditEntriesRequest_Op_Fields) GetIsRead() bool {
-	if x != nil && x.IsRead != nil {
-		return *x.IsRead
+func (x *EditEntriesRequest) GetOps() []*EditEntriesRequest_Op {
+	if x != nil {
+		return x.Ops
 	}
-	return false
+	return nil
 }
 
-func (x *EditEntriesRequest_Op_Fields) GetIsBookmarked() bool {
-	if x != nil && x.IsBookmarked != nil {
-		return *x.IsBookmarked
+type EditEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *EditEntriesResponse) Reset() {
+	*x = EditEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-type GetStatsResponse_Stats struct {
+func (x *EditEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditEntriesResponse) ProtoMessage() {}
+
+func (x *EditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*EditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EditEntriesResponse) GetEntries() []*Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type StreamEntriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumFeeds             uint32                 `protobuf:"varint,1,opt,name=num_feeds,json=numFeeds,proto3" json:"num_feeds,omitempty"`
-	NumEntries           uint32                 `protobuf:"varint,2,opt,name=num_entries,json=numEntries,proto3" json:"num_entries,omitempty"`
-	NumEntriesUnread     uint32                 `protobuf:"varint,3,opt,name=num_entries_unread,json=numEntriesUnread,proto3" json:"num_entries_unread,omitempty"`
-	Tag                  *string                `protobuf:"bytes,4,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
-	LastPullTime         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_pull_time,json=lastPullTime,proto3,oneof" json:"last_pull_time,omitempty"`
-	MostRecentUpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=most_recent_update_time,json=mostRecentUpdateTime,proto3,oneof" json:"most_recent_update_time,omitempty"`
+	// Only stream entries of the given feeds, or of all feeds if empty.
+	FeedIds []uint32 `protobuf:"varint,1,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
+	// Only stream entries of feeds with at least one of the given tags, if not empty.
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Only stream entries that are not yet read.
+	UnreadOnly bool `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
 }
 
-func (x *GetStatsResponse_Stats) Reset() {
-	*x = GetStatsResponse_Stats{}
+func (x *StreamEntriesRequest) Reset() {
+	*x = StreamEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_neon_proto_msgTypes[32]
+		mi := &file_neon_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatsResponse_Stats) String() string {
+func (x *StreamEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsResponse_Stats) ProtoMessage() {}
+func (*StreamEntriesRequest) ProtoMessage() {}
 
-func (x *GetStatsResponse_Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_neon_proto_msgTypes[32]
+func (x *StreamEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,450 +2642,3257 @@ func (x *GetStatsResponse_Stats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsResponse_Stats.ProtoReflect.Descriptor instead.
-func (*GetStatsResponse_Stats) Descriptor() ([]byte, []int) {
-	return file_neon_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use StreamEntriesRequest.ProtoReflect.Descriptor instead.
+func (*StreamEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetStatsResponse_Stats) GetNumFeeds() uint32 {
+func (x *StreamEntriesRequest) GetFeedIds() []uint32 {
 	if x != nil {
-		return x.NumFeeds
+		return x.FeedIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GetStatsResponse_Stats) GetNumEntries() uint32 {
+func (x *StreamEntriesRequest) GetTags() []string {
 	if x != nil {
-		return x.NumEntries
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *GetStatsResponse_Stats) GetNumEntriesUnread() uint32 {
+func (x *StreamEntriesRequest) GetUnreadOnly() bool {
 	if x != nil {
-		return x.NumEntriesUnread
+		return x.UnreadOnly
 	}
-	return 0
+	return false
 }
 
-func (x *GetStatsResponse_Stats) GetTag() string {
-	if x != nil && x.Tag != nil {
-		return *x.Tag
+type StreamEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *StreamEntriesResponse) Reset() {
+	*x = StreamEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetStatsResponse_Stats) GetLastPullTime() *timestamppb.Timestamp {
+func (x *StreamEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntriesResponse) ProtoMessage() {}
+
+func (x *StreamEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntriesResponse.ProtoReflect.Descriptor instead.
+func (*StreamEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StreamEntriesResponse) GetEntry() *Entry {
 	if x != nil {
-		return x.LastPullTime
+		return x.Entry
 	}
 	return nil
 }
 
-func (x *GetStatsResponse_Stats) GetMostRecentUpdateTime() *timestamppb.Timestamp {
+type GetEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetEntryRequest) Reset() {
+	*x = GetEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryRequest) ProtoMessage() {}
+
+func (x *GetEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetEntryRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetEntryRequest) GetId() uint32 {
 	if x != nil {
-		return x.MostRecentUpdateTime
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-var File_neon_proto protoreflect.FileDescriptor
+type GetEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_neon_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6e, 0x65,
-	0x6f, 0x6e, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x03, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x12, 0x1e, 0x0a, 0x08, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x73, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01,
-	0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x75,
-	0x62, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x72,
-	0x65, 0x64, 0x12, 0x25, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x69,
-	0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0x03, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x62,
-	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x12, 0x15, 0x0a,
-	0x06, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x78, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x70, 0x75, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
-	0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xc5, 0x01, 0x0a, 0x0e, 0x41, 0x64,
-	0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x19,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74,
-	0x61, 0x72, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65,
-	0x64, 0x22, 0x4c, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x04,
-	0x66, 0x65, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x22,
-	0xbe, 0x02, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x65, 0x65,
-	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x52, 0x03, 0x6f, 0x70,
-	0x73, 0x1a, 0xfc, 0x01, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
-	0x45, 0x64, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x4f, 0x70, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x1a, 0xab, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x19, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61,
-	0x72, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64,
-	0x22, 0x35, 0x0a, 0x11, 0x45, 0x64, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x14, 0x6d,
-	0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x66,
-	0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x61, 0x78,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x88, 0x01,
-	0x01, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x11, 0x4c, 0x69,
-	0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x20, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x22, 0x7c, 0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x73,
-	0x12, 0x34, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f,
-	0x70, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
-	0x52, 0x11, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x46,
-	0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x22,
-	0x78, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x64,
-	0x48, 0x00, 0x52, 0x04, 0x66, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x49,
-	0x64, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x73, 0x42,
-	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x22, 0x3c,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x86, 0x02, 0x0a,
-	0x12, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x52, 0x03, 0x6f,
-	0x70, 0x73, 0x1a, 0xc0, 0x01, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x6f, 0x6e,
-	0x2e, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x6e, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
-	0x1c, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x00, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
-	0x0d, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0c, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x73, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x64, 0x22, 0x3c, 0x0a, 0x13, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66,
-	0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x65,
-	0x65, 0x64, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
-	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e,
-	0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
-	0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x38, 0x0a, 0x11, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50,
-	0x4d, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x2d, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50,
-	0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x22, 0x5c, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d,
-	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d,
-	0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21,
-	0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x64, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xbb, 0x03, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x67, 0x6c, 0x6f,
-	0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x6f, 0x6e,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61,
-	0x6c, 0x88, 0x01, 0x01, 0x1a, 0xe0, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
-	0x75, 0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12,
-	0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x75, 0x6e, 0x72, 0x65,
-	0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x61,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67, 0x88, 0x01,
-	0x01, 0x12, 0x45, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x75, 0x6c,
-	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x17, 0x6d, 0x6f, 0x73, 0x74,
-	0x5f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x14, 0x6d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x1a, 0x0a, 0x18, 0x5f,
-	0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x67, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x32, 0xdc, 0x06, 0x0a, 0x04, 0x4e, 0x65, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
-	0x07, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x12, 0x14, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
-	0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
-	0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x45, 0x64, 0x69, 0x74, 0x46,
-	0x65, 0x65, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74,
-	0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6e,
-	0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x65, 0x65, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6e,
-	0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x50, 0x75, 0x6c, 0x6c, 0x46,
-	0x65, 0x65, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
-	0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6e,
-	0x65, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4c, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e,
-	0x65, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a,
-	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x6e,
-	0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e,
-	0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6e,
-	0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x4f, 0x50, 0x4d, 0x4c, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50,
-	0x4d, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6e, 0x65, 0x6f,
-	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x19, 0x5a, 0x17, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x62, 0x6f, 0x77, 0x2f, 0x6e, 0x65, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Entry *Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-var (
-	file_neon_proto_rawDescOnce sync.Once
-	file_neon_proto_rawDescData = file_neon_proto_rawDesc
-)
+func (x *GetEntryResponse) Reset() {
+	*x = GetEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_neon_proto_rawDescGZIP() []byte {
-	file_neon_proto_rawDescOnce.Do(func() {
-		file_neon_proto_rawDescData = protoimpl.X.CompressGZIP(file_neon_proto_rawDescData)
-	})
-	return file_neon_proto_rawDescData
+func (x *GetEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_neon_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
-var file_neon_proto_goTypes = []any{
-	(*Feed)(nil),                         // 0: neon.Feed
-	(*Entry)(nil),                        // 1: neon.Entry
-	(*AddFeedRequest)(nil),               // 2: neon.AddFeedRequest
-	(*AddFeedResponse)(nil),              // 3: neon.AddFeedResponse
-	(*EditFeedsRequest)(nil),             // 4: neon.EditFeedsRequest
-	(*EditFeedsResponse)(nil),            // 5: neon.EditFeedsResponse
-	(*ListFeedsRequest)(nil),             // 6: neon.ListFeedsRequest
-	(*ListFeedsResponse)(nil),            // 7: neon.ListFeedsResponse
-	(*PullFeedsRequest)(nil),             // 8: neon.PullFeedsRequest
-	(*PullFeedsResponse)(nil),            // 9: neon.PullFeedsResponse
-	(*DeleteFeedsRequest)(nil),           // 10: neon.DeleteFeedsRequest
-	(*DeleteFeedsResponse)(nil),          // 11: neon.DeleteFeedsResponse
-	(*ListEntriesRequest)(nil),           // 12: neon.ListEntriesRequest
-	(*ListEntriesResponse)(nil),          // 13: neon.ListEntriesResponse
-	(*EditEntriesRequest)(nil),           // 14: neon.EditEntriesRequest
-	(*EditEntriesResponse)(nil),          // 15: neon.EditEntriesResponse
-	(*StreamEntriesRequest)(nil),         // 16: neon.StreamEntriesRequest
-	(*StreamEntriesResponse)(nil),        // 17: neon.StreamEntriesResponse
-	(*GetEntryRequest)(nil),              // 18: neon.GetEntryRequest
-	(*GetEntryResponse)(nil),             // 19: neon.GetEntryResponse
-	(*ExportOPMLRequest)(nil),            // 20: neon.ExportOPMLRequest
-	(*ExportOPMLResponse)(nil),           // 21: neon.ExportOPMLResponse
-	(*ImportOPMLRequest)(nil),            // 22: neon.ImportOPMLRequest
-	(*ImportOPMLResponse)(nil),           // 23: neon.ImportOPMLResponse
-	(*GetStatsRequest)(nil),              // 24: neon.GetStatsRequest
-	(*GetStatsResponse)(nil),             // 25: neon.GetStatsResponse
-	(*GetInfoRequest)(nil),               // 26: neon.GetInfoRequest
-	(*GetInfoResponse)(nil),              // 27: neon.GetInfoResponse
-	(*EditFeedsRequest_Op)(nil),          // 28: neon.EditFeedsRequest.Op
-	(*EditFeedsRequest_Op_Fields)(nil),   // 29: neon.EditFeedsRequest.Op.Fields
-	(*EditEntriesRequest_Op)(nil),        // 30: neon.EditEntriesRequest.Op
-	(*EditEntriesRequest_Op_Fields)(nil), // 31: neon.EditEntriesRequest.Op.Fields
-	(*GetStatsResponse_Stats)(nil),       // 32: neon.GetStatsResponse.Stats
-	(*timestamppb.Timestamp)(nil),        // 33: google.protobuf.Timestamp
+func (*GetEntryResponse) ProtoMessage() {}
+
+func (x *GetEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_neon_proto_depIdxs = []int32{
-	33, // 0: neon.Feed.update_time:type_name -> google.protobuf.Timestamp
-	33, // 1: neon.Feed.sub_time:type_name -> google.protobuf.Timestamp
-	33, // 2: neon.Feed.last_pull_time:type_name -> google.protobuf.Timestamp
-	1,  // 3: neon.Feed.entries:type_name -> neon.Entry
-	33, // 4: neon.Entry.update_time:type_name -> google.protobuf.Timestamp
-	33, // 5: neon.Entry.pub_time:type_name -> google.protobuf.Timestamp
-	0,  // 6: neon.AddFeedResponse.feed:type_name -> neon.Feed
-	28, // 7: neon.EditFeedsRequest.ops:type_name -> neon.EditFeedsRequest.Op
-	0,  // 8: neon.EditFeedsResponse.feeds:type_name -> neon.Feed
-	0,  // 9: neon.ListFeedsResponse.feeds:type_name -> neon.Feed
-	0,  // 10: neon.PullFeedsResponse.feed:type_name -> neon.Feed
-	1,  // 11: neon.ListEntriesResponse.entries:type_name -> neon.Entry
-	30, // 12: neon.EditEntriesRequest.ops:type_name -> neon.EditEntriesRequest.Op
-	1,  // 13: neon.EditEntriesResponse.entries:type_name -> neon.Entry
-	1,  // 14: neon.StreamEntriesResponse.entry:type_name -> neon.Entry
-	1,  // 15: neon.GetEntryResponse.entry:type_name -> neon.Entry
-	32, // 16: neon.GetStatsResponse.global:type_name -> neon.GetStatsResponse.Stats
-	29, // 17: neon.EditFeedsRequest.Op.fields:type_name -> neon.EditFeedsRequest.Op.Fields
-	31, // 18: neon.EditEntriesRequest.Op.fields:type_name -> neon.EditEntriesRequest.Op.Fields
-	33, // 19: neon.GetStatsResponse.Stats.last_pull_time:type_name -> google.protobuf.Timestamp
-	33, // 20: neon.GetStatsResponse.Stats.most_recent_update_time:type_name -> google.protobuf.Timestamp
-	2,  // 21: neon.Neon.AddFeed:input_type -> neon.AddFeedRequest
-	4,  // 22: neon.Neon.EditFeeds:input_type -> neon.EditFeedsRequest
-	6,  // 23: neon.Neon.ListFeeds:input_type -> neon.ListFeedsRequest
-	8,  // 24: neon.Neon.PullFeeds:input_type -> neon.PullFeedsRequest
-	10, // 25: neon.Neon.DeleteFeeds:input_type -> neon.DeleteFeedsRequest
-	16, // 26: neon.Neon.StreamEntries:input_type -> neon.StreamEntriesRequest
-	12, // 27: neon.Neon.ListEntries:input_type -> neon.ListEntriesRequest
-	14, // 28: neon.Neon.EditEntries:input_type -> neon.EditEntriesRequest
-	18, // 29: neon.Neon.GetEntry:input_type -> neon.GetEntryRequest
-	20, // 30: neon.Neon.ExportOPML:input_type -> neon.ExportOPMLRequest
-	22, // 31: neon.Neon.ImportOPML:input_type -> neon.ImportOPMLRequest
-	24, // 32: neon.Neon.GetStats:input_type -> neon.GetStatsRequest
-	26, // 33: neon.Neon.GetInfo:input_type -> neon.GetInfoRequest
-	3,  // 34: neon.Neon.AddFeed:output_type -> neon.AddFeedResponse
-	5,  // 35: neon.Neon.EditFeeds:output_type -> neon.EditFeedsResponse
-	7,  // 36: neon.Neon.ListFeeds:output_type -> neon.ListFeedsResponse
-	9,  // 37: neon.Neon.PullFeeds:output_type -> neon.PullFeedsResponse
-	11, // 38: neon.Neon.DeleteFeeds:output_type -> neon.DeleteFeedsResponse
-	17, // 39: neon.Neon.StreamEntries:output_type -> neon.StreamEntriesResponse
-	13, // 40: neon.Neon.ListEntries:output_type -> neon.ListEntriesResponse
-	15, // 41: neon.Neon.EditEntries:output_type -> neon.EditEntriesResponse
-	19, // 42: neon.Neon.GetEntry:output_type -> neon.GetEntryResponse
-	21, // 43: neon.Neon.ExportOPML:output_type -> neon.ExportOPMLResponse
-	23, // 44: neon.Neon.ImportOPML:output_type -> neon.ImportOPMLResponse
-	25, // 45: neon.Neon.GetStats:output_type -> neon.GetStatsResponse
-	27, // 46: neon.Neon.GetInfo:output_type -> neon.GetInfoResponse
-	34, // [34:47] is the sub-list for method output_type
-	21, // [21:34] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+
+// Deprecated: Use GetEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetEntryResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{39}
 }
 
-func init() { file_neon_proto_init() }
-func file_neon_proto_init() {
-	if File_neon_proto != nil {
-		return
+func (x *GetEntryResponse) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_neon_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Feed); i {
+	return nil
+}
+
+type FetchEntryContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FetchEntryContentRequest) Reset() {
+	*x = FetchEntryContentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchEntryContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchEntryContentRequest) ProtoMessage() {}
+
+func (x *FetchEntryContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchEntryContentRequest.ProtoReflect.Descriptor instead.
+func (*FetchEntryContentRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FetchEntryContentRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FetchEntryContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *FetchEntryContentResponse) Reset() {
+	*x = FetchEntryContentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchEntryContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchEntryContentResponse) ProtoMessage() {}
+
+func (x *FetchEntryContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchEntryContentResponse.ProtoReflect.Descriptor instead.
+func (*FetchEntryContentResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FetchEntryContentResponse) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type SearchEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whitespace-separated terms, all of which must match. A trailing '*' makes a
+	// term match as a prefix.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Restricts the search to entries of these feeds, if not empty.
+	FeedIds    []uint32 `protobuf:"varint,2,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
+	MaxResults *uint32  `protobuf:"varint,3,opt,name=max_results,json=maxResults,proto3,oneof" json:"max_results,omitempty"`
+}
+
+func (x *SearchEntriesRequest) Reset() {
+	*x = SearchEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEntriesRequest) ProtoMessage() {}
+
+func (x *SearchEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEntriesRequest.ProtoReflect.Descriptor instead.
+func (*SearchEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SearchEntriesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchEntriesRequest) GetFeedIds() []uint32 {
+	if x != nil {
+		return x.FeedIds
+	}
+	return nil
+}
+
+func (x *SearchEntriesRequest) GetMaxResults() uint32 {
+	if x != nil && x.MaxResults != nil {
+		return *x.MaxResults
+	}
+	return 0
+}
+
+type SearchEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SearchEntriesResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchEntriesResponse) Reset() {
+	*x = SearchEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEntriesResponse) ProtoMessage() {}
+
+func (x *SearchEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEntriesResponse.ProtoReflect.Descriptor instead.
+func (*SearchEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchEntriesResponse) GetResults() []*SearchEntriesResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PruneEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Restricts pruning to entries of these feeds, if not empty.
+	FeedIds []uint32 `protobuf:"varint,1,rep,packed,name=feed_ids,json=feedIds,proto3" json:"feed_ids,omitempty"`
+	// Reports the entries that would be pruned without removing them.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *PruneEntriesRequest) Reset() {
+	*x = PruneEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneEntriesRequest) ProtoMessage() {}
+
+func (x *PruneEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneEntriesRequest.ProtoReflect.Descriptor instead.
+func (*PruneEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PruneEntriesRequest) GetFeedIds() []uint32 {
+	if x != nil {
+		return x.FeedIds
+	}
+	return nil
+}
+
+func (x *PruneEntriesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type PruneEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*PruneEntriesResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *PruneEntriesResponse) Reset() {
+	*x = PruneEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneEntriesResponse) ProtoMessage() {}
+
+func (x *PruneEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneEntriesResponse.ProtoReflect.Descriptor instead.
+func (*PruneEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PruneEntriesResponse) GetResults() []*PruneEntriesResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the feed to which the policy applies, unset for the global policy.
+	FeedId *uint32 `protobuf:"varint,1,opt,name=feed_id,json=feedId,proto3,oneof" json:"feed_id,omitempty"`
+	// Maximum age of entries, based on their update or publication times; unset
+	// for no limit.
+	MaxAge *durationpb.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3,oneof" json:"max_age,omitempty"`
+	// Maximum number of entries kept per feed, newest first; unset for no limit.
+	MaxEntries *uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3,oneof" json:"max_entries,omitempty"`
+	// Never removes bookmarked entries.
+	KeepBookmarked bool `protobuf:"varint,4,opt,name=keep_bookmarked,json=keepBookmarked,proto3" json:"keep_bookmarked,omitempty"`
+	// Never removes unread entries.
+	KeepUnread bool `protobuf:"varint,5,opt,name=keep_unread,json=keepUnread,proto3" json:"keep_unread,omitempty"`
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RetentionPolicy) GetFeedId() uint32 {
+	if x != nil && x.FeedId != nil {
+		return *x.FeedId
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetMaxEntries() uint32 {
+	if x != nil && x.MaxEntries != nil {
+		return *x.MaxEntries
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetKeepBookmarked() bool {
+	if x != nil {
+		return x.KeepBookmarked
+	}
+	return false
+}
+
+func (x *RetentionPolicy) GetKeepUnread() bool {
+	if x != nil {
+		return x.KeepUnread
+	}
+	return false
+}
+
+type ListRetentionPoliciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRetentionPoliciesRequest) Reset() {
+	*x = ListRetentionPoliciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRetentionPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetentionPoliciesRequest) ProtoMessage() {}
+
+func (x *ListRetentionPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetentionPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListRetentionPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{47}
+}
+
+type ListRetentionPoliciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policies []*RetentionPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *ListRetentionPoliciesResponse) Reset() {
+	*x = ListRetentionPoliciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRetentionPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetentionPoliciesResponse) ProtoMessage() {}
+
+func (x *ListRetentionPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetentionPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListRetentionPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListRetentionPoliciesResponse) GetPolicies() []*RetentionPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type SetRetentionPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *RetentionPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetRetentionPolicyRequest) Reset() {
+	*x = SetRetentionPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *SetRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetRetentionPolicyRequest) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetRetentionPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetRetentionPolicyResponse) Reset() {
+	*x = SetRetentionPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRetentionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyResponse) ProtoMessage() {}
+
+func (x *SetRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{50}
+}
+
+type DeleteRetentionPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the feed whose policy is removed, unset for the global policy.
+	FeedId *uint32 `protobuf:"varint,1,opt,name=feed_id,json=feedId,proto3,oneof" json:"feed_id,omitempty"`
+}
+
+func (x *DeleteRetentionPolicyRequest) Reset() {
+	*x = DeleteRetentionPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteRetentionPolicyRequest) GetFeedId() uint32 {
+	if x != nil && x.FeedId != nil {
+		return *x.FeedId
+	}
+	return 0
+}
+
+type DeleteRetentionPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRetentionPolicyResponse) Reset() {
+	*x = DeleteRetentionPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRetentionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRetentionPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{52}
+}
+
+type ExportOPMLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title *string `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
+}
+
+func (x *ExportOPMLRequest) Reset() {
+	*x = ExportOPMLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOPMLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOPMLRequest) ProtoMessage() {}
+
+func (x *ExportOPMLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOPMLRequest.ProtoReflect.Descriptor instead.
+func (*ExportOPMLRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExportOPMLRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+type ExportOPMLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ExportOPMLResponse) Reset() {
+	*x = ExportOPMLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOPMLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOPMLResponse) ProtoMessage() {}
+
+func (x *ExportOPMLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOPMLResponse.ProtoReflect.Descriptor instead.
+func (*ExportOPMLResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExportOPMLResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ImportOPMLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ImportOPMLRequest) Reset() {
+	*x = ImportOPMLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportOPMLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOPMLRequest) ProtoMessage() {}
+
+func (x *ImportOPMLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOPMLRequest.ProtoReflect.Descriptor instead.
+func (*ImportOPMLRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImportOPMLRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ImportOPMLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumProcessed uint32 `protobuf:"varint,1,opt,name=num_processed,json=numProcessed,proto3" json:"num_processed,omitempty"`
+	NumImported  uint32 `protobuf:"varint,2,opt,name=num_imported,json=numImported,proto3" json:"num_imported,omitempty"`
+}
+
+func (x *ImportOPMLResponse) Reset() {
+	*x = ImportOPMLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportOPMLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOPMLResponse) ProtoMessage() {}
+
+func (x *ImportOPMLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOPMLResponse.ProtoReflect.Descriptor instead.
+func (*ImportOPMLResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ImportOPMLResponse) GetNumProcessed() uint32 {
+	if x != nil {
+		return x.NumProcessed
+	}
+	return 0
+}
+
+func (x *ImportOPMLResponse) GetNumImported() uint32 {
+	if x != nil {
+		return x.NumImported
+	}
+	return 0
+}
+
+type GetStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{57}
+}
+
+type GetStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Global    *GetStatsResponse_Stats     `protobuf:"bytes,1,opt,name=global,proto3,oneof" json:"global,omitempty"`
+	Scheduler *GetStatsResponse_Scheduler `protobuf:"bytes,2,opt,name=scheduler,proto3,oneof" json:"scheduler,omitempty"`
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetStatsResponse) GetGlobal() *GetStatsResponse_Stats {
+	if x != nil {
+		return x.Global
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetScheduler() *GetStatsResponse_Scheduler {
+	if x != nil {
+		return x.Scheduler
+	}
+	return nil
+}
+
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{59}
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	GitCommit string `protobuf:"bytes,3,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+// Enclosure is a file attached to an entry, such as a podcast episode.
+type Entry_Enclosure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Media type of the file, empty if unknown.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Size of the file in bytes, zero if unknown.
+	Length uint64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *Entry_Enclosure) Reset() {
+	*x = Entry_Enclosure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entry_Enclosure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry_Enclosure) ProtoMessage() {}
+
+func (x *Entry_Enclosure) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry_Enclosure.ProtoReflect.Descriptor instead.
+func (*Entry_Enclosure) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Entry_Enclosure) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Entry_Enclosure) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Entry_Enclosure) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type DiscoverFeedsResponse_Candidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url   string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *DiscoverFeedsResponse_Candidate) Reset() {
+	*x = DiscoverFeedsResponse_Candidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverFeedsResponse_Candidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverFeedsResponse_Candidate) ProtoMessage() {}
+
+func (x *DiscoverFeedsResponse_Candidate) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverFeedsResponse_Candidate.ProtoReflect.Descriptor instead.
+func (*DiscoverFeedsResponse_Candidate) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *DiscoverFeedsResponse_Candidate) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DiscoverFeedsResponse_Candidate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type EditFeedsRequest_Op struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields *EditFeedsRequest_Op_Fields `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *EditFeedsRequest_Op) Reset() {
+	*x = EditFeedsRequest_Op{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditFeedsRequest_Op) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditFeedsRequest_Op) ProtoMessage() {}
+
+func (x *EditFeedsRequest_Op) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditFeedsRequest_Op.ProtoReflect.Descriptor instead.
+func (*EditFeedsRequest_Op) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *EditFeedsRequest_Op) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EditFeedsRequest_Op) GetFields() *EditFeedsRequest_Op_Fields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type EditFeedsRequest_Op_Fields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       *string `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// NOTE: This means an empty fields message in an op request will delete
+	//
+	//	existing tags.
+	Tags      []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsStarred *bool    `protobuf:"varint,4,opt,name=is_starred,json=isStarred,proto3,oneof" json:"is_starred,omitempty"`
+	// ID of the folder to move the feed into; 0 moves the feed out of its
+	// folder.
+	FolderId     *uint32 `protobuf:"varint,5,opt,name=folder_id,json=folderId,proto3,oneof" json:"folder_id,omitempty"`
+	FetchContent *bool   `protobuf:"varint,6,opt,name=fetch_content,json=fetchContent,proto3,oneof" json:"fetch_content,omitempty"`
+}
+
+func (x *EditFeedsRequest_Op_Fields) Reset() {
+	*x = EditFeedsRequest_Op_Fields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditFeedsRequest_Op_Fields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditFeedsRequest_Op_Fields) ProtoMessage() {}
+
+func (x *EditFeedsRequest_Op_Fields) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditFeedsRequest_Op_Fields.ProtoReflect.Descriptor instead.
+func (*EditFeedsRequest_Op_Fields) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{8, 0, 0}
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetIsStarred() bool {
+	if x != nil && x.IsStarred != nil {
+		return *x.IsStarred
+	}
+	return false
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetFolderId() uint32 {
+	if x != nil && x.FolderId != nil {
+		return *x.FolderId
+	}
+	return 0
+}
+
+func (x *EditFeedsRequest_Op_Fields) GetFetchContent() bool {
+	if x != nil && x.FetchContent != nil {
+		return *x.FetchContent
+	}
+	return false
+}
+
+type EditFoldersRequest_Op struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint32                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields *EditFoldersRequest_Op_Fields `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *EditFoldersRequest_Op) Reset() {
+	*x = EditFoldersRequest_Op{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditFoldersRequest_Op) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditFoldersRequest_Op) ProtoMessage() {}
+
+func (x *EditFoldersRequest_Op) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditFoldersRequest_Op.ProtoReflect.Descriptor instead.
+func (*EditFoldersRequest_Op) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *EditFoldersRequest_Op) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EditFoldersRequest_Op) GetFields() *EditFoldersRequest_Op_Fields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type EditFoldersRequest_Op_Fields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// ID of the folder to move the folder into; 0 moves the folder to the
+	// top level.
+	ParentId *uint32 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+}
+
+func (x *EditFoldersRequest_Op_Fields) Reset() {
+	*x = EditFoldersRequest_Op_Fields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditFoldersRequest_Op_Fields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditFoldersRequest_Op_Fields) ProtoMessage() {}
+
+func (x *EditFoldersRequest_Op_Fields) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditFoldersRequest_Op_Fields.ProtoReflect.Descriptor instead.
+func (*EditFoldersRequest_Op_Fields) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{20, 0, 0}
+}
+
+func (x *EditFoldersRequest_Op_Fields) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *EditFoldersRequest_Op_Fields) GetParentId() uint32 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+type EditEntriesRequest_Op struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint32                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields *EditEntriesRequest_Op_Fields `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *EditEntriesRequest_Op) Reset() {
+	*x = EditEntriesRequest_Op{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditEntriesRequest_Op) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditEntriesRequest_Op) ProtoMessage() {}
+
+func (x *EditEntriesRequest_Op) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditEntriesRequest_Op.ProtoReflect.Descriptor instead.
+func (*EditEntriesRequest_Op) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *EditEntriesRequest_Op) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EditEntriesRequest_Op) GetFields() *EditEntriesRequest_Op_Fields {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type EditEntriesRequest_Op_Fields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsRead       *bool `protobuf:"varint,1,opt,name=is_read,json=isRead,proto3,oneof" json:"is_read,omitempty"`
+	IsBookmarked *bool `protobuf:"varint,2,opt,name=is_bookmarked,json=isBookmarked,proto3,oneof" json:"is_bookmarked,omitempty"`
+}
+
+func (x *EditEntriesRequest_Op_Fields) Reset() {
+	*x = EditEntriesRequest_Op_Fields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditEntriesRequest_Op_Fields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditEntriesRequest_Op_Fields) ProtoMessage() {}
+
+func (x *EditEntriesRequest_Op_Fields) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditEntriesRequest_Op_Fields.ProtoReflect.Descriptor instead.
+func (*EditEntriesRequest_Op_Fields) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{34, 0, 0}
+}
+
+func (x *EditEntriesRequest_Op_Fields) GetIsRead() bool {
+	if x != nil && x.IsRead != nil {
+		return *x.IsRead
+	}
+	return false
+}
+
+func (x *EditEntriesRequest_Op_Fields) GetIsBookmarked() bool {
+	if x != nil && x.IsBookmarked != nil {
+		return *x.IsBookmarked
+	}
+	return false
+}
+
+type SearchEntriesResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Entry title, with matched terms enclosed in '\u0002' and '\u0003'.
+	TitleHighlight string `protobuf:"bytes,2,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	// Excerpt of the matched text, with matched terms enclosed in '\u0002' and
+	// '\u0003'.
+	Snippet string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// Relevance of the entry; higher is better.
+	Score float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *SearchEntriesResponse_Result) Reset() {
+	*x = SearchEntriesResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchEntriesResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEntriesResponse_Result) ProtoMessage() {}
+
+func (x *SearchEntriesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEntriesResponse_Result.ProtoReflect.Descriptor instead.
+func (*SearchEntriesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *SearchEntriesResponse_Result) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *SearchEntriesResponse_Result) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *SearchEntriesResponse_Result) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchEntriesResponse_Result) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type PruneEntriesResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeedId    uint32 `protobuf:"varint,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	FeedTitle string `protobuf:"bytes,2,opt,name=feed_title,json=feedTitle,proto3" json:"feed_title,omitempty"`
+	NumPruned uint32 `protobuf:"varint,3,opt,name=num_pruned,json=numPruned,proto3" json:"num_pruned,omitempty"`
+	NumKept   uint32 `protobuf:"varint,4,opt,name=num_kept,json=numKept,proto3" json:"num_kept,omitempty"`
+}
+
+func (x *PruneEntriesResponse_Result) Reset() {
+	*x = PruneEntriesResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneEntriesResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneEntriesResponse_Result) ProtoMessage() {}
+
+func (x *PruneEntriesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneEntriesResponse_Result.ProtoReflect.Descriptor instead.
+func (*PruneEntriesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{45, 0}
+}
+
+func (x *PruneEntriesResponse_Result) GetFeedId() uint32 {
+	if x != nil {
+		return x.FeedId
+	}
+	return 0
+}
+
+func (x *PruneEntriesResponse_Result) GetFeedTitle() string {
+	if x != nil {
+		return x.FeedTitle
+	}
+	return ""
+}
+
+func (x *PruneEntriesResponse_Result) GetNumPruned() uint32 {
+	if x != nil {
+		return x.NumPruned
+	}
+	return 0
+}
+
+func (x *PruneEntriesResponse_Result) GetNumKept() uint32 {
+	if x != nil {
+		return x.NumKept
+	}
+	return 0
+}
+
+type GetStatsResponse_Stats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumFeeds             uint32                 `protobuf:"varint,1,opt,name=num_feeds,json=numFeeds,proto3" json:"num_feeds,omitempty"`
+	NumEntries           uint32                 `protobuf:"varint,2,opt,name=num_entries,json=numEntries,proto3" json:"num_entries,omitempty"`
+	NumEntriesUnread     uint32                 `protobuf:"varint,3,opt,name=num_entries_unread,json=numEntriesUnread,proto3" json:"num_entries_unread,omitempty"`
+	Tag                  *string                `protobuf:"bytes,4,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	LastPullTime         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_pull_time,json=lastPullTime,proto3,oneof" json:"last_pull_time,omitempty"`
+	MostRecentUpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=most_recent_update_time,json=mostRecentUpdateTime,proto3,oneof" json:"most_recent_update_time,omitempty"`
+}
+
+func (x *GetStatsResponse_Stats) Reset() {
+	*x = GetStatsResponse_Stats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse_Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse_Stats) ProtoMessage() {}
+
+func (x *GetStatsResponse_Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse_Stats.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse_Stats) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{58, 0}
+}
+
+func (x *GetStatsResponse_Stats) GetNumFeeds() uint32 {
+	if x != nil {
+		return x.NumFeeds
+	}
+	return 0
+}
+
+func (x *GetStatsResponse_Stats) GetNumEntries() uint32 {
+	if x != nil {
+		return x.NumEntries
+	}
+	return 0
+}
+
+func (x *GetStatsResponse_Stats) GetNumEntriesUnread() uint32 {
+	if x != nil {
+		return x.NumEntriesUnread
+	}
+	return 0
+}
+
+func (x *GetStatsResponse_Stats) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *GetStatsResponse_Stats) GetLastPullTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPullTime
+	}
+	return nil
+}
+
+func (x *GetStatsResponse_Stats) GetMostRecentUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MostRecentUpdateTime
+	}
+	return nil
+}
+
+type GetStatsResponse_Scheduler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumFeeds       uint32                 `protobuf:"varint,1,opt,name=num_feeds,json=numFeeds,proto3" json:"num_feeds,omitempty"`
+	NumPullsOk     uint32                 `protobuf:"varint,2,opt,name=num_pulls_ok,json=numPullsOk,proto3" json:"num_pulls_ok,omitempty"`
+	NumPullsFailed uint32                 `protobuf:"varint,3,opt,name=num_pulls_failed,json=numPullsFailed,proto3" json:"num_pulls_failed,omitempty"`
+	LastPullTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_pull_time,json=lastPullTime,proto3,oneof" json:"last_pull_time,omitempty"`
+	NextPullTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_pull_time,json=nextPullTime,proto3,oneof" json:"next_pull_time,omitempty"`
+	LastError      *string                `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+}
+
+func (x *GetStatsResponse_Scheduler) Reset() {
+	*x = GetStatsResponse_Scheduler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_neon_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse_Scheduler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse_Scheduler) ProtoMessage() {}
+
+func (x *GetStatsResponse_Scheduler) ProtoReflect() protoreflect.Message {
+	mi := &file_neon_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse_Scheduler.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse_Scheduler) Descriptor() ([]byte, []int) {
+	return file_neon_proto_rawDescGZIP(), []int{58, 1}
+}
+
+func (x *GetStatsResponse_Scheduler) GetNumFeeds() uint32 {
+	if x != nil {
+		return x.NumFeeds
+	}
+	return 0
+}
+
+func (x *GetStatsResponse_Scheduler) GetNumPullsOk() uint32 {
+	if x != nil {
+		return x.NumPullsOk
+	}
+	return 0
+}
+
+func (x *GetStatsResponse_Scheduler) GetNumPullsFailed() uint32 {
+	if x != nil {
+		return x.NumPullsFailed
+	}
+	return 0
+}
+
+func (x *GetStatsResponse_Scheduler) GetLastPullTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPullTime
+	}
+	return nil
+}
+
+func (x *GetStatsResponse_Scheduler) GetNextPullTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextPullTime
+	}
+	return nil
+}
+
+func (x *GetStatsResponse_Scheduler) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+var File_neon_proto protoreflect.FileDescriptor
+
+var file_neon_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6e, 0x65,
+	0x6f, 0x6e, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xe5, 0x05, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x1e, 0x0a, 0x08, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x07, 0x73, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64,
+	0x12, 0x20, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x70, 0x75, 0x6c, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x75, 0x6c,
+	0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e, 0x65, 0x6f,
+	0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x45, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x75, 0x6c, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x69, 0x74, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x06, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xc0, 0x04, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x12, 0x15,
+	0x0a, 0x06, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x70, 0x75, 0x62, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52,
+	0x0a, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x49, 0x0a, 0x09, 0x45,
+	0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xea, 0x04, 0x0a, 0x04, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x26, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x03, 0x74, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a,
+	0x09, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x04, 0x22, 0x57, 0x0a, 0x09,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x47, 0x45, 0x58, 0x10, 0x02, 0x22, 0x6c, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x4d, 0x41, 0x52, 0x4b,
+	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x47,
+	0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x10, 0x04, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67, 0x22, 0xc5, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72,
+	0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x22,
+	0x4c, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x04, 0x66, 0x65,
+	0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x22, 0x28, 0x0a,
+	0x14, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x33, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xaa, 0x03,
+	0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x52, 0x03, 0x6f, 0x70, 0x73, 0x1a,
+	0xe8, 0x02, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f,
+	0x70, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x1a, 0x97, 0x02, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x72,
+	0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04,
+	0x52, 0x0c, 0x66, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x11, 0x45, 0x64,
+	0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x22, 0xc4, 0x03, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08,
+	0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07,
+	0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x01, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x3f, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x81, 0x01, 0x0a,
+	0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f,
+	0x41, 0x53, 0x43, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x03,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x22, 0x5d, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07,
+	0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x22, 0xf0, 0x02, 0x0a,
+	0x11, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x04, 0x66, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x55, 0x72, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x65,
+	0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8f,
+	0x01, 0x0a, 0x0a, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a,
+	0x17, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x55,
+	0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x55, 0x4c,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x55, 0x4c, 0x4c, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x04,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x72, 0x6c, 0x22,
+	0x2f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x73,
+	0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x22, 0x3c, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6e, 0x65, 0x6f,
+	0x6e, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x07, 0x66, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x12, 0x45, 0x64, 0x69, 0x74, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03,
+	0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x52, 0x03, 0x6f, 0x70, 0x73, 0x1a, 0xac, 0x01, 0x0a, 0x02,
+	0x4f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x5a,
+	0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x13, 0x45, 0x64,
+	0x69, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x52, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x34,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0x2f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x73,
+	0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x10, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x3a, 0x0a, 0x11, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xdb, 0x07, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x0c, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x2b,
+	0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0d, 0x69, 0x73, 0x46, 0x65, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x03, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x44, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x06, 0x52, 0x0d, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x41, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x0e,
+	0x68, 0x61, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x45, 0x6e, 0x63, 0x6c, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x73, 0x88, 0x01, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x53, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x4f, 0x52, 0x54, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4f, 0x52, 0x54, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x55, 0x42, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x44,
+	0x45, 0x53, 0x43, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x50, 0x55, 0x42, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x41, 0x53, 0x43,
+	0x10, 0x03, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x72, 0x65, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x86, 0x02, 0x0a, 0x12,
+	0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2d, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x52, 0x03, 0x6f, 0x70,
+	0x73, 0x1a, 0xc0, 0x01, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
+	0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4f, 0x70, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x1a, 0x6e, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1c,
+	0x0a, 0x07, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d,
+	0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0c, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x73, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x64, 0x22, 0x3c, 0x0a, 0x13, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x66, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65,
+	0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x3a, 0x0a, 0x15, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x22, 0x2a, 0x0a, 0x18, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x19,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x7d, 0x0a, 0x14,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65,
+	0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x15,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x1a, 0x84, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x50, 0x72,
+	0x75, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xcf, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x7a, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x65, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x75, 0x6d, 0x5f, 0x6b, 0x65, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x6e, 0x75, 0x6d, 0x4b, 0x65, 0x70, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x07, 0x66,
+	0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06,
+	0x66, 0x65, 0x65, 0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x6b, 0x65, 0x65, 0x70,
+	0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x6b, 0x65, 0x65, 0x70, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x55, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x1d, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x4a,
+	0x0a, 0x19, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x66, 0x65, 0x65,
+	0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x5f,
+	0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d,
+	0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x2e, 0x0a,
+	0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x2d, 0x0a,
+	0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x5c, 0x0a, 0x12,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e,
+	0x75, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xec, 0x06,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x48, 0x00, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a,
+	0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x48, 0x01, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x1a, 0xe0, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x6e, 0x75, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x75,
+	0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12,
+	0x45, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x17, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x14, 0x6d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x06,
+	0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x6d, 0x6f,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0xdb, 0x02, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x46, 0x65, 0x65, 0x64, 0x73,
+	0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x73, 0x5f, 0x6f, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x50, 0x75, 0x6c, 0x6c, 0x73,
+	0x4f, 0x6b, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x73, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x75,
+	0x6d, 0x50, 0x75, 0x6c, 0x6c, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x75, 0x6c, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x22, 0x10, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x32, 0xe5,
+	0x14, 0x0a, 0x04, 0x4e, 0x65, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x46, 0x65,
+	0x65, 0x64, 0x12, 0x14, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
+	0x41, 0x64, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x12, 0x64, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x3a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x09, 0x45,
+	0x64, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
+	0x45, 0x64, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0e, 0x3a, 0x01, 0x2a, 0x32, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12,
+	0x4f, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x12, 0x59, 0x0a, 0x09, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x16, 0x2e,
+	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x6c,
+	0x6c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x3a, 0x70, 0x75, 0x6c, 0x6c, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x0b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x6f,
+	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x2a, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x12, 0x5d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x57, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x18, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x6f,
+	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f,
+	0x76, 0x31, 0x2f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x5a, 0x0a, 0x0b, 0x45, 0x64,
+	0x69, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x6f, 0x6e,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x32, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x5d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x2a, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x58, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x4f, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x55, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x18, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x2a, 0x09, 0x2f, 0x76,
+	0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x66, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x3a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x57, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x6e, 0x65,
+	0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x3a, 0x01, 0x2a, 0x32, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x15, 0x2e,
+	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6e, 0x65,
+	0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x65,
+	0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x63, 0x0a, 0x0c, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x6f, 0x6e,
+	0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x80,
+	0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x7f, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x3a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x16, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x50, 0x4d, 0x4c, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6e,
+	0x65, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x6d, 0x6c, 0x12, 0x54, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x50, 0x4d,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x6d, 0x6c, 0x12, 0x4c,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x6f,
+	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x6e, 0x65, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76,
+	0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x19, 0x5a, 0x17, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6f, 0x77, 0x2f, 0x6e, 0x65, 0x6f, 0x6e, 0x2f, 0x61, 0x70,
+	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_neon_proto_rawDescOnce sync.Once
+	file_neon_proto_rawDescData = file_neon_proto_rawDesc
+)
+
+func file_neon_proto_rawDescGZIP() []byte {
+	file_neon_proto_rawDescOnce.Do(func() {
+		file_neon_proto_rawDescData = protoimpl.X.CompressGZIP(file_neon_proto_rawDescData)
+	})
+	return file_neon_proto_rawDescData
+}
+
+var file_neon_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_neon_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_neon_proto_goTypes = []any{
+	(Rule_Field)(0),                         // 0: neon.Rule.Field
+	(Rule_MatchType)(0),                     // 1: neon.Rule.MatchType
+	(Rule_Action)(0),                        // 2: neon.Rule.Action
+	(ListFeedsRequest_SortOrder)(0),         // 3: neon.ListFeedsRequest.SortOrder
+	(PullFeedsResponse_PullStatus)(0),       // 4: neon.PullFeedsResponse.PullStatus
+	(ListEntriesRequest_SortOrder)(0),       // 5: neon.ListEntriesRequest.SortOrder
+	(*Feed)(nil),                            // 6: neon.Feed
+	(*Folder)(nil),                          // 7: neon.Folder
+	(*Entry)(nil),                           // 8: neon.Entry
+	(*Rule)(nil),                            // 9: neon.Rule
+	(*AddFeedRequest)(nil),                  // 10: neon.AddFeedRequest
+	(*AddFeedResponse)(nil),                 // 11: neon.AddFeedResponse
+	(*DiscoverFeedsRequest)(nil),            // 12: neon.DiscoverFeedsRequest
+	(*DiscoverFeedsResponse)(nil),           // 13: neon.DiscoverFeedsResponse
+	(*EditFeedsRequest)(nil),                // 14: neon.EditFeedsRequest
+	(*EditFeedsResponse)(nil),               // 15: neon.EditFeedsResponse
+	(*ListFeedsRequest)(nil),                // 16: neon.ListFeedsRequest
+	(*ListFeedsResponse)(nil),               // 17: neon.ListFeedsResponse
+	(*PullFeedsRequest)(nil),                // 18: neon.PullFeedsRequest
+	(*PullFeedsResponse)(nil),               // 19: neon.PullFeedsResponse
+	(*DeleteFeedsRequest)(nil),              // 20: neon.DeleteFeedsRequest
+	(*DeleteFeedsResponse)(nil),             // 21: neon.DeleteFeedsResponse
+	(*CreateFolderRequest)(nil),             // 22: neon.CreateFolderRequest
+	(*CreateFolderResponse)(nil),            // 23: neon.CreateFolderResponse
+	(*ListFoldersRequest)(nil),              // 24: neon.ListFoldersRequest
+	(*ListFoldersResponse)(nil),             // 25: neon.ListFoldersResponse
+	(*EditFoldersRequest)(nil),              // 26: neon.EditFoldersRequest
+	(*EditFoldersResponse)(nil),             // 27: neon.EditFoldersResponse
+	(*DeleteFoldersRequest)(nil),            // 28: neon.DeleteFoldersRequest
+	(*DeleteFoldersResponse)(nil),           // 29: neon.DeleteFoldersResponse
+	(*CreateRuleRequest)(nil),               // 30: neon.CreateRuleRequest
+	(*CreateRuleResponse)(nil),              // 31: neon.CreateRuleResponse
+	(*ListRulesRequest)(nil),                // 32: neon.ListRulesRequest
+	(*ListRulesResponse)(nil),               // 33: neon.ListRulesResponse
+	(*DeleteRulesRequest)(nil),              // 34: neon.DeleteRulesRequest
+	(*DeleteRulesResponse)(nil),             // 35: neon.DeleteRulesResponse
+	(*MatchRuleRequest)(nil),                // 36: neon.MatchRuleRequest
+	(*MatchRuleResponse)(nil),               // 37: neon.MatchRuleResponse
+	(*ListEntriesRequest)(nil),              // 38: neon.ListEntriesRequest
+	(*ListEntriesResponse)(nil),             // 39: neon.ListEntriesResponse
+	(*EditEntriesRequest)(nil),              // 40: neon.EditEntriesRequest
+	(*EditEntriesResponse)(nil),             // 41: neon.EditEntriesResponse
+	(*StreamEntriesRequest)(nil),            // 42: neon.StreamEntriesRequest
+	(*StreamEntriesResponse)(nil),           // 43: neon.StreamEntriesResponse
+	(*GetEntryRequest)(nil),                 // 44: neon.GetEntryRequest
+	(*GetEntryResponse)(nil),                // 45: neon.GetEntryResponse
+	(*FetchEntryContentRequest)(nil),        // 46: neon.FetchEntryContentRequest
+	(*FetchEntryContentResponse)(nil),       // 47: neon.FetchEntryContentResponse
+	(*SearchEntriesRequest)(nil),            // 48: neon.SearchEntriesRequest
+	(*SearchEntriesResponse)(nil),           // 49: neon.SearchEntriesResponse
+	(*PruneEntriesRequest)(nil),             // 50: neon.PruneEntriesRequest
+	(*PruneEntriesResponse)(nil),            // 51: neon.PruneEntriesResponse
+	(*RetentionPolicy)(nil),                 // 52: neon.RetentionPolicy
+	(*ListRetentionPoliciesRequest)(nil),    // 53: neon.ListRetentionPoliciesRequest
+	(*ListRetentionPoliciesResponse)(nil),   // 54: neon.ListRetentionPoliciesResponse
+	(*SetRetentionPolicyRequest)(nil),       // 55: neon.SetRetentionPolicyRequest
+	(*SetRetentionPolicyResponse)(nil),      // 56: neon.SetRetentionPolicyResponse
+	(*DeleteRetentionPolicyRequest)(nil),    // 57: neon.DeleteRetentionPolicyRequest
+	(*DeleteRetentionPolicyResponse)(nil),   // 58: neon.DeleteRetentionPolicyResponse
+	(*ExportOPMLRequest)(nil),               // 59: neon.ExportOPMLRequest
+	(*ExportOPMLResponse)(nil),              // 60: neon.ExportOPMLResponse
+	(*ImportOPMLRequest)(nil),               // 61: neon.ImportOPMLRequest
+	(*ImportOPMLResponse)(nil),              // 62: neon.ImportOPMLResponse
+	(*GetStatsRequest)(nil),                 // 63: neon.GetStatsRequest
+	(*GetStatsResponse)(nil),                // 64: neon.GetStatsResponse
+	(*GetInfoRequest)(nil),                  // 65: neon.GetInfoRequest
+	(*GetInfoResponse)(nil),                 // 66: neon.GetInfoResponse
+	(*Entry_Enclosure)(nil),                 // 67: neon.Entry.Enclosure
+	(*DiscoverFeedsResponse_Candidate)(nil), // 68: neon.DiscoverFeedsResponse.Candidate
+	(*EditFeedsRequest_Op)(nil),             // 69: neon.EditFeedsRequest.Op
+	(*EditFeedsRequest_Op_Fields)(nil),      // 70: neon.EditFeedsRequest.Op.Fields
+	(*EditFoldersRequest_Op)(nil),           // 71: neon.EditFoldersRequest.Op
+	(*EditFoldersRequest_Op_Fields)(nil),    // 72: neon.EditFoldersRequest.Op.Fields
+	(*EditEntriesRequest_Op)(nil),           // 73: neon.EditEntriesRequest.Op
+	(*EditEntriesRequest_Op_Fields)(nil),    // 74: neon.EditEntriesRequest.Op.Fields
+	(*SearchEntriesResponse_Result)(nil),    // 75: neon.SearchEntriesResponse.Result
+	(*PruneEntriesResponse_Result)(nil),     // 76: neon.PruneEntriesResponse.Result
+	(*GetStatsResponse_Stats)(nil),          // 77: neon.GetStatsResponse.Stats
+	(*GetStatsResponse_Scheduler)(nil),      // 78: neon.GetStatsResponse.Scheduler
+	(*timestamppb.Timestamp)(nil),           // 79: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 80: google.protobuf.Duration
+}
+var file_neon_proto_depIdxs = []int32{
+	79, // 0: neon.Feed.update_time:type_name -> google.protobuf.Timestamp
+	79, // 1: neon.Feed.sub_time:type_name -> google.protobuf.Timestamp
+	79, // 2: neon.Feed.last_pull_time:type_name -> google.protobuf.Timestamp
+	8,  // 3: neon.Feed.entries:type_name -> neon.Entry
+	79, // 4: neon.Feed.next_pull_time:type_name -> google.protobuf.Timestamp
+	79, // 5: neon.Entry.update_time:type_name -> google.protobuf.Timestamp
+	79, // 6: neon.Entry.pub_time:type_name -> google.protobuf.Timestamp
+	67, // 7: neon.Entry.enclosures:type_name -> neon.Entry.Enclosure
+	0,  // 8: neon.Rule.field:type_name -> neon.Rule.Field
+	1,  // 9: neon.Rule.match_type:type_name -> neon.Rule.MatchType
+	2,  // 10: neon.Rule.action:type_name -> neon.Rule.Action
+	79, // 11: neon.Rule.create_time:type_name -> google.protobuf.Timestamp
+	6,  // 12: neon.AddFeedResponse.feed:type_name -> neon.Feed
+	68, // 13: neon.DiscoverFeedsResponse.candidates:type_name -> neon.DiscoverFeedsResponse.Candidate
+	69, // 14: neon.EditFeedsRequest.ops:type_name -> neon.EditFeedsRequest.Op
+	6,  // 15: neon.EditFeedsResponse.feeds:type_name -> neon.Feed
+	3,  // 16: neon.ListFeedsRequest.sort_order:type_name -> neon.ListFeedsRequest.SortOrder
+	6,  // 17: neon.ListFeedsResponse.feeds:type_name -> neon.Feed
+	6,  // 18: neon.PullFeedsResponse.feed:type_name -> neon.Feed
+	4,  // 19: neon.PullFeedsResponse.status:type_name -> neon.PullFeedsResponse.PullStatus
+	7,  // 20: neon.CreateFolderResponse.folder:type_name -> neon.Folder
+	7,  // 21: neon.ListFoldersResponse.folders:type_name -> neon.Folder
+	71, // 22: neon.EditFoldersRequest.ops:type_name -> neon.EditFoldersRequest.Op
+	7,  // 23: neon.EditFoldersResponse.folders:type_name -> neon.Folder
+	9,  // 24: neon.CreateRuleRequest.rule:type_name -> neon.Rule
+	9,  // 25: neon.CreateRuleResponse.rule:type_name -> neon.Rule
+	9,  // 26: neon.ListRulesResponse.rules:type_name -> neon.Rule
+	9,  // 27: neon.MatchRuleRequest.rule:type_name -> neon.Rule
+	8,  // 28: neon.MatchRuleResponse.entries:type_name -> neon.Entry
+	79, // 29: neon.ListEntriesRequest.published_after:type_name -> google.protobuf.Timestamp
+	79, // 30: neon.ListEntriesRequest.published_before:type_name -> google.protobuf.Timestamp
+	79, // 31: neon.ListEntriesRequest.updated_after:type_name -> google.protobuf.Timestamp
+	79, // 32: neon.ListEntriesRequest.updated_before:type_name -> google.protobuf.Timestamp
+	5,  // 33: neon.ListEntriesRequest.sort_order:type_name -> neon.ListEntriesRequest.SortOrder
+	8,  // 34: neon.ListEntriesResponse.entries:type_name -> neon.Entry
+	73, // 35: neon.EditEntriesRequest.ops:type_name -> neon.EditEntriesRequest.Op
+	8,  // 36: neon.EditEntriesResponse.entries:type_name -> neon.Entry
+	8,  // 37: neon.StreamEntriesResponse.entry:type_name -> neon.Entry
+	8,  // 38: neon.GetEntryResponse.entry:type_name -> neon.Entry
+	8,  // 39: neon.FetchEntryContentResponse.entry:type_name -> neon.Entry
+	75, // 40: neon.SearchEntriesResponse.results:type_name -> neon.SearchEntriesResponse.Result
+	76, // 41: neon.PruneEntriesResponse.results:type_name -> neon.PruneEntriesResponse.Result
+	80, // 42: neon.RetentionPolicy.max_age:type_name -> google.protobuf.Duration
+	52, // 43: neon.ListRetentionPoliciesResponse.policies:type_name -> neon.RetentionPolicy
+	52, // 44: neon.SetRetentionPolicyRequest.policy:type_name -> neon.RetentionPolicy
+	77, // 45: neon.GetStatsResponse.global:type_name -> neon.GetStatsResponse.Stats
+	78, // 46: neon.GetStatsResponse.scheduler:type_name -> neon.GetStatsResponse.Scheduler
+	70, // 47: neon.EditFeedsRequest.Op.fields:type_name -> neon.EditFeedsRequest.Op.Fields
+	72, // 48: neon.EditFoldersRequest.Op.fields:type_name -> neon.EditFoldersRequest.Op.Fields
+	74, // 49: neon.EditEntriesRequest.Op.fields:type_name -> neon.EditEntriesRequest.Op.Fields
+	8,  // 50: neon.SearchEntriesResponse.Result.entry:type_name -> neon.Entry
+	79, // 51: neon.GetStatsResponse.Stats.last_pull_time:type_name -> google.protobuf.Timestamp
+	79, // 52: neon.GetStatsResponse.Stats.most_recent_update_time:type_name -> google.protobuf.Timestamp
+	79, // 53: neon.GetStatsResponse.Scheduler.last_pull_time:type_name -> google.protobuf.Timestamp
+	79, // 54: neon.GetStatsResponse.Scheduler.next_pull_time:type_name -> google.protobuf.Timestamp
+	10, // 55: neon.Neon.AddFeed:input_type -> neon.AddFeedRequest
+	12, // 56: neon.Neon.DiscoverFeeds:input_type -> neon.DiscoverFeedsRequest
+	14, // 57: neon.Neon.EditFeeds:input_type -> neon.EditFeedsRequest
+	16, // 58: neon.Neon.ListFeeds:input_type -> neon.ListFeedsRequest
+	18, // 59: neon.Neon.PullFeeds:input_type -> neon.PullFeedsRequest
+	20, // 60: neon.Neon.DeleteFeeds:input_type -> neon.DeleteFeedsRequest
+	22, // 61: neon.Neon.CreateFolder:input_type -> neon.CreateFolderRequest
+	24, // 62: neon.Neon.ListFolders:input_type -> neon.ListFoldersRequest
+	26, // 63: neon.Neon.EditFolders:input_type -> neon.EditFoldersRequest
+	28, // 64: neon.Neon.DeleteFolders:input_type -> neon.DeleteFoldersRequest
+	30, // 65: neon.Neon.CreateRule:input_type -> neon.CreateRuleRequest
+	32, // 66: neon.Neon.ListRules:input_type -> neon.ListRulesRequest
+	34, // 67: neon.Neon.DeleteRules:input_type -> neon.DeleteRulesRequest
+	36, // 68: neon.Neon.MatchRule:input_type -> neon.MatchRuleRequest
+	42, // 69: neon.Neon.StreamEntries:input_type -> neon.StreamEntriesRequest
+	38, // 70: neon.Neon.ListEntries:input_type -> neon.ListEntriesRequest
+	40, // 71: neon.Neon.EditEntries:input_type -> neon.EditEntriesRequest
+	44, // 72: neon.Neon.GetEntry:input_type -> neon.GetEntryRequest
+	46, // 73: neon.Neon.FetchEntryContent:input_type -> neon.FetchEntryContentRequest
+	48, // 74: neon.Neon.SearchEntries:input_type -> neon.SearchEntriesRequest
+	50, // 75: neon.Neon.PruneEntries:input_type -> neon.PruneEntriesRequest
+	53, // 76: neon.Neon.ListRetentionPolicies:input_type -> neon.ListRetentionPoliciesRequest
+	55, // 77: neon.Neon.SetRetentionPolicy:input_type -> neon.SetRetentionPolicyRequest
+	57, // 78: neon.Neon.DeleteRetentionPolicy:input_type -> neon.DeleteRetentionPolicyRequest
+	59, // 79: neon.Neon.ExportOPML:input_type -> neon.ExportOPMLRequest
+	61, // 80: neon.Neon.ImportOPML:input_type -> neon.ImportOPMLRequest
+	63, // 81: neon.Neon.GetStats:input_type -> neon.GetStatsRequest
+	65, // 82: neon.Neon.GetInfo:input_type -> neon.GetInfoRequest
+	11, // 83: neon.Neon.AddFeed:output_type -> neon.AddFeedResponse
+	13, // 84: neon.Neon.DiscoverFeeds:output_type -> neon.DiscoverFeedsResponse
+	15, // 85: neon.Neon.EditFeeds:output_type -> neon.EditFeedsResponse
+	17, // 86: neon.Neon.ListFeeds:output_type -> neon.ListFeedsResponse
+	19, // 87: neon.Neon.PullFeeds:output_type -> neon.PullFeedsResponse
+	21, // 88: neon.Neon.DeleteFeeds:output_type -> neon.DeleteFeedsResponse
+	23, // 89: neon.Neon.CreateFolder:output_type -> neon.CreateFolderResponse
+	25, // 90: neon.Neon.ListFolders:output_type -> neon.ListFoldersResponse
+	27, // 91: neon.Neon.EditFolders:output_type -> neon.EditFoldersResponse
+	29, // 92: neon.Neon.DeleteFolders:output_type -> neon.DeleteFoldersResponse
+	31, // 93: neon.Neon.CreateRule:output_type -> neon.CreateRuleResponse
+	33, // 94: neon.Neon.ListRules:output_type -> neon.ListRulesResponse
+	35, // 95: neon.Neon.DeleteRules:output_type -> neon.DeleteRulesResponse
+	37, // 96: neon.Neon.MatchRule:output_type -> neon.MatchRuleResponse
+	43, // 97: neon.Neon.StreamEntries:output_type -> neon.StreamEntriesResponse
+	39, // 98: neon.Neon.ListEntries:output_type -> neon.ListEntriesResponse
+	41, // 99: neon.Neon.EditEntries:output_type -> neon.EditEntriesResponse
+	45, // 100: neon.Neon.GetEntry:output_type -> neon.GetEntryResponse
+	47, // 101: neon.Neon.FetchEntryContent:output_type -> neon.FetchEntryContentResponse
+	49, // 102: neon.Neon.SearchEntries:output_type -> neon.SearchEntriesResponse
+	51, // 103: neon.Neon.PruneEntries:output_type -> neon.PruneEntriesResponse
+	54, // 104: neon.Neon.ListRetentionPolicies:output_type -> neon.ListRetentionPoliciesResponse
+	56, // 105: neon.Neon.SetRetentionPolicy:output_type -> neon.SetRetentionPolicyResponse
+	58, // 106: neon.Neon.DeleteRetentionPolicy:output_type -> neon.DeleteRetentionPolicyResponse
+	60, // 107: neon.Neon.ExportOPML:output_type -> neon.ExportOPMLResponse
+	62, // 108: neon.Neon.ImportOPML:output_type -> neon.ImportOPMLResponse
+	64, // 109: neon.Neon.GetStats:output_type -> neon.GetStatsResponse
+	66, // 110: neon.Neon.GetInfo:output_type -> neon.GetInfoResponse
+	83, // [83:111] is the sub-list for method output_type
+	55, // [55:83] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
+}
+
+func init() { file_neon_proto_init() }
+func file_neon_proto_init() {
+	if File_neon_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_neon_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Feed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Folder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*AddFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*AddFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DiscoverFeedsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*DiscoverFeedsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*EditFeedsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*EditFeedsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFeedsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFeedsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*PullFeedsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*PullFeedsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFeedsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFeedsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFolderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFolderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFoldersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFoldersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*EditFoldersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2304,8 +5903,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*Entry); i {
+		file_neon_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*EditFoldersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2316,8 +5915,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*AddFeedRequest); i {
+		file_neon_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFoldersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2328,8 +5927,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*AddFeedResponse); i {
+		file_neon_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFoldersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2340,8 +5939,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*EditFeedsRequest); i {
+		file_neon_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2352,8 +5951,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*EditFeedsResponse); i {
+		file_neon_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRuleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2364,8 +5963,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ListFeedsRequest); i {
+		file_neon_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2376,8 +5975,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*ListFeedsResponse); i {
+		file_neon_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2388,8 +5987,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*PullFeedsRequest); i {
+		file_neon_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2400,8 +5999,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*PullFeedsResponse); i {
+		file_neon_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2412,8 +6011,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteFeedsRequest); i {
+		file_neon_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*MatchRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2424,8 +6023,8 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteFeedsResponse); i {
+		file_neon_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*MatchRuleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2436,7 +6035,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[32].Exporter = func(v any, i int) any {
 			switch v := v.(*ListEntriesRequest); i {
 			case 0:
 				return &v.state
@@ -2448,7 +6047,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[33].Exporter = func(v any, i int) any {
 			switch v := v.(*ListEntriesResponse); i {
 			case 0:
 				return &v.state
@@ -2460,7 +6059,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[34].Exporter = func(v any, i int) any {
 			switch v := v.(*EditEntriesRequest); i {
 			case 0:
 				return &v.state
@@ -2472,7 +6071,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[35].Exporter = func(v any, i int) any {
 			switch v := v.(*EditEntriesResponse); i {
 			case 0:
 				return &v.state
@@ -2484,7 +6083,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[36].Exporter = func(v any, i int) any {
 			switch v := v.(*StreamEntriesRequest); i {
 			case 0:
 				return &v.state
@@ -2496,7 +6095,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[37].Exporter = func(v any, i int) any {
 			switch v := v.(*StreamEntriesResponse); i {
 			case 0:
 				return &v.state
@@ -2508,7 +6107,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[18].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[38].Exporter = func(v any, i int) any {
 			switch v := v.(*GetEntryRequest); i {
 			case 0:
 				return &v.state
@@ -2520,7 +6119,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[19].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[39].Exporter = func(v any, i int) any {
 			switch v := v.(*GetEntryResponse); i {
 			case 0:
 				return &v.state
@@ -2532,7 +6131,163 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[20].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*FetchEntryContentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*FetchEntryContentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*PruneEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*PruneEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*RetentionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRetentionPoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRetentionPoliciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRetentionPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRetentionPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRetentionPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRetentionPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*ExportOPMLRequest); i {
 			case 0:
 				return &v.state
@@ -2544,7 +6299,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[21].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*ExportOPMLResponse); i {
 			case 0:
 				return &v.state
@@ -2556,7 +6311,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[22].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*ImportOPMLRequest); i {
 			case 0:
 				return &v.state
@@ -2568,7 +6323,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[23].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[56].Exporter = func(v any, i int) any {
 			switch v := v.(*ImportOPMLResponse); i {
 			case 0:
 				return &v.state
@@ -2580,7 +6335,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[24].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[57].Exporter = func(v any, i int) any {
 			switch v := v.(*GetStatsRequest); i {
 			case 0:
 				return &v.state
@@ -2592,7 +6347,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[25].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*GetStatsResponse); i {
 			case 0:
 				return &v.state
@@ -2604,7 +6359,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[26].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[59].Exporter = func(v any, i int) any {
 			switch v := v.(*GetInfoRequest); i {
 			case 0:
 				return &v.state
@@ -2616,7 +6371,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[27].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*GetInfoResponse); i {
 			case 0:
 				return &v.state
@@ -2628,7 +6383,31 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[28].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*Entry_Enclosure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*DiscoverFeedsResponse_Candidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[63].Exporter = func(v any, i int) any {
 			switch v := v.(*EditFeedsRequest_Op); i {
 			case 0:
 				return &v.state
@@ -2640,7 +6419,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[29].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[64].Exporter = func(v any, i int) any {
 			switch v := v.(*EditFeedsRequest_Op_Fields); i {
 			case 0:
 				return &v.state
@@ -2652,7 +6431,31 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[30].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*EditFoldersRequest_Op); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*EditFoldersRequest_Op_Fields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[67].Exporter = func(v any, i int) any {
 			switch v := v.(*EditEntriesRequest_Op); i {
 			case 0:
 				return &v.state
@@ -2664,7 +6467,7 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[31].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[68].Exporter = func(v any, i int) any {
 			switch v := v.(*EditEntriesRequest_Op_Fields); i {
 			case 0:
 				return &v.state
@@ -2676,7 +6479,31 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
-		file_neon_proto_msgTypes[32].Exporter = func(v any, i int) any {
+		file_neon_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchEntriesResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*PruneEntriesResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_neon_proto_msgTypes[71].Exporter = func(v any, i int) any {
 			switch v := v.(*GetStatsResponse_Stats); i {
 			case 0:
 				return &v.state
@@ -2688,31 +6515,52 @@ func file_neon_proto_init() {
 				return nil
 			}
 		}
+		file_neon_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStatsResponse_Scheduler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_neon_proto_msgTypes[0].OneofWrappers = []any{}
 	file_neon_proto_msgTypes[1].OneofWrappers = []any{}
 	file_neon_proto_msgTypes[2].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[6].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[8].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[9].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[3].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[4].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[10].OneofWrappers = []any{}
 	file_neon_proto_msgTypes[12].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[20].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[25].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[29].OneofWrappers = []any{}
-	file_neon_proto_msgTypes[31].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[13].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[16].OneofWrappers = []any{}
 	file_neon_proto_msgTypes[32].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[42].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[46].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[51].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[53].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[58].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[64].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[66].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[68].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[71].OneofWrappers = []any{}
+	file_neon_proto_msgTypes[72].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_neon_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   33,
+			NumEnums:      6,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_neon_proto_goTypes,
 		DependencyIndexes: file_neon_proto_depIdxs,
+		EnumInfos:         file_neon_proto_enumTypes,
 		MessageInfos:      file_neon_proto_msgTypes,
 	}.Build()
 	File_neon_proto = out.File
@@ -24,19 +24,34 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Neon_AddFeed_FullMethodName       = "/neon.Neon/AddFeed"
-	Neon_EditFeeds_FullMethodName     = "/neon.Neon/EditFeeds"
-	Neon_ListFeeds_FullMethodName     = "/neon.Neon/ListFeeds"
-	Neon_PullFeeds_FullMethodName     = "/neon.Neon/PullFeeds"
-	Neon_DeleteFeeds_FullMethodName   = "/neon.Neon/DeleteFeeds"
-	Neon_StreamEntries_FullMethodName = "/neon.Neon/StreamEntries"
-	Neon_ListEntries_FullMethodName   = "/neon.Neon/ListEntries"
-	Neon_EditEntries_FullMethodName   = "/neon.Neon/EditEntries"
-	Neon_GetEntry_FullMethodName      = "/neon.Neon/GetEntry"
-	Neon_ExportOPML_FullMethodName    = "/neon.Neon/ExportOPML"
-	Neon_ImportOPML_FullMethodName    = "/neon.Neon/ImportOPML"
-	Neon_GetStats_FullMethodName      = "/neon.Neon/GetStats"
-	Neon_GetInfo_FullMethodName       = "/neon.Neon/GetInfo"
+	Neon_AddFeed_FullMethodName               = "/neon.Neon/AddFeed"
+	Neon_DiscoverFeeds_FullMethodName         = "/neon.Neon/DiscoverFeeds"
+	Neon_EditFeeds_FullMethodName             = "/neon.Neon/EditFeeds"
+	Neon_ListFeeds_FullMethodName             = "/neon.Neon/ListFeeds"
+	Neon_PullFeeds_FullMethodName             = "/neon.Neon/PullFeeds"
+	Neon_DeleteFeeds_FullMethodName           = "/neon.Neon/DeleteFeeds"
+	Neon_CreateFolder_FullMethodName          = "/neon.Neon/CreateFolder"
+	Neon_ListFolders_FullMethodName           = "/neon.Neon/ListFolders"
+	Neon_EditFolders_FullMethodName           = "/neon.Neon/EditFolders"
+	Neon_DeleteFolders_FullMethodName         = "/neon.Neon/DeleteFolders"
+	Neon_CreateRule_FullMethodName            = "/neon.Neon/CreateRule"
+	Neon_ListRules_FullMethodName             = "/neon.Neon/ListRules"
+	Neon_DeleteRules_FullMethodName           = "/neon.Neon/DeleteRules"
+	Neon_MatchRule_FullMethodName             = "/neon.Neon/MatchRule"
+	Neon_StreamEntries_FullMethodName         = "/neon.Neon/StreamEntries"
+	Neon_ListEntries_FullMethodName           = "/neon.Neon/ListEntries"
+	Neon_EditEntries_FullMethodName           = "/neon.Neon/EditEntries"
+	Neon_GetEntry_FullMethodName              = "/neon.Neon/GetEntry"
+	Neon_FetchEntryContent_FullMethodName     = "/neon.Neon/FetchEntryContent"
+	Neon_SearchEntries_FullMethodName         = "/neon.Neon/SearchEntries"
+	Neon_PruneEntries_FullMethodName          = "/neon.Neon/PruneEntries"
+	Neon_ListRetentionPolicies_FullMethodName = "/neon.Neon/ListRetentionPolicies"
+	Neon_SetRetentionPolicy_FullMethodName    = "/neon.Neon/SetRetentionPolicy"
+	Neon_DeleteRetentionPolicy_FullMethodName = "/neon.Neon/DeleteRetentionPolicy"
+	Neon_ExportOPML_FullMethodName            = "/neon.Neon/ExportOPML"
+	Neon_ImportOPML_FullMethodName            = "/neon.Neon/ImportOPML"
+	Neon_GetStats_FullMethodName              = "/neon.Neon/GetStats"
+	Neon_GetInfo_FullMethodName               = "/neon.Neon/GetInfo"
 )
 
 // NeonClient is the client API for Neon service.
@@ -45,6 +60,8 @@ const (
 type NeonClient interface {
 	// AddFeeds adds a new feed source.
 	AddFeed(ctx context.Context, in *AddFeedRequest, opts ...grpc.CallOption) (*AddFeedResponse, error)
+	// DiscoverFeeds finds the feeds published by a website.
+	DiscoverFeeds(ctx context.Context, in *DiscoverFeedsRequest, opts ...grpc.CallOption) (*DiscoverFeedsResponse, error)
 	// EditFeeds sets one or more fields of feeds.
 	EditFeeds(ctx context.Context, in *EditFeedsRequest, opts ...grpc.CallOption) (*EditFeedsResponse, error)
 	// ListFeeds lists all added feed sources.
@@ -53,7 +70,23 @@ type NeonClient interface {
 	PullFeeds(ctx context.Context, in *PullFeedsRequest, opts ...grpc.CallOption) (Neon_PullFeedsClient, error)
 	// DeleteFeeds removes one or more feed sources.
 	DeleteFeeds(ctx context.Context, in *DeleteFeedsRequest, opts ...grpc.CallOption) (*DeleteFeedsResponse, error)
-	// StreamEntries streams entries of a specific feed.
+	// CreateFolder creates a new feed folder.
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	// ListFolders lists all feed folders.
+	ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error)
+	// EditFolders renames or moves one or more folders.
+	EditFolders(ctx context.Context, in *EditFoldersRequest, opts ...grpc.CallOption) (*EditFoldersResponse, error)
+	// DeleteFolders removes one or more folders, together with their subfolders.
+	DeleteFolders(ctx context.Context, in *DeleteFoldersRequest, opts ...grpc.CallOption) (*DeleteFoldersResponse, error)
+	// CreateRule creates a new rule applied to new entries when feeds are pulled.
+	CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*CreateRuleResponse, error)
+	// ListRules lists all entry rules.
+	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
+	// DeleteRules removes one or more entry rules.
+	DeleteRules(ctx context.Context, in *DeleteRulesRequest, opts ...grpc.CallOption) (*DeleteRulesResponse, error)
+	// MatchRule returns the existing entries matching a rule, without creating the rule.
+	MatchRule(ctx context.Context, in *MatchRuleRequest, opts ...grpc.CallOption) (*MatchRuleResponse, error)
+	// StreamEntries streams entries as they are added or updated, until the client cancels.
 	StreamEntries(ctx context.Context, in *StreamEntriesRequest, opts ...grpc.CallOption) (Neon_StreamEntriesClient, error)
 	// ListEntries lists entries of a specific feed.
 	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
@@ -61,6 +94,22 @@ type NeonClient interface {
 	EditEntries(ctx context.Context, in *EditEntriesRequest, opts ...grpc.CallOption) (*EditEntriesResponse, error)
 	// GetEntry returns the content of an entry.
 	GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*GetEntryResponse, error)
+	// FetchEntryContent replaces the content of an entry with the article
+	// extracted from the entry URL.
+	FetchEntryContent(ctx context.Context, in *FetchEntryContentRequest, opts ...grpc.CallOption) (*FetchEntryContentResponse, error)
+	// SearchEntries returns entries matching a full-text query, best matches first.
+	SearchEntries(ctx context.Context, in *SearchEntriesRequest, opts ...grpc.CallOption) (*SearchEntriesResponse, error)
+	// PruneEntries removes entries according to the retention policies.
+	PruneEntries(ctx context.Context, in *PruneEntriesRequest, opts ...grpc.CallOption) (*PruneEntriesResponse, error)
+	// ListRetentionPolicies lists the retention policies, with the global policy
+	// first.
+	ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error)
+	// SetRetentionPolicy creates or replaces the global retention policy, or the
+	// policy of a feed.
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error)
+	// DeleteRetentionPolicy removes the global retention policy, or the policy of
+	// a feed.
+	DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error)
 	// ExportOPML exports feed subscriptions as an OPML document.
 	ExportOPML(ctx context.Context, in *ExportOPMLRequest, opts ...grpc.CallOption) (*ExportOPMLResponse, error)
 	// ImportOPML imports an OPML document.
@@ -88,6 +137,15 @@ func (c *neonClient) AddFeed(ctx context.Context, in *AddFeedRequest, opts ...gr
 	return out, nil
 }
 
+func (c *neonClient) DiscoverFeeds(ctx context.Context, in *DiscoverFeedsRequest, opts ...grpc.CallOption) (*DiscoverFeedsResponse, error) {
+	out := new(DiscoverFeedsResponse)
+	err := c.cc.Invoke(ctx, Neon_DiscoverFeeds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *neonClient) EditFeeds(ctx context.Context, in *EditFeedsRequest, opts ...grpc.CallOption) (*EditFeedsResponse, error) {
 	out := new(EditFeedsResponse)
 	err := c.cc.Invoke(ctx, Neon_EditFeeds_FullMethodName, in, out, opts...)
@@ -147,6 +205,78 @@ func (c *neonClient) DeleteFeeds(ctx context.Context, in *DeleteFeedsRequest, op
 	return out, nil
 }
 
+func (c *neonClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error) {
+	out := new(CreateFolderResponse)
+	err := c.cc.Invoke(ctx, Neon_CreateFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error) {
+	out := new(ListFoldersResponse)
+	err := c.cc.Invoke(ctx, Neon_ListFolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) EditFolders(ctx context.Context, in *EditFoldersRequest, opts ...grpc.CallOption) (*EditFoldersResponse, error) {
+	out := new(EditFoldersResponse)
+	err := c.cc.Invoke(ctx, Neon_EditFolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) DeleteFolders(ctx context.Context, in *DeleteFoldersRequest, opts ...grpc.CallOption) (*DeleteFoldersResponse, error) {
+	out := new(DeleteFoldersResponse)
+	err := c.cc.Invoke(ctx, Neon_DeleteFolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) CreateRule(ctx context.Context, in *CreateRuleRequest, opts ...grpc.CallOption) (*CreateRuleResponse, error) {
+	out := new(CreateRuleResponse)
+	err := c.cc.Invoke(ctx, Neon_CreateRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error) {
+	out := new(ListRulesResponse)
+	err := c.cc.Invoke(ctx, Neon_ListRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) DeleteRules(ctx context.Context, in *DeleteRulesRequest, opts ...grpc.CallOption) (*DeleteRulesResponse, error) {
+	out := new(DeleteRulesResponse)
+	err := c.cc.Invoke(ctx, Neon_DeleteRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) MatchRule(ctx context.Context, in *MatchRuleRequest, opts ...grpc.CallOption) (*MatchRuleResponse, error) {
+	out := new(MatchRuleResponse)
+	err := c.cc.Invoke(ctx, Neon_MatchRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *neonClient) StreamEntries(ctx context.Context, in *StreamEntriesRequest, opts ...grpc.CallOption) (Neon_StreamEntriesClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Neon_ServiceDesc.Streams[1], Neon_StreamEntries_FullMethodName, opts...)
 	if err != nil {
@@ -206,6 +336,60 @@ func (c *neonClient) GetEntry(ctx context.Context, in *GetEntryRequest, opts ...
 	return out, nil
 }
 
+func (c *neonClient) FetchEntryContent(ctx context.Context, in *FetchEntryContentRequest, opts ...grpc.CallOption) (*FetchEntryContentResponse, error) {
+	out := new(FetchEntryContentResponse)
+	err := c.cc.Invoke(ctx, Neon_FetchEntryContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) SearchEntries(ctx context.Context, in *SearchEntriesRequest, opts ...grpc.CallOption) (*SearchEntriesResponse, error) {
+	out := new(SearchEntriesResponse)
+	err := c.cc.Invoke(ctx, Neon_SearchEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) PruneEntries(ctx context.Context, in *PruneEntriesRequest, opts ...grpc.CallOption) (*PruneEntriesResponse, error) {
+	out := new(PruneEntriesResponse)
+	err := c.cc.Invoke(ctx, Neon_PruneEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error) {
+	out := new(ListRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, Neon_ListRetentionPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error) {
+	out := new(SetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, Neon_SetRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *neonClient) DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error) {
+	out := new(DeleteRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, Neon_DeleteRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *neonClient) ExportOPML(ctx context.Context, in *ExportOPMLRequest, opts ...grpc.CallOption) (*ExportOPMLResponse, error) {
 	out := new(ExportOPMLResponse)
 	err := c.cc.Invoke(ctx, Neon_ExportOPML_FullMethodName, in, out, opts...)
@@ -248,6 +432,8 @@ func (c *neonClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...gr
 type NeonServer interface {
 	// AddFeeds adds a new feed source.
 	AddFeed(context.Context, *AddFeedRequest) (*AddFeedResponse, error)
+	// DiscoverFeeds finds the feeds published by a website.
+	DiscoverFeeds(context.Context, *DiscoverFeedsRequest) (*DiscoverFeedsResponse, error)
 	// EditFeeds sets one or more fields of feeds.
 	EditFeeds(context.Context, *EditFeedsRequest) (*EditFeedsResponse, error)
 	// ListFeeds lists all added feed sources.
@@ -256,7 +442,23 @@ type NeonServer interface {
 	PullFeeds(*PullFeedsRequest, Neon_PullFeedsServer) error
 	// DeleteFeeds removes one or more feed sources.
 	DeleteFeeds(context.Context, *DeleteFeedsRequest) (*DeleteFeedsResponse, error)
-	// StreamEntries streams entries of a specific feed.
+	// CreateFolder creates a new feed folder.
+	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	// ListFolders lists all feed folders.
+	ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error)
+	// EditFolders renames or moves one or more folders.
+	EditFolders(context.Context, *EditFoldersRequest) (*EditFoldersResponse, error)
+	// DeleteFolders removes one or more folders, together with their subfolders.
+	DeleteFolders(context.Context, *DeleteFoldersRequest) (*DeleteFoldersResponse, error)
+	// CreateRule creates a new rule applied to new entries when feeds are pulled.
+	CreateRule(context.Context, *CreateRuleRequest) (*CreateRuleResponse, error)
+	// ListRules lists all entry rules.
+	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
+	// DeleteRules removes one or more entry rules.
+	DeleteRules(context.Context, *DeleteRulesRequest) (*DeleteRulesResponse, error)
+	// MatchRule returns the existing entries matching a rule, without creating the rule.
+	MatchRule(context.Context, *MatchRuleRequest) (*MatchRuleResponse, error)
+	// StreamEntries streams entries as they are added or updated, until the client cancels.
 	StreamEntries(*StreamEntriesRequest, Neon_StreamEntriesServer) error
 	// ListEntries lists entries of a specific feed.
 	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
@@ -264,6 +466,22 @@ type NeonServer interface {
 	EditEntries(context.Context, *EditEntriesRequest) (*EditEntriesResponse, error)
 	// GetEntry returns the content of an entry.
 	GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error)
+	// FetchEntryContent replaces the content of an entry with the article
+	// extracted from the entry URL.
+	FetchEntryContent(context.Context, *FetchEntryContentRequest) (*FetchEntryContentResponse, error)
+	// SearchEntries returns entries matching a full-text query, best matches first.
+	SearchEntries(context.Context, *SearchEntriesRequest) (*SearchEntriesResponse, error)
+	// PruneEntries removes entries according to the retention policies.
+	PruneEntries(context.Context, *PruneEntriesRequest) (*PruneEntriesResponse, error)
+	// ListRetentionPolicies lists the retention policies, with the global policy
+	// first.
+	ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error)
+	// SetRetentionPolicy creates or replaces the global retention policy, or the
+	// policy of a feed.
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error)
+	// DeleteRetentionPolicy removes the global retention policy, or the policy of
+	// a feed.
+	DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*DeleteRetentionPolicyResponse, error)
 	// ExportOPML exports feed subscriptions as an OPML document.
 	ExportOPML(context.Context, *ExportOPMLRequest) (*ExportOPMLResponse, error)
 	// ImportOPML imports an OPML document.
@@ -282,6 +500,9 @@ type UnimplementedNeonServer struct {
 func (UnimplementedNeonServer) AddFeed(context.Context, *AddFeedRequest) (*AddFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFeed not implemented")
 }
+func (UnimplementedNeonServer) DiscoverFeeds(context.Context, *DiscoverFeedsRequest) (*DiscoverFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverFeeds not implemented")
+}
 func (UnimplementedNeonServer) EditFeeds(context.Context, *EditFeedsRequest) (*EditFeedsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditFeeds not implemented")
 }
@@ -294,6 +515,30 @@ func (UnimplementedNeonServer) PullFeeds(*PullFeedsRequest, Neon_PullFeedsServer
 func (UnimplementedNeonServer) DeleteFeeds(context.Context, *DeleteFeedsRequest) (*DeleteFeedsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeeds not implemented")
 }
+func (UnimplementedNeonServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedNeonServer) ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFolders not implemented")
+}
+func (UnimplementedNeonServer) EditFolders(context.Context, *EditFoldersRequest) (*EditFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditFolders not implemented")
+}
+func (UnimplementedNeonServer) DeleteFolders(context.Context, *DeleteFoldersRequest) (*DeleteFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolders not implemented")
+}
+func (UnimplementedNeonServer) CreateRule(context.Context, *CreateRuleRequest) (*CreateRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRule not implemented")
+}
+func (UnimplementedNeonServer) ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRules not implemented")
+}
+func (UnimplementedNeonServer) DeleteRules(context.Context, *DeleteRulesRequest) (*DeleteRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRules not implemented")
+}
+func (UnimplementedNeonServer) MatchRule(context.Context, *MatchRuleRequest) (*MatchRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchRule not implemented")
+}
 func (UnimplementedNeonServer) StreamEntries(*StreamEntriesRequest, Neon_StreamEntriesServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamEntries not implemented")
 }
@@ -306,6 +551,24 @@ func (UnimplementedNeonServer) EditEntries(context.Context, *EditEntriesRequest)
 func (UnimplementedNeonServer) GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
 }
+func (UnimplementedNeonServer) FetchEntryContent(context.Context, *FetchEntryContentRequest) (*FetchEntryContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchEntryContent not implemented")
+}
+func (UnimplementedNeonServer) SearchEntries(context.Context, *SearchEntriesRequest) (*SearchEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEntries not implemented")
+}
+func (UnimplementedNeonServer) PruneEntries(context.Context, *PruneEntriesRequest) (*PruneEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneEntries not implemented")
+}
+func (UnimplementedNeonServer) ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRetentionPolicies not implemented")
+}
+func (UnimplementedNeonServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedNeonServer) DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*DeleteRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetentionPolicy not implemented")
+}
 func (UnimplementedNeonServer) ExportOPML(context.Context, *ExportOPMLRequest) (*ExportOPMLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportOPML not implemented")
 }
@@ -349,6 +612,24 @@ func _Neon_AddFeed_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Neon_DiscoverFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).DiscoverFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_DiscoverFeeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).DiscoverFeeds(ctx, req.(*DiscoverFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Neon_EditFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EditFeedsRequest)
 	if err := dec(in); err != nil {
@@ -424,6 +705,150 @@ func _Neon_DeleteFeeds_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Neon_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_CreateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_ListFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).ListFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_ListFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).ListFolders(ctx, req.(*ListFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_EditFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).EditFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_EditFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).EditFolders(ctx, req.(*EditFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_DeleteFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).DeleteFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_DeleteFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).DeleteFolders(ctx, req.(*DeleteFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_CreateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).CreateRule(ctx, req.(*CreateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_ListRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_DeleteRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).DeleteRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_DeleteRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).DeleteRules(ctx, req.(*DeleteRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_MatchRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).MatchRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_MatchRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).MatchRule(ctx, req.(*MatchRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Neon_StreamEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamEntriesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -499,6 +924,114 @@ func _Neon_GetEntry_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Neon_FetchEntryContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchEntryContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).FetchEntryContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_FetchEntryContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).FetchEntryContent(ctx, req.(*FetchEntryContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_SearchEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).SearchEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_SearchEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).SearchEntries(ctx, req.(*SearchEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_PruneEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).PruneEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_PruneEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).PruneEntries(ctx, req.(*PruneEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_ListRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRetentionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).ListRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_ListRetentionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).ListRetentionPolicies(ctx, req.(*ListRetentionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_SetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Neon_DeleteRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NeonServer).DeleteRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Neon_DeleteRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NeonServer).DeleteRetentionPolicy(ctx, req.(*DeleteRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Neon_ExportOPML_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportOPMLRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +1115,10 @@ var Neon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddFeed",
 			Handler:    _Neon_AddFeed_Handler,
 		},
+		{
+			MethodName: "DiscoverFeeds",
+			Handler:    _Neon_DiscoverFeeds_Handler,
+		},
 		{
 			MethodName: "EditFeeds",
 			Handler:    _Neon_EditFeeds_Handler,
@@ -594,6 +1131,38 @@ var Neon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFeeds",
 			Handler:    _Neon_DeleteFeeds_Handler,
 		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _Neon_CreateFolder_Handler,
+		},
+		{
+			MethodName: "ListFolders",
+			Handler:    _Neon_ListFolders_Handler,
+		},
+		{
+			MethodName: "EditFolders",
+			Handler:    _Neon_EditFolders_Handler,
+		},
+		{
+			MethodName: "DeleteFolders",
+			Handler:    _Neon_DeleteFolders_Handler,
+		},
+		{
+			MethodName: "CreateRule",
+			Handler:    _Neon_CreateRule_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _Neon_ListRules_Handler,
+		},
+		{
+			MethodName: "DeleteRules",
+			Handler:    _Neon_DeleteRules_Handler,
+		},
+		{
+			MethodName: "MatchRule",
+			Handler:    _Neon_MatchRule_Handler,
+		},
 		{
 			MethodName: "ListEntries",
 			Handler:    _Neon_ListEntries_Handler,
@@ -606,6 +1175,30 @@ var Neon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEntry",
 			Handler:    _Neon_GetEntry_Handler,
 		},
+		{
+			MethodName: "FetchEntryContent",
+			Handler:    _Neon_FetchEntryContent_Handler,
+		},
+		{
+			MethodName: "SearchEntries",
+			Handler:    _Neon_SearchEntries_Handler,
+		},
+		{
+			MethodName: "PruneEntries",
+			Handler:    _Neon_PruneEntries_Handler,
+		},
+		{
+			MethodName: "ListRetentionPolicies",
+			Handler:    _Neon_ListRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _Neon_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRetentionPolicy",
+			Handler:    _Neon_DeleteRetentionPolicy_Handler,
+		},
 		{
 			MethodName: "ExportOPML",
 			Handler:    _Neon_ExportOPML_Handler,
@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bow/neon/internal"
+	"github.com/bow/neon/internal/reader/backend"
+	"github.com/bow/neon/internal/tlsutil"
+)
+
+// Keys of the flags for connecting to a server, shared by all client commands.
+const (
+	tlsKey           = "tls"
+	tlsCAKey         = "tls-ca"
+	tlsClientCertKey = "tls-client-cert"
+	tlsClientKeyKey  = "tls-client-key"
+	tlsServerNameKey = "tls-server-name"
+	tokenKey         = "token"
+)
+
+// addClientFlags adds the flags for connecting to a server. The flags only take effect if
+// the given flag, which selects a server to connect to, is set.
+func addClientFlags(flags *pflag.FlagSet, requiredFlag string) {
+	flags.Bool(
+		tlsKey,
+		false,
+		fmt.Sprintf(
+			`connect using TLS, implied by the other TLS flags; requires "%s"`,
+			requiredFlag,
+		),
+	)
+	flags.String(tlsCAKey, "", "CA file for verifying the server; system CAs are used if unset")
+	flags.String(tlsClientCertKey, "", "client TLS certificate file, for mutual TLS")
+	flags.String(tlsClientKeyKey, "", "client TLS private key file, for mutual TLS")
+	flags.String(tlsServerNameKey, "", "server name used for verifying the server certificate")
+	flags.String(
+		tokenKey,
+		"",
+		fmt.Sprintf(
+			`API token for the server, also read from $%s; requires "%s"`,
+			internal.EnvKey(tokenKey),
+			requiredFlag,
+		),
+	)
+}
+
+// bindClientEnv binds the token to the environment variables of the given command and to the
+// variable shared by all clients.
+func bindClientEnv(v *viper.Viper, cmdName string) {
+	err := v.BindEnv(tokenKey, internal.EnvKey(cmdName+"-"+tokenKey), internal.EnvKey(tokenKey))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// clientDialOpts returns the dial options for connecting to a server, according to the flags
+// added by addClientFlags.
+func clientDialOpts(v *viper.Viper) ([]grpc.DialOption, error) {
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	useTLS := v.GetBool(tlsKey)
+	for _, key := range []string{tlsCAKey, tlsClientCertKey, tlsClientKeyKey, tlsServerNameKey} {
+		useTLS = useTLS || v.GetString(key) != ""
+	}
+	if useTLS {
+		tlsConfig, err := tlsutil.ClientConfig(
+			v.GetString(tlsCAKey),
+			v.GetString(tlsClientCertKey),
+			v.GetString(tlsClientKeyKey),
+			v.GetString(tlsServerNameKey),
+		)
+		if err != nil {
+			return nil, err
+		}
+		dialOpts = []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		}
+	}
+	if token := v.GetString(tokenKey); token != "" {
+		dialOpts = append(dialOpts, backend.WithToken(token))
+	}
+
+	return dialOpts, nil
+}
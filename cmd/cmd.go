@@ -71,3 +71,5 @@ func fmtOrEmpty(p *time.Time) string {
 func fmtTime(value time.Time) string {
 	return value.Local().Format("2 January 2006 • 15:04 MST")
 }
+
+func pointer[T any](value T) *T { return &value }
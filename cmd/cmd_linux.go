@@ -6,9 +6,12 @@
 package cmd
 
 import (
+	"path/filepath"
 	"strings"
 
 	"github.com/adrg/xdg"
+
+	"github.com/bow/neon/internal"
 )
 
 var defaultDBPath = "$XDG_DATA_HOME/neon/neon.db"
@@ -28,3 +31,8 @@ func resolveDBPath(path string) (string, error) {
 	}
 	return path, nil
 }
+
+// configDir returns the directory where the configuration file is looked up.
+func configDir() (string, error) {
+	return filepath.Join(xdg.ConfigHome, internal.AppName()), nil
+}
@@ -21,6 +21,14 @@ func resolveDBPath(path string) (string, error) {
 	return "", fmt.Errorf("not yet supported")
 }
 
+func configDir() (string, error) {
+	cd, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cd, internal.AppName()), nil
+}
+
 func stateDir() (string, error) {
 	cd, err := os.UserCacheDir()
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
@@ -51,19 +52,97 @@ func TestServer(t *testing.T) {
 
 	require.False(t, dbExists())
 
+	errCh := make(chan error, 1)
 	go func() {
 		cmd, _, _ := newCommand() // nolint: contextcheck
 		cmd.SetArgs([]string{"server", "-a", "tcp://:0", "-d", dbPath})
-
-		err = cmd.ExecuteContext(ctx)
-		if err != nil && err != context.Canceled {
-			require.NoError(t, err)
-		}
+		errCh <- cmd.ExecuteContext(ctx)
 	}()
 
 	require.Eventually(t, dbExists, 5*time.Second, 250*time.Millisecond)
-	// Kill the server once we have ensured the db is created.
+	// Kill the server once we have ensured the db is created, and wait for it to exit.
 	cancel()
+	err = <-errCh
+	if err != nil && !errors.Is(err, context.Canceled) {
+		require.NoError(t, err)
+	}
+}
+
+func TestFeedRemoteOk(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	dbPath := filepath.Join(createTestDir(t, ""), "test.db")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cmd, _, _ := newCommand() // nolint: contextcheck
+		cmd.SetArgs([]string{"server", "-a", "tcp://" + addr, "-d", dbPath})
+		_ = cmd.ExecuteContext(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	listFeeds := func() bool {
+		stdout, _, lerr := execCommand([]string{"feed", "--server", addr, "-o", "json", "list"})
+		return lerr == nil && stdout == "[]\n"
+	}
+	require.Eventually(t, listFeeds, 5*time.Second, 250*time.Millisecond)
+
+	_, _, err = execCommand(
+		[]string{"feed", "--server", addr, "retention", "set", "--max-entries", "5"},
+	)
+	require.NoError(t, err)
+
+	stdout, _, err := execCommand(
+		[]string{"feed", "--server", addr, "-o", "json", "retention", "list"},
+	)
+	require.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`[{"feed_id": null, "max_age": null, "max_entries": 5,`+
+			` "keep_bookmarked": true, "keep_unread": false}]`,
+		stdout,
+	)
+}
+
+func TestFeedImportRemoveOutputOk(t *testing.T) {
+	var (
+		td       = createTestDir(t, "")
+		dbPath   = filepath.Join(td, "test.db")
+		opmlPath = filepath.Join(td, "feeds.opml")
+	)
+	opml := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>Feeds</title></head>
+  <body>
+    <outline text="Feed A" type="rss" xmlUrl="http://a.com/feed.xml"/>
+    <outline text="Feed B" type="rss" xmlUrl="http://b.com/feed.xml"/>
+  </body>
+</opml>`
+	require.NoError(t, os.WriteFile(opmlPath, []byte(opml), 0o600))
+
+	stdout, _, err := execCommand([]string{"feed", "-d", dbPath, "-o", "json", "import", opmlPath})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"num_processed": 2, "num_imported": 2}`, stdout)
+
+	stdout, _, err = execCommand(
+		[]string{"feed", "-d", dbPath, "-o", "template={{.feed_url}}", "remove", "--dry-run", "1"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "http://a.com/feed.xml\n", stdout)
+
+	// Nothing is removed in a dry run.
+	stdout, _, err = execCommand([]string{"feed", "-d", dbPath, "-o", "ndjson", "list"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(stdout, "\n"))
 }
 
 func TestVersionOk(t *testing.T) {
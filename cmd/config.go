@@ -0,0 +1,471 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/bow/neon/internal"
+)
+
+const (
+	configKey   = "config"
+	profileKey  = "profile"
+	profilesKey = "profiles"
+
+	logLevelKey = "level"
+	logStyleKey = "style"
+
+	// skipConfigAnnotation marks commands that load the configuration file themselves.
+	skipConfigAnnotation = "skip-config"
+)
+
+// configFileNames are the names of the configuration file that are looked up, in order.
+var configFileNames = []string{"config.toml", "config.yaml", "config.yml"}
+
+// configHelp describes the configuration file.
+const configHelp = `View or create the configuration file
+
+The configuration file sets the defaults of command-line flags. It is read
+from the file given with '--config' or $NEON_CONFIG, or otherwise from the
+first of config.toml, config.yaml, or config.yml found in $XDG_CONFIG_HOME/neon.
+
+The file has one section for each of the 'feed', 'reader', and 'server'
+commands, whose settings are named like their flags, and a 'logging' section
+with the 'level' and 'style' settings. For example:
+
+  [feed]
+  db-path = "/data/neon.db"
+  output = "json"
+
+  [logging]
+  level = "debug"
+
+Profiles are named sets of sections that take precedence over the top-level
+ones. A profile is selected with '--profile', $NEON_PROFILE, or the top-level
+'profile' setting:
+
+  profile = "home"
+
+  [profiles.home.feed]
+  server = "127.0.0.1:5151"
+
+  [profiles.work.feed]
+  server = "neon.example.com:5151"
+  tls = true
+
+Flags take precedence over environment variables, which take precedence over
+the configuration file.`
+
+// configSection is a section of the configuration file, holding the settings of a command.
+type configSection struct {
+	name string
+	// envName is the name that prefixes the environment variables of the section settings.
+	envName string
+	// flags creates the flags that the section settings set.
+	flags func() *pflag.FlagSet
+	// check validates the settings after they have been set to the flags, if not nil.
+	check func(flags *pflag.FlagSet) error
+}
+
+// configSections returns all sections of the configuration file, sorted by name.
+func configSections() []configSection {
+	return []configSection{
+		{
+			name:    "feed",
+			envName: "feed",
+			flags:   func() *pflag.FlagSet { return newFeedCommand().PersistentFlags() },
+		},
+		{
+			name:    "logging",
+			envName: "log",
+			flags:   newLoggingFlags,
+			check: func(flags *pflag.FlagSet) error {
+				return internal.ValidateLogging(
+					flags.Lookup(logLevelKey).Value.String(),
+					flags.Lookup(logStyleKey).Value.String(),
+				)
+			},
+		},
+		{
+			name:    "reader",
+			envName: "reader",
+			flags:   func() *pflag.FlagSet { return newReaderCommand().Flags() },
+		},
+		{
+			name:    "server",
+			envName: "server",
+			flags:   func() *pflag.FlagSet { return newServerCommand().Flags() },
+		},
+	}
+}
+
+func findConfigSection(name string) (configSection, bool) {
+	for _, section := range configSections() {
+		if section.name == name {
+			return section, true
+		}
+	}
+	return configSection{}, false
+}
+
+// newLoggingFlags creates the flags of the logging settings, which are otherwise only set with
+// environment variables.
+func newLoggingFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet("logging", pflag.ContinueOnError)
+	flags.String(logLevelKey, internal.DefaultLogLevel, "log level")
+	flags.String(logStyleKey, internal.DefaultLogStyle, "log style: pretty or json")
+	return flags
+}
+
+// config is the configuration file.
+type config struct {
+	// path is the location of the file, which may not exist.
+	path string
+	// explicit is whether the location was given by the user instead of looked up.
+	explicit bool
+	// exists is whether the file exists.
+	exists bool
+	// profile is the name of the selected profile, empty if there is none.
+	profile string
+
+	file *viper.Viper
+}
+
+// newConfig creates a configuration file at the given location, or at the first existing
+// default location if it is empty. The file is not read until load is called.
+func newConfig(path, profile string) (*config, error) {
+	cfg := config{path: path, explicit: path != "", profile: profile, file: viper.New()}
+
+	if cfg.explicit {
+		_, err := os.Stat(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		cfg.exists = err == nil
+		return &cfg, nil
+	}
+
+	dir, err := configDir()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range configFileNames {
+		candidate := filepath.Join(dir, name)
+		_, err := os.Stat(candidate)
+		if err == nil {
+			cfg.path = candidate
+			cfg.exists = true
+			return &cfg, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+	cfg.path = filepath.Join(dir, configFileNames[0])
+
+	return &cfg, nil
+}
+
+// load reads and validates the configuration file. A missing file is only an error if its
+// location was given by the user.
+func (c *config) load() error {
+	if !c.exists {
+		if c.explicit {
+			return fmt.Errorf("configuration file %s not found", c.path)
+		}
+		if c.profile != "" {
+			return fmt.Errorf(
+				"profile %q is selected, but there is no configuration file",
+				c.profile,
+			)
+		}
+		return nil
+	}
+
+	c.file.SetConfigFile(c.path)
+	if err := c.file.ReadInConfig(); err != nil {
+		return fmt.Errorf("can not read configuration file %s: %w", c.path, err)
+	}
+	if c.profile == "" {
+		c.profile = c.file.GetString(profileKey)
+	}
+
+	return c.validate()
+}
+
+// validate returns all problems of the configuration file as a single error.
+func (c *config) validate() error {
+	var (
+		errs     []error
+		settings = c.file.AllSettings()
+	)
+
+	for _, key := range sortedKeys(settings) {
+		value := settings[key]
+		switch key {
+		case profileKey:
+			if _, ok := value.(string); !ok {
+				errs = append(errs, fmt.Errorf("setting %q must be a string", key))
+			}
+		case profilesKey:
+			profiles, ok := value.(map[string]any)
+			if !ok {
+				errs = append(errs, fmt.Errorf("setting %q must be a table", key))
+				continue
+			}
+			for _, name := range sortedKeys(profiles) {
+				errs = append(errs, validateProfile(name, profiles[name])...)
+			}
+		default:
+			errs = append(errs, validateSection(key, key, value)...)
+		}
+	}
+
+	if c.profile != "" && !c.file.IsSet(profilesKey+"."+c.profile) {
+		errs = append(errs, fmt.Errorf("profile %q not found", c.profile))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid configuration file %s:\n%w", c.path, err)
+	}
+	return nil
+}
+
+func validateProfile(name string, value any) []error {
+	where := profilesKey + "." + name
+	sections, ok := value.(map[string]any)
+	if !ok {
+		return []error{fmt.Errorf("profile %q must be a table", name)}
+	}
+	var errs []error
+	for _, key := range sortedKeys(sections) {
+		errs = append(errs, validateSection(key, where+"."+key, sections[key])...)
+	}
+	return errs
+}
+
+// validateSection checks that the given section value only has known settings, with values that
+// are valid for their flags. The where argument is the full key of the section.
+func validateSection(name, where string, value any) []error {
+	section, found := findConfigSection(name)
+	if !found {
+		return []error{fmt.Errorf("unknown section %q", where)}
+	}
+	if value == nil {
+		return nil
+	}
+	settings, ok := value.(map[string]any)
+	if !ok {
+		return []error{fmt.Errorf("section %q must be a table", where)}
+	}
+
+	var (
+		errs  []error
+		flags = section.flags()
+	)
+	for _, key := range sortedKeys(settings) {
+		if flags.Lookup(key) == nil {
+			errs = append(errs, fmt.Errorf("unknown setting %q in section %q", key, where))
+			continue
+		}
+		if err := flags.Set(key, fmtConfigValue(settings[key])); err != nil {
+			errs = append(errs, fmt.Errorf("invalid setting %q in section %q: %w", key, where, err))
+		}
+	}
+	if len(errs) == 0 && section.check != nil {
+		if err := section.check(flags); err != nil {
+			errs = append(errs, fmt.Errorf("invalid section %q: %w", where, err))
+		}
+	}
+
+	return errs
+}
+
+// settings returns the settings of the given section, where the settings of the selected
+// profile take precedence over the top-level ones.
+func (c *config) settings(section string) map[string]any {
+	settings := make(map[string]any)
+	for key, value := range c.file.GetStringMap(section) {
+		settings[key] = value
+	}
+	if c.profile != "" {
+		for key, value := range c.file.GetStringMap(c.profileKey(section)) {
+			settings[key] = value
+		}
+	}
+	return settings
+}
+
+// profileKey returns the full key of the given section of the selected profile.
+func (c *config) profileKey(section string) string {
+	return strings.Join([]string{profilesKey, c.profile, section}, ".")
+}
+
+// apply sets the settings of the given section as the configured values of the given viper, so
+// that they take precedence over flag defaults, but not over set flags or environment variables.
+func (c *config) apply(v *viper.Viper, section string) error {
+	return v.MergeConfigMap(c.settings(section))
+}
+
+// lookup returns the configured value of the given setting and where it was set. It returns
+// false if the setting is not configured, in which case the flag default applies.
+func (c *config) lookup(section configSection, key string) (any, string, bool) {
+	envKeys := []string{internal.EnvKey(section.envName + "-" + key)}
+	if key == tokenKey {
+		envKeys = append(envKeys, internal.EnvKey(tokenKey))
+	}
+	for _, envKey := range envKeys {
+		if value := os.Getenv(envKey); value != "" {
+			return value, fmt.Sprintf("env %s", envKey), true
+		}
+	}
+
+	if c.profile != "" {
+		if value, found := c.file.GetStringMap(c.profileKey(section.name))[key]; found {
+			return value, fmt.Sprintf("profile %s", c.profile), true
+		}
+	}
+	if value, found := c.file.GetStringMap(section.name)[key]; found {
+		return value, "file", true
+	}
+
+	return nil, "", false
+}
+
+// setupLogging sets up logging again if the configuration has logging settings, since logging
+// is set up from the environment before the configuration file is read.
+func (c *config) setupLogging(w io.Writer) error {
+	const name = "logging"
+	if len(c.settings(name)) == 0 {
+		return nil
+	}
+
+	section, _ := findConfigSection(name)
+	v := newViper(section.envName)
+	if err := v.BindPFlags(section.flags()); err != nil {
+		return err
+	}
+	if err := c.apply(v, name); err != nil {
+		return err
+	}
+
+	return internal.SetupLogging(v.GetString(logLevelKey), v.GetString(logStyleKey), w)
+}
+
+// fmtConfigValue formats the given value of the configuration file as a flag value.
+func fmtConfigValue(value any) string {
+	switch v := value.(type) {
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmtConfigValue(item)
+		}
+		return strings.Join(items, ",")
+	case map[string]any:
+		items := make([]string, 0, len(v))
+		for _, key := range sortedKeys(v) {
+			items = append(items, fmt.Sprintf("%s=%s", key, fmtConfigValue(v[key])))
+		}
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// fmtConfigLiteral formats the value of the given flag as a literal of the given configuration
+// file format, either "toml" or "yaml".
+func fmtConfigLiteral(flags *pflag.FlagSet, name, format string) (string, error) {
+	f := flags.Lookup(name)
+	switch f.Value.Type() {
+	case "bool", "int", "int32", "int64", "uint", "uint32", "uint64", "float64":
+		return f.Value.String(), nil
+	case "stringToString":
+		values, err := flags.GetStringToString(name)
+		if err != nil {
+			return "", err
+		}
+		sep := " = "
+		if format == "yaml" {
+			sep = ": "
+		}
+		items := make([]string, 0, len(values))
+		for _, key := range sortedKeys(values) {
+			items = append(
+				items,
+				fmt.Sprintf("%s%s%s", quoteConfig(key), sep, quoteConfig(values[key])),
+			)
+		}
+		return fmt.Sprintf("{%s}", strings.Join(items, ", ")), nil
+	default:
+		return quoteConfig(f.Value.String()), nil
+	}
+}
+
+// quoteConfig quotes the given string, such that it is valid in both TOML and YAML.
+func quoteConfig(value string) string {
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		panic(err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func newConfigCommand() *cobra.Command {
+
+	const name = "config"
+
+	command := cobra.Command{
+		Use:     name,
+		Aliases: makeAlias(name),
+		Short:   "View or create the configuration file",
+		Long:    configHelp,
+	}
+
+	command.AddCommand(newConfigInitCommand())
+	command.AddCommand(newConfigShowCommand())
+	command.AddCommand(newConfigValidateCommand())
+
+	return &command
+}
+
+// applyConfig sets the settings of the given section of the configuration file as the configured
+// values of the given viper.
+func applyConfig(cmd *cobra.Command, v *viper.Viper, section string) error {
+	cfg, err := configFromCmdCtx(cmd)
+	if err != nil {
+		return err
+	}
+	return cfg.apply(v, section)
+}
+
+func configToCmdCtx(cmd *cobra.Command, cfg *config) {
+	toCmdContext(cmd, configKey, cfg)
+}
+
+func configFromCmdCtx(cmd *cobra.Command) (*config, error) {
+	return fromCmdContext[*config](cmd, configKey)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func newConfigInitCommand() *cobra.Command {
+
+	const (
+		name     = "init"
+		forceKey = "force"
+	)
+	var v = newViper("config-" + name)
+
+	command := cobra.Command{
+		Use:   name,
+		Short: "Create a configuration file",
+		Long: `Create a configuration file
+
+The file lists all settings with their defaults, commented out. It is written
+to the location the configuration is read from, in TOML unless the location
+ends with '.yaml' or '.yml'.`,
+		Annotations: map[string]string{skipConfigAnnotation: ""},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+
+			cfg, err := configFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			if cfg.exists && !v.GetBool(forceKey) {
+				return fmt.Errorf(
+					"configuration file %s already exists; use --%s to overwrite it",
+					cfg.path,
+					forceKey,
+				)
+			}
+
+			format := "toml"
+			switch filepath.Ext(cfg.path) {
+			case ".yaml", ".yml":
+				format = "yaml"
+			}
+
+			var buf bytes.Buffer
+			if err = writeConfigTemplate(&buf, format); err != nil {
+				return err
+			}
+			if err = os.MkdirAll(filepath.Dir(cfg.path), 0o755); err != nil {
+				return err
+			}
+			// The file may hold API tokens, so it is only readable by its owner.
+			if err = os.WriteFile(cfg.path, buf.Bytes(), 0o600); err != nil {
+				return err
+			}
+
+			log.Info().Str("path", cfg.path).Msg("created configuration file")
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+	flags.BoolP(forceKey, "f", false, "overwrite an existing configuration file")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+// writeConfigTemplate writes a configuration file in the given format with all settings set to
+// their defaults and commented out.
+func writeConfigTemplate(w io.Writer, format string) error {
+
+	fmt.Fprint(w, `# Configuration file of neon, created by 'neon config init'.
+#
+# Uncomment and change the settings to override their defaults. Profiles, which
+# are selected with '--profile', are written in the same way under 'profiles'.
+# See 'neon config --help' for details.
+`)
+
+	for _, section := range configSections() {
+		if format == "yaml" {
+			fmt.Fprintf(w, "\n%s:\n", section.name)
+		} else {
+			fmt.Fprintf(w, "\n[%s]\n", section.name)
+		}
+
+		var (
+			err   error
+			first = true
+			flags = section.flags()
+		)
+		flags.VisitAll(func(f *pflag.Flag) {
+			if err != nil {
+				return
+			}
+			var literal string
+			if literal, err = fmtConfigLiteral(flags, f.Name, format); err != nil {
+				return
+			}
+			if !first {
+				fmt.Fprintln(w)
+			}
+			first = false
+			if format == "yaml" {
+				fmt.Fprintf(w, "  # %s\n  # %s: %s\n", f.Usage, f.Name, literal)
+			} else {
+				fmt.Fprintf(w, "# %s\n# %s = %s\n", f.Usage, f.Name, literal)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func newConfigShowCommand() *cobra.Command {
+
+	const name = "show"
+
+	command := cobra.Command{
+		Use:     name,
+		Aliases: makeAlias(name),
+		Short:   "Show the effective configuration",
+		Long: `Show the effective configuration
+
+All settings are shown with their effective values, each followed by where the
+value was set: an environment variable, the selected profile, the top-level
+sections of the configuration file, or the flag default. Flags of the command
+being run take precedence over all of these. Tokens are not shown.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+
+			cfg, err := configFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			return showConfig(cmd.OutOrStdout(), cfg)
+		},
+	}
+
+	return &command
+}
+
+func showConfig(w io.Writer, cfg *config) error {
+
+	if cfg.exists {
+		fmt.Fprintf(w, "# file: %s\n", cfg.path)
+	} else {
+		fmt.Fprintf(w, "# file: %s (not found)\n", cfg.path)
+	}
+	if cfg.profile != "" {
+		fmt.Fprintf(w, "# profile: %s\n", cfg.profile)
+	}
+
+	for _, section := range configSections() {
+		fmt.Fprintf(w, "\n[%s]\n", section.name)
+
+		var (
+			err   error
+			tw    = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+			flags = section.flags()
+		)
+		flags.VisitAll(func(f *pflag.Flag) {
+			if err != nil {
+				return
+			}
+			source := "default"
+			if value, from, found := cfg.lookup(section, f.Name); found {
+				if err = flags.Set(f.Name, fmtConfigValue(value)); err != nil {
+					err = fmt.Errorf("invalid setting %q from %s: %w", f.Name, from, err)
+					return
+				}
+				source = from
+			}
+			var literal string
+			if literal, err = fmtConfigLiteral(flags, f.Name, "toml"); err != nil {
+				return
+			}
+			if f.Name == tokenKey && f.Value.String() != "" {
+				literal = quoteConfig("<hidden>")
+			}
+			fmt.Fprintf(tw, "%s = %s\t# %s\n", f.Name, literal, source)
+		})
+		if err != nil {
+			return err
+		}
+		if err = tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestConfig writes the given content to a configuration file with the given name in a
+// temporary directory and returns its path.
+func writeTestConfig(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(createTestDir(t, ""), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestConfigInitOk(t *testing.T) {
+	a := assert.New(t)
+	r := require.New(t)
+
+	for _, name := range []string{"config.toml", "config.yaml"} {
+		path := filepath.Join(createTestDir(t, ""), "neon", name)
+
+		_, _, err := execCommand([]string{"--config", path, "config", "init"})
+		r.NoError(err)
+		r.FileExists(path)
+
+		stdout, _, err := execCommand([]string{"--config", path, "config", "validate"})
+		r.NoError(err)
+		a.Equal("Configuration file "+path+" is valid\n", stdout)
+
+		_, _, err = execCommand([]string{"--config", path, "config", "init"})
+		a.ErrorContains(err, "already exists")
+
+		_, _, err = execCommand([]string{"--config", path, "config", "init", "--force"})
+		a.NoError(err)
+	}
+}
+
+func TestConfigFeedProfileOk(t *testing.T) {
+	a := assert.New(t)
+	r := require.New(t)
+
+	dir := createTestDir(t, "")
+	path := writeTestConfig(t, "config.toml", `
+profile = "home"
+
+[feed]
+db-path = "`+filepath.Join(dir, "base.db")+`"
+output = "json"
+
+[profiles.home.feed]
+db-path = "`+filepath.Join(dir, "home.db")+`"
+`)
+
+	stdout, _, err := execCommand([]string{"--config", path, "feed", "list"})
+	r.NoError(err)
+	a.Equal("[]\n", stdout)
+	a.FileExists(filepath.Join(dir, "home.db"))
+	a.NoFileExists(filepath.Join(dir, "base.db"))
+
+	stdout, _, err = execCommand(
+		[]string{"--config", path, "feed", "-d", filepath.Join(dir, "flag.db"), "-o", "csv", "list"},
+	)
+	r.NoError(err)
+	a.Empty(stdout)
+	a.FileExists(filepath.Join(dir, "flag.db"))
+	a.NoFileExists(filepath.Join(dir, "base.db"))
+}
+
+func TestConfigShowOk(t *testing.T) {
+	a := assert.New(t)
+	r := require.New(t)
+
+	t.Setenv("NEON_FEED_OUTPUT", "yaml")
+	path := writeTestConfig(t, "config.yaml", `
+feed:
+  output: json
+  db-path: /data/neon.db
+server:
+  pull-interval-feed:
+    "2": 1h
+profiles:
+  work:
+    feed:
+      server: neon.example.com:5151
+      token: secret
+`)
+
+	stdout, _, err := execCommand(
+		[]string{"--config", path, "--profile", "work", "config", "show"},
+	)
+	r.NoError(err)
+
+	a.Contains(stdout, "# file: "+path+"\n# profile: work\n")
+	a.Regexp(`db-path = "/data/neon.db" +# file\n`, stdout)
+	a.Regexp(`output = "yaml" +# env NEON_FEED_OUTPUT\n`, stdout)
+	a.Regexp(`server = "neon.example.com:5151" +# profile work\n`, stdout)
+	a.Regexp(`token = "<hidden>" +# profile work\n`, stdout)
+	a.Regexp(`pull-interval-feed = \{"2" = "1h"\} +# file\n`, stdout)
+	a.Regexp(`level = "info" +# default\n`, stdout)
+	a.NotContains(stdout, "secret")
+}
+
+func TestConfigValidateErr(t *testing.T) {
+	path := writeTestConfig(t, "config.toml", `
+profile = "home"
+
+[feed]
+outptu = "json"
+
+[server]
+pull-interval = 30
+
+[logging]
+level = "loud"
+
+[profiles.work.reeder]
+connect = true
+`)
+
+	for _, args := range [][]string{
+		{"--config", path, "config", "validate"},
+		{"--config", path, "feed", "list"},
+	} {
+		_, _, err := execCommand(args)
+		assert.ErrorContains(t, err, `unknown setting "outptu" in section "feed"`)
+		assert.ErrorContains(t, err, `invalid setting "pull-interval" in section "server"`)
+		assert.ErrorContains(t, err, `invalid section "logging": invalid log level 'loud'`)
+		assert.ErrorContains(t, err, `unknown section "profiles.work.reeder"`)
+		assert.ErrorContains(t, err, `profile "home" not found`)
+	}
+}
+
+func TestConfigErrNotFound(t *testing.T) {
+	path := filepath.Join(createTestDir(t, ""), "config.toml")
+
+	_, _, err := execCommand([]string{"--config", path, "version"})
+
+	assert.EqualError(t, err, "configuration file "+path+" not found")
+}
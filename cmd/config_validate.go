@@ -0,0 +1,42 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newConfigValidateCommand() *cobra.Command {
+
+	const name = "validate"
+
+	command := cobra.Command{
+		Use:         name,
+		Aliases:     makeAlias(name),
+		Short:       "Check the configuration file",
+		Annotations: map[string]string{skipConfigAnnotation: ""},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+
+			cfg, err := configFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			if err = cfg.load(); err != nil {
+				return err
+			}
+
+			if !cfg.exists {
+				fmt.Fprintf(cmd.OutOrStdout(), "No configuration file found at %s\n", cfg.path)
+				return nil
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Configuration file %s is valid\n", cfg.path)
+
+			return nil
+		},
+	}
+
+	return &command
+}
@@ -4,11 +4,21 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/reader/backend"
+	"github.com/bow/neon/internal/server"
 )
 
+const serverKey = "server"
+
 func newFeedCommand() *cobra.Command {
 
 	const name = "feed"
@@ -18,13 +28,36 @@ func newFeedCommand() *cobra.Command {
 		Use:     name,
 		Aliases: makeAlias(name),
 		Short:   "View or modify feeds",
+		Long:    "View or modify feeds\n\n" + outputHelp,
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 
-			dbPath, err := resolveDBPath(v.GetString(dbPathKey))
+			if err := applyConfig(cmd, v, name); err != nil {
+				return err
+			}
+
+			if addr := v.GetString(serverKey); addr != "" {
+				dialOpts, err := clientDialOpts(v)
+				if err != nil {
+					return err
+				}
+				rpc, err := backend.NewRPC(cmd.Context(), grpcTarget(addr), dialOpts...)
+				if err != nil {
+					return err
+				}
+				toCmdContext(cmd, serverKey, rpc)
+			} else {
+				dbPath, err := resolveDBPath(v.GetString(dbPathKey))
+				if err != nil {
+					return err
+				}
+				dbPathToCmdCtx(cmd, dbPath)
+			}
+
+			out, err := newOutput(v.GetString(outputKey), cmd.OutOrStdout())
 			if err != nil {
 				return err
 			}
-			dbPathToCmdCtx(cmd, dbPath)
+			outputToCmdCtx(cmd, out)
 
 			return nil
 		},
@@ -32,18 +65,42 @@ func newFeedCommand() *cobra.Command {
 
 	pflags := command.PersistentFlags()
 
-	pflags.StringP(dbPathKey, "d", defaultDBPath, "datastore location")
+	pflags.StringP(
+		dbPathKey,
+		"d",
+		defaultDBPath,
+		`datastore location, ignored if "--server" is set`,
+	)
+	pflags.String(serverKey, "", "address of a server to use instead of the local datastore")
+	pflags.StringP(
+		outputKey,
+		"o",
+		defaultOutput,
+		"output format: text, json, ndjson, yaml, csv, or template=TEXT",
+	)
+
+	addClientFlags(pflags, "--server")
 
 	if err := v.BindPFlags(pflags); err != nil {
 		panic(err)
 	}
+	bindClientEnv(v, name)
 
 	command.AddCommand(newFeedAddCommand())
+	command.AddCommand(newFeedBookmarkCommand())
+	command.AddCommand(newFeedEditCommand())
 	command.AddCommand(newFeedExportCommand())
+	command.AddCommand(newFeedFetchContentCommand())
+	command.AddCommand(newFeedFolderCommand())
 	command.AddCommand(newFeedImportCommand())
 	command.AddCommand(newFeedListCommand())
 	command.AddCommand(newFeedPullCommand())
+	command.AddCommand(newFeedPruneCommand())
+	command.AddCommand(newFeedRemoveCommand())
+	command.AddCommand(newFeedRetentionCommand())
 	command.AddCommand(newFeedListEntriesCommand())
+	command.AddCommand(newFeedMarkReadCommand())
+	command.AddCommand(newFeedSearchCommand())
 	command.AddCommand(newFeedShowEntryCommand())
 
 	return &command
@@ -64,3 +121,91 @@ func dbFromCmdCtx(cmd *cobra.Command) (*datastore.SQLite, error) {
 	}
 	return db, nil
 }
+
+// feedStore is the part of the datastore used by the feed commands. It is implemented by the
+// local datastore and by the client of a server.
+type feedStore interface {
+	AddFeed(
+		ctx context.Context,
+		feedURL string,
+		title *string,
+		desc *string,
+		tags []string,
+		isStarred *bool,
+		pullTimeout *time.Duration,
+	) (*entity.Feed, bool, error)
+	EditFeeds(ctx context.Context, ops []*entity.FeedEditOp) ([]*entity.Feed, error)
+	ListFeeds(
+		ctx context.Context,
+		maxEntriesPerFeed *uint32,
+		filter *entity.FeedFilter,
+		order entity.FeedSortOrder,
+		page *entity.Page,
+	) ([]*entity.Feed, string, error)
+	PullFeeds(
+		ctx context.Context,
+		ids []entity.ID,
+		entryReadStatus *bool,
+		maxEntriesPerFeed *uint32,
+		timeoutPerFeed *time.Duration,
+		force bool,
+	) <-chan entity.PullResult
+	DeleteFeeds(ctx context.Context, ids []entity.ID) error
+	ListEntries(
+		ctx context.Context,
+		filter *entity.EntryFilter,
+		order entity.EntrySortOrder,
+		page *entity.Page,
+	) ([]*entity.Entry, string, error)
+	EditEntries(ctx context.Context, ops []*entity.EntryEditOp) ([]*entity.Entry, error)
+	GetEntry(ctx context.Context, id entity.ID) (*entity.Entry, error)
+	FetchEntryContent(
+		ctx context.Context,
+		id entity.ID,
+		timeout *time.Duration,
+	) (*entity.Entry, error)
+	SearchEntries(
+		ctx context.Context,
+		query string,
+		feedIDs []entity.ID,
+		maxResults *uint32,
+	) ([]*entity.SearchResult, error)
+	PruneEntries(
+		ctx context.Context,
+		feedIDs []entity.ID,
+		dryRun bool,
+	) ([]*entity.PruneResult, error)
+	SetRetentionPolicy(ctx context.Context, policy *entity.RetentionPolicy) error
+	DeleteRetentionPolicy(ctx context.Context, feedID *entity.ID) error
+	ListRetentionPolicies(ctx context.Context) ([]*entity.RetentionPolicy, error)
+	ExportSubscription(ctx context.Context, title *string) (*entity.Subscription, error)
+	ImportSubscription(ctx context.Context, sub *entity.Subscription) (int, int, error)
+	CreateFolder(ctx context.Context, name string, parentID *entity.ID) (*entity.Folder, error)
+	ListFolders(ctx context.Context) ([]*entity.Folder, error)
+	EditFolders(ctx context.Context, ops []*entity.FolderEditOp) ([]*entity.Folder, error)
+	DeleteFolders(ctx context.Context, ids []entity.ID) error
+}
+
+// Ensure the local datastore and the server client implement feedStore.
+var (
+	_ feedStore = new(datastore.SQLite)
+	_ feedStore = new(backend.RPC)
+)
+
+// feedStoreFromCmdCtx returns the client of the server selected with the server flag, or the
+// local datastore if no server was selected.
+func feedStoreFromCmdCtx(cmd *cobra.Command) (feedStore, error) {
+	if rpc, err := fromCmdContext[*backend.RPC](cmd, serverKey); err == nil {
+		return rpc, nil
+	}
+	return dbFromCmdCtx(cmd)
+}
+
+// grpcTarget returns the gRPC target of the given server address.
+func grpcTarget(addr string) string {
+	addr = normalizeAddr(addr)
+	if server.IsFileSystemAddr(addr) {
+		return fmt.Sprintf("unix:%s", strings.TrimPrefix(addr, "file://"))
+	}
+	return strings.TrimPrefix(addr, "tcp://")
+}
@@ -4,7 +4,12 @@
 package cmd
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -22,6 +27,7 @@ func newFeedAddCommand() *cobra.Command {
 		starKey    = "star"
 		tagKey     = "tag"
 		timeoutKey = "timeout"
+		pickKey    = "pick"
 	)
 	var v = newViper(name)
 
@@ -30,6 +36,11 @@ func newFeedAddCommand() *cobra.Command {
 		Args:    cobra.ExactArgs(1),
 		Aliases: makeAlias(name),
 		Short:   "Add a new feed",
+		Long: `Add a new feed
+
+The input may be the URL of a feed or of a website publishing a feed. When a
+website publishes more than one feed, the feed to add is prompted for unless
+it is picked using its number in the candidate list.`,
 
 		DisableFlagsInUseLine: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -61,27 +72,40 @@ func newFeedAddCommand() *cobra.Command {
 				pullTimeout = &value
 			}
 
-			db, err := dbFromCmdCtx(cmd)
+			db, err := feedStoreFromCmdCtx(cmd)
 			if err != nil {
 				return err
 			}
 
-			feed, added, err := db.AddFeed(
-				cmd.Context(),
-				url,
-				title,
-				desc,
-				tags,
-				isStarred,
-				pullTimeout,
-			)
+			add := func(url string) (*entity.Feed, bool, error) {
+				return db.AddFeed(
+					cmd.Context(),
+					url,
+					title,
+					desc,
+					tags,
+					isStarred,
+					pullTimeout,
+				)
+			}
+
+			feed, added, err := add(url)
+			var aerr entity.AmbiguousFeedURLError
+			if errors.As(err, &aerr) {
+				var candidate *entity.FeedCandidate
+				candidate, err = pickCandidate(aerr.Candidates, v.GetInt(pickKey), cmd.InOrStdin())
+				if err != nil {
+					return err
+				}
+				feed, added, err = add(candidate.URL)
+			}
 			if err != nil {
 				return err
 			}
 
 			logAddResult(feed, added)
 
-			return nil
+			return writeChangedOne(cmd, entity.ToFeedPb(feed))
 		},
 	}
 
@@ -92,6 +116,7 @@ func newFeedAddCommand() *cobra.Command {
 	flags.Bool(starKey, false, "star the feed")
 	flags.StringArray(tagKey, nil, "feed tags")
 	flags.Duration(timeoutKey, 20*time.Second, "timeout for adding the feed")
+	flags.Int(pickKey, 0, "number of the feed to add when a website publishes several feeds")
 
 	if err := v.BindPFlags(flags); err != nil {
 		panic(err)
@@ -100,6 +125,42 @@ func newFeedAddCommand() *cobra.Command {
 	return &command
 }
 
+// pickCandidate returns the candidate with the given 1-based number, or prompts for the number
+// if it is 0.
+func pickCandidate(
+	candidates []*entity.FeedCandidate,
+	pick int,
+	in io.Reader,
+) (*entity.FeedCandidate, error) {
+
+	if pick == 0 {
+		fmt.Printf("Found %d feeds:\n", len(candidates))
+		for i, candidate := range candidates {
+			if candidate.Title == "" {
+				fmt.Printf("  %d. %s\n", i+1, candidate.URL)
+			} else {
+				fmt.Printf("  %d. %s (%s)\n", i+1, candidate.Title, candidate.URL)
+			}
+		}
+		fmt.Printf("Feed to add [1-%d]: ", len(candidates))
+
+		line, err := bufio.NewReader(in).ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			return nil, fmt.Errorf("no feed picked")
+		}
+		pick, err = strconv.Atoi(strings.TrimSpace(line))
+		if err != nil {
+			return nil, fmt.Errorf("invalid feed number %q", strings.TrimSpace(line))
+		}
+	}
+
+	if pick < 1 || pick > len(candidates) {
+		return nil, fmt.Errorf("feed number must be between 1 and %d", len(candidates))
+	}
+
+	return candidates[pick-1], nil
+}
+
 func logAddResult(feed *entity.Feed, added bool) {
 
 	var msg string
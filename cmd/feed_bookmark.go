@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+func newFeedBookmarkCommand() *cobra.Command {
+
+	const (
+		name      = "bookmark"
+		removeKey = "remove"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s ENTRY-ID...", name),
+		Args:    cobra.MinimumNArgs(1),
+		Aliases: makeAlias(name),
+		Short:   "Add entries to or remove entries from bookmarks",
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			ids, err := entity.ToEntryIDs(sliceutil.Dedup(args))
+			if err != nil {
+				return err
+			}
+
+			isBookmarked := !v.GetBool(removeKey)
+			ops := make([]*entity.EntryEditOp, len(ids))
+			for i, id := range ids {
+				ops[i] = &entity.EntryEditOp{ID: id, IsBookmarked: &isBookmarked}
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := db.EditEntries(cmd.Context(), ops)
+			if err != nil {
+				return err
+			}
+
+			msg := "bookmarked entries"
+			if !isBookmarked {
+				msg = "removed entries from bookmarks"
+			}
+			log.Info().Int("num_entries", len(entries)).Msg(msg)
+
+			return writeChanged(cmd, entity.ToEntryPbs(entries)...)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.BoolP(removeKey, "r", false, "remove entries from bookmarks instead")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func newFeedEditCommand() *cobra.Command {
+
+	const (
+		name         = "edit"
+		titleKey     = "title"
+		descKey      = "desc"
+		starKey      = "star"
+		tagKey       = "tag"
+		clearTagsKey = "clear-tags"
+		folderKey    = "folder"
+		fetchKey     = "fetch-content"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:   fmt.Sprintf("%s FEED-ID", name),
+		Args:  cobra.ExactArgs(1),
+		Short: "Edit a feed",
+		Long: `Edit a feed
+
+Only the fields of the given flags are changed. The description is cleared by
+setting it to an empty value, the feed is unstarred with --star=false, and the
+tags given with --tag replace all existing tags. Tags are removed with
+--clear-tags, and the feed is moved out of its folder with --folder=0.
+
+With --fetch-content, the full article of new entries is fetched from their
+URL when the feed is pulled, replacing the content given by the feed. This is
+turned off with --fetch-content=false.`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			id, err := entity.ToFeedID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var (
+				flags = cmd.Flags()
+				op    = entity.FeedEditOp{ID: id}
+			)
+
+			if flags.Changed(titleKey) {
+				value := v.GetString(titleKey)
+				if value == "" {
+					return fmt.Errorf("feed title can not be empty")
+				}
+				op.Title = &value
+			}
+
+			if flags.Changed(descKey) {
+				value := v.GetString(descKey)
+				op.Description = &value
+			}
+
+			if flags.Changed(starKey) {
+				value := v.GetBool(starKey)
+				op.IsStarred = &value
+			}
+
+			clearTags := v.GetBool(clearTagsKey)
+			if flags.Changed(tagKey) {
+				if clearTags {
+					return fmt.Errorf("only one of --%s and --%s may be set", tagKey, clearTagsKey)
+				}
+				value := v.GetStringSlice(tagKey)
+				op.Tags = &value
+			} else if clearTags {
+				value := make([]string, 0)
+				op.Tags = &value
+			}
+
+			if flags.Changed(folderKey) {
+				value, ferr := entity.ToFolderID(v.GetString(folderKey))
+				if ferr != nil {
+					return ferr
+				}
+				op.FolderID = &value
+			}
+
+			if flags.Changed(fetchKey) {
+				value := v.GetBool(fetchKey)
+				op.FetchContent = &value
+			}
+
+			if op.Title == nil && op.Description == nil && op.IsStarred == nil && op.Tags == nil &&
+				op.FolderID == nil && op.FetchContent == nil {
+				return fmt.Errorf("no changes specified")
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeds, err := db.EditFeeds(cmd.Context(), []*entity.FeedEditOp{&op})
+			if err != nil {
+				return err
+			}
+
+			for _, feed := range feeds {
+				l := log.Info().Uint32("id", feed.ID).Str("title", feed.Title)
+				if feed.IsStarred {
+					l = l.Bool("starred", feed.IsStarred)
+				}
+				if len(feed.Tags) > 0 {
+					l = l.Strs("tags", feed.Tags)
+				}
+				if feed.FolderID != nil {
+					l = l.Uint32("folder_id", *feed.FolderID)
+				}
+				if feed.FetchContent {
+					l = l.Bool("fetch_content", feed.FetchContent)
+				}
+				l.Msg("edited feed")
+			}
+
+			if err = writeChanged(cmd, entity.ToFeedPbs(feeds)...); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringP(titleKey, "t", "", "feed title")
+	flags.String(descKey, "", "feed description; an empty value clears it")
+	flags.Bool(starKey, false, "star or, with --star=false, unstar the feed")
+	flags.StringArray(tagKey, nil, "feed tags, replacing existing tags")
+	flags.Bool(clearTagsKey, false, "remove all feed tags")
+	flags.String(folderKey, "", "ID of the folder to move the feed into")
+	flags.Bool(fetchKey, false, "fetch the full article of new entries when pulling")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
@@ -49,7 +49,7 @@ func newFeedExportCommand() *cobra.Command {
 				}
 			}
 
-			db, err := dbFromCmdCtx(cmd)
+			db, err := feedStoreFromCmdCtx(cmd)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,74 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func newFeedFetchContentCommand() *cobra.Command {
+
+	const (
+		name       = "fetch-content"
+		timeoutKey = "timeout"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s ENTRY-ID", name),
+		Aliases: []string{"fetch-c", "fc"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Fetch the full article of a feed entry",
+		Long: `Fetch the full article of a feed entry
+
+The article is extracted from the page at the entry URL and replaces the
+entry content.`,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			entryID, err := entity.ToEntryID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var timeout *time.Duration
+			if value := v.GetDuration(timeoutKey); value > 0 {
+				timeout = &value
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			entry, err := db.FetchEntryContent(cmd.Context(), entryID, timeout)
+			if err != nil {
+				return err
+			}
+
+			log.Info().
+				Uint32("id", entry.ID).
+				Str("title", entry.Title).
+				Int("content_length", len(derefOrEmpty(entry.Content))).
+				Msg("fetched entry content")
+
+			return writeChangedOne(cmd, entity.ToEntryPb(entry))
+		},
+	}
+
+	flags := command.Flags()
+	flags.Duration(timeoutKey, 20*time.Second, "timeout for fetching the entry page")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+func newFeedFolderCommand() *cobra.Command {
+
+	const name = "folder"
+
+	command := cobra.Command{
+		Use:   name,
+		Short: "View or modify feed folders",
+		Long: `View or modify feed folders
+
+Folders group feeds and may contain other folders. Feeds are moved into folders
+with 'neon feed edit --folder'.`,
+	}
+
+	command.AddCommand(newFeedFolderCreateCommand())
+	command.AddCommand(newFeedFolderEditCommand())
+	command.AddCommand(newFeedFolderListCommand())
+	command.AddCommand(newFeedFolderRemoveCommand())
+
+	return &command
+}
+
+func newFeedFolderCreateCommand() *cobra.Command {
+
+	const (
+		name      = "create"
+		parentKey = "parent"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s NAME", name),
+		Args:    cobra.ExactArgs(1),
+		Aliases: makeAlias(name),
+		Short:   "Create a folder",
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			var parentID *entity.ID
+			if rawID := v.GetString(parentKey); rawID != "" {
+				id, err := entity.ToFolderID(rawID)
+				if err != nil {
+					return err
+				}
+				parentID = &id
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			folder, err := db.CreateFolder(cmd.Context(), args[0], parentID)
+			if err != nil {
+				return err
+			}
+			log.Info().Uint32("id", folder.ID).Str("name", folder.Name).Msg("created folder")
+
+			return writeChangedOne(cmd, entity.ToFolderPb(folder))
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringP(parentKey, "p", "", "ID of the folder to create the folder in")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func newFeedFolderEditCommand() *cobra.Command {
+
+	const (
+		name      = "edit"
+		nameKey   = "name"
+		parentKey = "parent"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:   fmt.Sprintf("%s FOLDER-ID", name),
+		Args:  cobra.ExactArgs(1),
+		Short: "Rename or move a folder",
+		Long: `Rename or move a folder
+
+The folder is moved to the top level with --parent=0.`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			id, err := entity.ToFolderID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var (
+				flags = cmd.Flags()
+				op    = entity.FolderEditOp{ID: id}
+			)
+
+			if flags.Changed(nameKey) {
+				value := v.GetString(nameKey)
+				if value == "" {
+					return fmt.Errorf("folder name can not be empty")
+				}
+				op.Name = &value
+			}
+
+			if flags.Changed(parentKey) {
+				value, perr := entity.ToFolderID(v.GetString(parentKey))
+				if perr != nil {
+					return perr
+				}
+				op.ParentID = &value
+			}
+
+			if op.Name == nil && op.ParentID == nil {
+				return fmt.Errorf("no changes specified")
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			folders, err := db.EditFolders(cmd.Context(), []*entity.FolderEditOp{&op})
+			if err != nil {
+				return err
+			}
+			for _, folder := range folders {
+				log.Info().Uint32("id", folder.ID).Str("name", folder.Name).Msg("edited folder")
+			}
+
+			return writeChanged(cmd, entity.ToFolderPbs(folders)...)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringP(nameKey, "n", "", "folder name")
+	flags.StringP(parentKey, "p", "", "ID of the folder to move the folder into")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func newFeedFolderListCommand() *cobra.Command {
+
+	const name = "list"
+
+	command := cobra.Command{
+		Use:     name,
+		Aliases: makeAlias(name),
+		Short:   "List folders",
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			folders, err := db.ListFolders(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			out, err := outputFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			if !out.isText() {
+				if err = writeProto(out, entity.ToFolderPbs(folders)...); err != nil {
+					return err
+				}
+				return out.flush()
+			}
+
+			fmt.Printf("%s", fmtFolderTree(folders))
+
+			return nil
+		},
+	}
+
+	return &command
+}
+
+func newFeedFolderRemoveCommand() *cobra.Command {
+
+	const name = "remove"
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s FOLDER-ID...", name),
+		Args:    cobra.MinimumNArgs(1),
+		Aliases: []string{"rm"},
+		Short:   "Remove folders",
+		Long: `Remove folders
+
+The given folders are removed together with their subfolders. Their feeds are
+kept, but moved out of any folder.`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			ids, err := entity.ToFolderIDs(sliceutil.Dedup(args))
+			if err != nil {
+				return err
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err = db.DeleteFolders(cmd.Context(), ids); err != nil {
+				return err
+			}
+			log.Info().Int("num_folders", len(ids)).Msg("removed folders")
+
+			return nil
+		},
+	}
+
+	return &command
+}
+
+// fmtFolderTree formats the given folders as a tree, with subfolders indented below their
+// parents.
+func fmtFolderTree(folders []*entity.Folder) string {
+	var (
+		sb       strings.Builder
+		children = entity.FolderChildren(folders)
+	)
+
+	var cat func(parentID entity.ID, depth int)
+	cat = func(parentID entity.ID, depth int) {
+		for _, folder := range children[parentID] {
+			fmt.Fprintf(
+				&sb,
+				"%s\x1b[36m▶\x1b[0m %s (ID=%d)\n",
+				strings.Repeat("  ", depth),
+				folder.Name,
+				folder.ID,
+			)
+			cat(folder.ID, depth+1)
+		}
+	}
+	cat(0, 0)
+
+	return sb.String()
+}
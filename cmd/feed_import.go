@@ -11,6 +11,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
+	"github.com/bow/neon/api"
 	"github.com/bow/neon/internal"
 	"github.com/bow/neon/internal/entity"
 )
@@ -48,7 +49,7 @@ func newFeedImportCommand() *cobra.Command {
 				return err
 			}
 
-			db, err := dbFromCmdCtx(cmd)
+			db, err := feedStoreFromCmdCtx(cmd)
 			if err != nil {
 				return err
 			}
@@ -67,7 +68,11 @@ func newFeedImportCommand() *cobra.Command {
 				Int("num_imported", nimp).
 				Msg("finished feed import")
 
-			return nil
+			rsp := api.ImportOPMLResponse{
+				NumProcessed: uint32(nproc), // #nosec: G115
+				NumImported:  uint32(nimp),  // #nosec: G115
+			}
+			return writeChangedOne(cmd, &rsp)
 		},
 	}
 
@@ -23,15 +23,33 @@ func newFeedListCommand() *cobra.Command {
 		DisableFlagsInUseLine: true,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 
-			db, err := dbFromCmdCtx(cmd)
+			db, err := feedStoreFromCmdCtx(cmd)
 			if err != nil {
 				return err
 			}
 
-			feeds, err := db.ListFeeds(cmd.Context(), nil)
+			feeds, _, err := db.ListFeeds(
+				cmd.Context(),
+				nil,
+				nil,
+				entity.FeedSortUpdateTimeDesc,
+				nil,
+			)
 			if err != nil {
 				return err
 			}
+
+			out, err := outputFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			if !out.isText() {
+				if err = writeProto(out, entity.ToFeedPbs(feeds)...); err != nil {
+					return err
+				}
+				return out.flush()
+			}
+
 			for _, feed := range feeds {
 				fmt.Printf("%s", fmtFeed(feed))
 			}
@@ -56,6 +74,11 @@ func fmtFeed(feed *entity.Feed) string {
 		return "-"
 	}
 
+	var failures string
+	if feed.IsFailing() {
+		failures = fmt.Sprintf("%d", feed.PullFailures)
+	}
+
 	kv := []*struct {
 		k, v string
 	}{
@@ -65,6 +88,9 @@ func fmtFeed(feed *entity.Feed) string {
 		{"Unread", fmt.Sprintf("%d/%d", feed.NumEntriesUnread(), feed.NumEntriesTotal())},
 		{"URL", derefOrEmpty(feed.SiteURL)},
 		{"Tags", fmtTags(feed.Tags)},
+		{"Failures", failures},
+		{"Last error", derefOrEmpty(feed.LastPullError)},
+		{"Next pull", fmtOrEmpty(feed.NextPullTime)},
 	}
 
 	keyMaxLen := 0
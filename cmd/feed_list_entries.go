@@ -6,55 +6,132 @@ package cmd
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
 	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
 )
 
 func newFeedListEntriesCommand() *cobra.Command {
 
 	const (
-		name          = "list-entries"
-		bookmarkedKey = "bookmarked"
+		name               = "list-entries"
+		bookmarkedKey      = "bookmarked"
+		unreadKey          = "unread"
+		readKey            = "read"
+		starredKey         = "starred"
+		tagKey             = "tag"
+		authorKey          = "author"
+		entryTagKey        = "entry-tag"
+		withEnclosuresKey  = "with-enclosures"
+		publishedAfterKey  = "published-after"
+		publishedBeforeKey = "published-before"
+		updatedAfterKey    = "updated-after"
+		updatedBeforeKey   = "updated-before"
+		sortKey            = "sort"
+		pageSizeKey        = "page-size"
+		pageTokenKey       = "page-token"
 	)
 	var v = newViper(name)
 
 	command := cobra.Command{
-		Use:                   fmt.Sprintf("%s FEED-ID", name),
-		Aliases:               []string{"list-e", "le"},
-		Short:                 "List feed entries",
+		Use:     fmt.Sprintf("%s [FEED-ID...]", name),
+		Aliases: []string{"list-e", "le"},
+		Short:   "List feed entries",
+		Long: `List feed entries
+
+Entries of all feeds are listed when no feed IDs are given. Time flags accept
+RFC 3339 timestamps or dates in the YYYY-MM-DD format, with lower bounds
+being inclusive and upper bounds exclusive.`,
 		DisableFlagsInUseLine: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			if len(args) == 0 {
-				return fmt.Errorf("feed ID value not specified")
-			} else if len(args) > 1 {
-				return fmt.Errorf("too many arguments")
+			feedIDs, err := entity.ToFeedIDs(sliceutil.Dedup(args))
+			if err != nil {
+				return err
+			}
+
+			filter := entity.EntryFilter{
+				FeedIDs:  feedIDs,
+				FeedTags: v.GetStringSlice(tagKey),
+				Authors:  v.GetStringSlice(authorKey),
+				Tags:     v.GetStringSlice(entryTagKey),
 			}
 
-			var isBookmarked *bool = nil
 			if value := v.GetBool(bookmarkedKey); value {
-				isBookmarked = &value
+				filter.IsBookmarked = &value
+			}
+			if value := v.GetBool(starredKey); value {
+				filter.IsFeedStarred = &value
+			}
+			if value := v.GetBool(withEnclosuresKey); value {
+				filter.HasEnclosures = &value
+			}
+			unread, read := v.GetBool(unreadKey), v.GetBool(readKey)
+			if unread && read {
+				return fmt.Errorf("only one of --%s and --%s may be set", unreadKey, readKey)
+			}
+			if unread || read {
+				filter.IsRead = &read
 			}
 
-			feedID, err := entity.ToFeedID(args[0])
+			for _, item := range []struct {
+				key    string
+				target **time.Time
+			}{
+				{publishedAfterKey, &filter.PublishedAfter},
+				{publishedBeforeKey, &filter.PublishedBefore},
+				{updatedAfterKey, &filter.UpdatedAfter},
+				{updatedBeforeKey, &filter.UpdatedBefore},
+			} {
+				if *item.target, err = parseTimeFlag(v.GetString(item.key)); err != nil {
+					return fmt.Errorf("invalid --%s value: %w", item.key, err)
+				}
+			}
+
+			order, err := parseEntrySortOrder(v.GetString(sortKey))
+			if err != nil {
+				return err
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
 			if err != nil {
 				return err
 			}
 
-			db, err := dbFromCmdCtx(cmd)
+			entries, nextToken, err := db.ListEntries(
+				cmd.Context(),
+				&filter,
+				order,
+				&entity.Page{Size: v.GetUint32(pageSizeKey), Token: v.GetString(pageTokenKey)},
+			)
 			if err != nil {
 				return err
 			}
 
-			entries, err := db.ListEntries(cmd.Context(), []entity.ID{feedID}, isBookmarked)
+			out, err := outputFromCmdCtx(cmd)
 			if err != nil {
 				return err
 			}
+			if !out.isText() {
+				if err = writeProto(out, entity.ToEntryPbs(entries)...); err != nil {
+					return err
+				}
+				if nextToken != "" {
+					log.Info().Str("next_page_token", nextToken).Msg("more entries available")
+				}
+				return out.flush()
+			}
+
 			for _, entry := range entries {
 				fmt.Printf("%s\n", fmtListEntry(entry))
 			}
+			if nextToken != "" {
+				fmt.Printf("Next page: --%s %s\n", pageTokenKey, nextToken)
+			}
 
 			return nil
 		},
@@ -63,6 +140,24 @@ func newFeedListEntriesCommand() *cobra.Command {
 	flags := command.Flags()
 
 	flags.BoolP(bookmarkedKey, "b", false, "list only bookmarked entries")
+	flags.BoolP(unreadKey, "u", false, "list only unread entries")
+	flags.Bool(readKey, false, "list only read entries")
+	flags.BoolP(starredKey, "s", false, "list only entries of starred feeds")
+	flags.StringSliceP(tagKey, "t", nil, "list only entries of feeds with any of the given tag(s)")
+	flags.StringSliceP(authorKey, "a", nil, "list only entries by any of the given author(s)")
+	flags.StringSlice(entryTagKey, nil, "list only entries with any of the given tag(s)")
+	flags.Bool(withEnclosuresKey, false, "list only entries with enclosures")
+	flags.String(publishedAfterKey, "", "list only entries published at or after this time")
+	flags.String(publishedBeforeKey, "", "list only entries published before this time")
+	flags.String(updatedAfterKey, "", "list only entries updated at or after this time")
+	flags.String(updatedBeforeKey, "", "list only entries updated before this time")
+	flags.String(
+		sortKey,
+		entrySortUpdatedDesc,
+		fmt.Sprintf("sort order, one of: %s", strings.Join(entrySortOrderNames(), ", ")),
+	)
+	flags.Uint32P(pageSizeKey, "n", 0, "maximum number of entries shown; 0 means no limit")
+	flags.String(pageTokenKey, "", "token of the page to show, from a previous listing")
 
 	if err := v.BindPFlags(flags); err != nil {
 		panic(err)
@@ -71,6 +166,57 @@ func newFeedListEntriesCommand() *cobra.Command {
 	return &command
 }
 
+const (
+	entrySortUpdatedDesc   = "updated-desc"
+	entrySortUpdatedAsc    = "updated-asc"
+	entrySortPublishedDesc = "published-desc"
+	entrySortPublishedAsc  = "published-asc"
+)
+
+var entrySortOrders = map[string]entity.EntrySortOrder{
+	entrySortUpdatedDesc:   entity.EntrySortUpdateTimeDesc,
+	entrySortUpdatedAsc:    entity.EntrySortUpdateTimeAsc,
+	entrySortPublishedDesc: entity.EntrySortPubTimeDesc,
+	entrySortPublishedAsc:  entity.EntrySortPubTimeAsc,
+}
+
+func entrySortOrderNames() []string {
+	return []string{
+		entrySortUpdatedDesc,
+		entrySortUpdatedAsc,
+		entrySortPublishedDesc,
+		entrySortPublishedAsc,
+	}
+}
+
+func parseEntrySortOrder(value string) (entity.EntrySortOrder, error) {
+	order, ok := entrySortOrders[value]
+	if !ok {
+		return 0, fmt.Errorf(
+			"invalid sort order %q, expected one of: %s",
+			value,
+			strings.Join(entrySortOrderNames(), ", "),
+		)
+	}
+	return order, nil
+}
+
+// parseTimeFlag parses the given RFC 3339 timestamp or YYYY-MM-DD date. Dates are interpreted
+// as midnight in the local time zone. Empty values result in nil.
+func parseTimeFlag(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if tv, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return &tv, nil
+	}
+	tv, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("expected RFC 3339 timestamp or YYYY-MM-DD date, got %q", value)
+	}
+	return &tv, nil
+}
+
 func fmtListEntry(entry *entity.Entry) string {
 	var (
 		sb  strings.Builder
@@ -83,6 +229,7 @@ func fmtListEntry(entry *entity.Entry) string {
 		{"EntryID", fmt.Sprintf("%d", entry.ID)},
 		{"URL", derefOrEmpty(entry.URL)},
 		{"Pub", fmtOrEmpty(entry.Published)},
+		{"Tags", strings.Join(entry.Tags, ", ")},
 	}
 
 	keyMaxLen := 0
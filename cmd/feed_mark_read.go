@@ -0,0 +1,131 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+func newFeedMarkReadCommand() *cobra.Command {
+
+	const (
+		name      = "mark-read"
+		feedKey   = "feed"
+		allKey    = "all"
+		unreadKey = "unread"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s [ENTRY-ID...]", name),
+		Aliases: []string{"mark-r", "mr"},
+		Short:   "Mark entries as read",
+		Long: `Mark entries as read
+
+The given entries are marked, together with all entries of the feeds given
+with --feed, or with all entries if --all is set. Entries are marked as unread
+instead when --unread is set.`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			entryIDs, err := entity.ToEntryIDs(args)
+			if err != nil {
+				return err
+			}
+
+			feedIDs, err := entity.ToFeedIDs(sliceutil.Dedup(v.GetStringSlice(feedKey)))
+			if err != nil {
+				return err
+			}
+
+			all := v.GetBool(allKey)
+			if len(entryIDs) == 0 && len(feedIDs) == 0 && !all {
+				return fmt.Errorf(
+					"no entries specified; give entry IDs, --%s, or --%s",
+					feedKey,
+					allKey,
+				)
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			isRead := !v.GetBool(unreadKey)
+
+			if len(feedIDs) > 0 || all {
+				if !all {
+					feeds, _, lerr := db.ListFeeds(
+						cmd.Context(),
+						pointer(uint32(0)),
+						&entity.FeedFilter{IDs: feedIDs},
+						entity.FeedSortTitleAsc,
+						nil,
+					)
+					if lerr != nil {
+						return lerr
+					}
+					if lerr = checkFeedsFound(feedIDs, feeds); lerr != nil {
+						return lerr
+					}
+				}
+				filter := entity.EntryFilter{IsRead: pointer(!isRead)}
+				if !all {
+					filter.FeedIDs = feedIDs
+				}
+				entries, _, lerr := db.ListEntries(
+					cmd.Context(),
+					&filter,
+					entity.EntrySortUpdateTimeDesc,
+					nil,
+				)
+				if lerr != nil {
+					return lerr
+				}
+				for _, entry := range entries {
+					entryIDs = append(entryIDs, entry.ID)
+				}
+			}
+
+			entryIDs = sliceutil.Dedup(entryIDs)
+			ops := make([]*entity.EntryEditOp, len(entryIDs))
+			for i, id := range entryIDs {
+				ops[i] = &entity.EntryEditOp{ID: id, IsRead: &isRead}
+			}
+
+			entries, err := db.EditEntries(cmd.Context(), ops)
+			if err != nil {
+				return err
+			}
+
+			msg := "marked entries as read"
+			if !isRead {
+				msg = "marked entries as unread"
+			}
+			log.Info().Int("num_entries", len(entries)).Msg(msg)
+
+			return writeChanged(cmd, entity.ToEntryPbs(entries)...)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringSliceP(feedKey, "f", nil, "mark all entries of the given feed(s)")
+	flags.Bool(allKey, false, "mark all entries")
+	flags.BoolP(unreadKey, "u", false, "mark entries as unread instead")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
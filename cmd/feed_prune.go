@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+func newFeedPruneCommand() *cobra.Command {
+
+	const (
+		name      = "prune"
+		dryRunKey = "dry-run"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:   fmt.Sprintf("%s [FEED-ID...]", name),
+		Short: "Remove entries according to retention policies",
+		Long: `Remove entries according to retention policies
+
+Entries of the given feeds, or of all feeds if none are given, are removed if
+they exceed the maximum age or the maximum number of entries of the feed's
+retention policy. Feeds without their own policies use the global policy. See
+'neon feed retention' for managing policies. Removed entries are not added
+again on subsequent pulls.`,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			ids, err := entity.ToFeedIDs(sliceutil.Dedup(args))
+			if err != nil {
+				return err
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			out, err := outputFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			dryRun := v.GetBool(dryRunKey)
+			results, err := db.PruneEntries(cmd.Context(), ids, dryRun)
+			if err != nil {
+				return err
+			}
+
+			var numPruned uint32
+			for _, result := range results {
+				if out.isText() {
+					fmt.Printf("%s\n", fmtPruneResult(result, dryRun))
+				}
+				numPruned += result.NumPruned
+			}
+			if !out.isText() {
+				if err = writeProto(out, entity.ToPruneResultPbs(results)...); err != nil {
+					return err
+				}
+				if err = out.flush(); err != nil {
+					return err
+				}
+			}
+
+			if dryRun || numPruned == 0 {
+				return nil
+			}
+			// The API does not provide optimization, so only a local datastore is optimized.
+			if local, ok := db.(*datastore.SQLite); ok {
+				if err := local.Optimize(cmd.Context()); err != nil {
+					return err
+				}
+			}
+			log.Info().Uint32("num_pruned", numPruned).Msg("Finished pruning entries")
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.Bool(dryRunKey, false, "only report the entries that would be removed")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func fmtPruneResult(result *entity.PruneResult, dryRun bool) string {
+	verb := "removed"
+	if dryRun {
+		verb = "to remove"
+	}
+	return fmt.Sprintf(
+		"\x1b[36m▶\x1b[0m \x1b[4m%s\x1b[0m (ID=%d): %d %s, %d kept",
+		result.FeedTitle,
+		result.FeedID,
+		result.NumPruned,
+		verb,
+		result.NumKept,
+	)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 
+	"github.com/bow/neon/api"
 	"github.com/bow/neon/internal/entity"
 	"github.com/bow/neon/internal/sliceutil"
 )
@@ -22,6 +23,7 @@ func newFeedPullCommand() *cobra.Command {
 	const (
 		name       = "pull"
 		timeoutKey = "timeout"
+		forceKey   = "force"
 		numMaxIDs  = 500
 	)
 	var v = newViper(name)
@@ -39,7 +41,7 @@ func newFeedPullCommand() *cobra.Command {
 				return fmt.Errorf("number of specified feeds exceeds %d", numMaxIDs)
 			}
 
-			db, err := dbFromCmdCtx(cmd)
+			db, err := feedStoreFromCmdCtx(cmd)
 			if err != nil {
 				return err
 			}
@@ -54,29 +56,64 @@ func newFeedPullCommand() *cobra.Command {
 				perFeedTimeout = &value
 			}
 
+			out, err := outputFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
 			var (
-				errs []error
-				n    int
-				s    = newPullSpinner(rawIDs)
-				max  = uint32(0)
-				ch   = db.PullFeeds(cmd.Context(), ids, nil, &max, perFeedTimeout)
+				errs     []error
+				moves    [][2]string
+				n        int
+				nskipped int
+				s        = newPullSpinner(rawIDs)
+				max      = uint32(0)
+				force    = v.GetBool(forceKey)
+				ch       = db.PullFeeds(cmd.Context(), ids, nil, &max, perFeedTimeout, force)
 			)
 
-			s.Start()
-			defer s.Stop()
+			if out.isText() {
+				s.Start()
+				defer s.Stop()
+			}
 			for pr := range ch {
+				if !out.isText() {
+					rec, rerr := newPullRecord(pr)
+					if rerr != nil {
+						return rerr
+					}
+					if rerr = out.write(rec); rerr != nil {
+						return rerr
+					}
+				}
 				if err := pr.Error(); err != nil {
 					errs = append(errs, fmt.Errorf("%s: %w", pr.URL(), err))
+				} else if pr.Status() == entity.PullSkipped {
+					nskipped++
 				} else {
 					n++
 				}
+				if newURL := pr.NewURL(); newURL != "" {
+					moves = append(moves, [2]string{pr.URL(), newURL})
+				}
 			}
 			s.Stop()
 
+			if err = out.flush(); err != nil {
+				return err
+			}
+
+			for _, move := range moves {
+				log.Warn().Str("old_url", move[0]).Str("new_url", move[1]).Msg("Feed moved")
+			}
+
 			if len(errs) > 0 {
 				return errors.Join(errs...)
 			}
-			log.Info().Int("num_pulled", n).Msgf("Finished pulling feeds")
+			log.Info().
+				Int("num_pulled", n).
+				Int("num_skipped", nskipped).
+				Msgf("Finished pulling feeds")
 
 			return nil
 		},
@@ -85,6 +122,7 @@ func newFeedPullCommand() *cobra.Command {
 	flags := command.Flags()
 
 	flags.Duration(timeoutKey, 20*time.Second, "timeout for pulling each feed")
+	flags.BoolP(forceKey, "f", false, "also pull feeds backing off after failed pulls")
 
 	if err := v.BindPFlags(flags); err != nil {
 		panic(err)
@@ -93,6 +131,31 @@ func newFeedPullCommand() *cobra.Command {
 	return &command
 }
 
+// newPullRecord creates the output record of a pull result. It has the fields of
+// PullFeedsResponse and the status of the pull.
+func newPullRecord(pr entity.PullResult) (record, error) {
+	pb := api.PullFeedsResponse{Url: pr.URL()}
+	if feed := pr.Feed(); feed != nil {
+		pb.Feed = entity.ToFeedPb(feed)
+	}
+	if err := pr.Error(); err != nil {
+		msg := err.Error()
+		pb.Error = &msg
+	}
+	if newURL := pr.NewURL(); newURL != "" {
+		pb.NewUrl = &newURL
+	}
+
+	rec, err := newProtoRecord(&pb)
+	if err != nil {
+		return record{}, err
+	}
+	// The status is written as its documented short name, not as the name of the API enum value.
+	rec.values["status"] = strings.ReplaceAll(pr.Status().String(), " ", "_")
+
+	return rec, nil
+}
+
 func newPullSpinner(rawIDs []string) *spinner.Spinner {
 	var msg string
 	if nids := len(rawIDs); nids == 0 {
@@ -0,0 +1,163 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+func newFeedRemoveCommand() *cobra.Command {
+
+	const (
+		name      = "remove"
+		yesKey    = "yes"
+		dryRunKey = "dry-run"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s FEED-ID...", name),
+		Args:    cobra.MinimumNArgs(1),
+		Aliases: []string{"rm"},
+		Short:   "Remove feeds",
+		Long: `Remove feeds
+
+The given feeds are removed together with all of their entries, after a
+confirmation prompt unless --yes is set.`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			ids, err := entity.ToFeedIDs(sliceutil.Dedup(args))
+			if err != nil {
+				return err
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeds, _, err := db.ListFeeds(
+				cmd.Context(),
+				nil,
+				&entity.FeedFilter{IDs: ids},
+				entity.FeedSortTitleAsc,
+				nil,
+			)
+			if err != nil {
+				return err
+			}
+			if err = checkFeedsFound(ids, feeds); err != nil {
+				return err
+			}
+
+			var numEntries int
+			for _, feed := range feeds {
+				numEntries += feed.NumEntriesTotal()
+			}
+
+			if v.GetBool(dryRunKey) {
+				out, oerr := outputFromCmdCtx(cmd)
+				if oerr != nil {
+					return oerr
+				}
+				if !out.isText() {
+					if err = writeProto(out, entity.ToFeedPbs(feeds)...); err != nil {
+						return err
+					}
+					return out.flush()
+				}
+				for _, feed := range feeds {
+					fmt.Printf(
+						"\x1b[36m▶\x1b[0m \x1b[4m%s\x1b[0m (ID=%d): %d entries to remove\n",
+						feed.Title,
+						feed.ID,
+						feed.NumEntriesTotal(),
+					)
+				}
+				fmt.Printf("%d feed(s) and %d entries to remove\n", len(feeds), numEntries)
+				return nil
+			}
+
+			if !v.GetBool(yesKey) {
+				prompt := fmt.Sprintf(
+					"Remove %d feed(s) and %d entries?",
+					len(feeds),
+					numEntries,
+				)
+				confirmed, cerr := confirm(prompt, cmd.InOrStdin())
+				if cerr != nil {
+					return cerr
+				}
+				if !confirmed {
+					return nil
+				}
+			}
+
+			if err = db.DeleteFeeds(cmd.Context(), ids); err != nil {
+				return err
+			}
+			log.Info().
+				Int("num_feeds", len(feeds)).
+				Int("num_entries", numEntries).
+				Msg("removed feeds")
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.BoolP(yesKey, "y", false, "remove without asking for confirmation")
+	flags.Bool(dryRunKey, false, "only report the feeds and entries that would be removed")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+// checkFeedsFound returns an error for the first of the given IDs that is not the ID of any of
+// the given feeds.
+func checkFeedsFound(ids []entity.ID, feeds []*entity.Feed) error {
+	found := make(map[entity.ID]struct{}, len(feeds))
+	for _, feed := range feeds {
+		found[feed.ID] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			return entity.FeedNotFoundError{ID: id}
+		}
+	}
+	return nil
+}
+
+// confirm asks the given yes/no question and reads the answer. Answers other than yes mean no.
+func confirm(prompt string, in io.Reader) (bool, error) {
+	fmt.Printf("%s [y/N]: ", prompt)
+
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func newFeedRetentionCommand() *cobra.Command {
+
+	const name = "retention"
+
+	command := cobra.Command{
+		Use:   name,
+		Short: "View or modify entry retention policies",
+		Long: `View or modify entry retention policies
+
+Retention policies determine which entries are removed by 'neon feed prune'.
+A policy may be set globally or for a single feed, with the feed policy taking
+precedence over the global one.`,
+	}
+
+	command.AddCommand(newFeedRetentionListCommand())
+	command.AddCommand(newFeedRetentionSetCommand())
+	command.AddCommand(newFeedRetentionUnsetCommand())
+
+	return &command
+}
+
+func newFeedRetentionListCommand() *cobra.Command {
+
+	const name = "list"
+
+	command := cobra.Command{
+		Use:     name,
+		Aliases: makeAlias(name),
+		Short:   "List retention policies",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			policies, err := db.ListRetentionPolicies(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			out, err := outputFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			if !out.isText() {
+				if err = writeProto(out, entity.ToRetentionPolicyPbs(policies)...); err != nil {
+					return err
+				}
+				return out.flush()
+			}
+
+			for _, policy := range policies {
+				fmt.Printf("%s\n", fmtRetentionPolicy(policy))
+			}
+
+			return nil
+		},
+	}
+
+	return &command
+}
+
+func newFeedRetentionSetCommand() *cobra.Command {
+
+	const (
+		name              = "set"
+		feedKey           = "feed"
+		maxAgeKey         = "max-age"
+		maxEntriesKey     = "max-entries"
+		keepBookmarkedKey = "keep-bookmarked"
+		keepUnreadKey     = "keep-unread"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:   name,
+		Short: "Set a retention policy",
+		Long: `Set a retention policy
+
+The global policy is set unless a feed ID is given. Any existing policy of the
+same scope is replaced.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+
+			policy := entity.RetentionPolicy{
+				KeepBookmarked: v.GetBool(keepBookmarkedKey),
+				KeepUnread:     v.GetBool(keepUnreadKey),
+			}
+
+			if rawID := v.GetString(feedKey); rawID != "" {
+				id, err := entity.ToFeedID(rawID)
+				if err != nil {
+					return err
+				}
+				policy.FeedID = &id
+			}
+			if rawAge := v.GetString(maxAgeKey); rawAge != "" {
+				maxAge, err := parseRetentionAge(rawAge)
+				if err != nil {
+					return err
+				}
+				policy.MaxAge = &maxAge
+			}
+			if value := v.GetUint32(maxEntriesKey); value > 0 {
+				policy.MaxEntries = &value
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			return db.SetRetentionPolicy(cmd.Context(), &policy)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringP(feedKey, "f", "", "ID of the feed to which the policy applies")
+	flags.String(maxAgeKey, "", "maximum entry age, e.g. '720h' or '30d'")
+	flags.Uint32(maxEntriesKey, 0, "maximum number of entries kept per feed; 0 means no limit")
+	flags.Bool(keepBookmarkedKey, true, "never remove bookmarked entries")
+	flags.Bool(keepUnreadKey, false, "never remove unread entries")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func newFeedRetentionUnsetCommand() *cobra.Command {
+
+	const (
+		name    = "unset"
+		feedKey = "feed"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:   name,
+		Short: "Remove a retention policy",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+
+			var feedID *entity.ID
+			if rawID := v.GetString(feedKey); rawID != "" {
+				id, err := entity.ToFeedID(rawID)
+				if err != nil {
+					return err
+				}
+				feedID = &id
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			return db.DeleteRetentionPolicy(cmd.Context(), feedID)
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringP(feedKey, "f", "", "ID of the feed whose policy is removed; global if unset")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+// parseRetentionAge parses the given maximum entry age. In addition to the units accepted by
+// time.ParseDuration, whole days may be given with the 'd' suffix.
+func parseRetentionAge(raw string) (time.Duration, error) {
+	if days, found := strings.CutSuffix(raw, "d"); found {
+		n, err := strconv.ParseUint(days, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid maximum age %q", raw)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	age, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maximum age %q", raw)
+	}
+	return age, nil
+}
+
+func fmtRetentionPolicy(policy *entity.RetentionPolicy) string {
+	scope := "global"
+	if policy.FeedID != nil {
+		scope = fmt.Sprintf("feed ID=%d", *policy.FeedID)
+	}
+
+	maxAge := "none"
+	if policy.MaxAge != nil {
+		maxAge = policy.MaxAge.String()
+	}
+	maxEntries := "none"
+	if policy.MaxEntries != nil {
+		maxEntries = strconv.FormatUint(uint64(*policy.MaxEntries), 10)
+	}
+
+	return fmt.Sprintf(
+		"%s: max age %s, max entries %s, keep bookmarked %t, keep unread %t",
+		scope,
+		maxAge,
+		maxEntries,
+		policy.KeepBookmarked,
+		policy.KeepUnread,
+	)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+func newFeedSearchCommand() *cobra.Command {
+
+	const (
+		name          = "search"
+		feedKey       = "feed"
+		maxResultsKey = "max"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:   fmt.Sprintf("%s QUERY...", name),
+		Short: "Search feed entries",
+		Long: `Search feed entries
+
+All query terms must appear in the title, description, or content of an entry
+for it to match. Terms ending with '*' match as prefixes. Results are ordered by
+relevance, with title matches ranked highest.`,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			if len(args) == 0 {
+				return fmt.Errorf("search query not specified")
+			}
+
+			feedIDs, err := entity.ToFeedIDs(sliceutil.Dedup(v.GetStringSlice(feedKey)))
+			if err != nil {
+				return err
+			}
+
+			var maxResults *uint32
+			if value := v.GetUint32(maxResultsKey); value > 0 {
+				maxResults = &value
+			}
+
+			db, err := feedStoreFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			results, err := db.SearchEntries(
+				cmd.Context(),
+				strings.Join(args, " "),
+				feedIDs,
+				maxResults,
+			)
+			if err != nil {
+				return err
+			}
+
+			out, err := outputFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			if !out.isText() {
+				if err = writeProto(out, entity.ToSearchResultPbs(results)...); err != nil {
+					return err
+				}
+				return out.flush()
+			}
+
+			for _, result := range results {
+				fmt.Printf("%s\n", fmtSearchResult(result))
+			}
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringSliceP(feedKey, "f", nil, "search only entries of the given feed ID(s)")
+	flags.Uint32P(maxResultsKey, "n", 0, "maximum number of results shown; 0 means no limit")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func fmtSearchResult(result *entity.SearchResult) string {
+	var (
+		sb  strings.Builder
+		cat = func(format string, a ...any) { fmt.Fprintf(&sb, format, a...) }
+	)
+
+	entry := result.Entry
+	kv := []*struct {
+		k, v string
+	}{
+		{"EntryID", fmt.Sprintf("%d", entry.ID)},
+		{"FeedID", fmt.Sprintf("%d", entry.FeedID)},
+		{"URL", capText(derefOrEmpty(entry.URL))},
+		{"Pub", fmtOrEmpty(entry.Published)},
+		{"Match", fmtHighlights(result.Snippet)},
+	}
+
+	keyMaxLen := 0
+	for _, line := range kv {
+		keyLen := len(line.k)
+		if keyLen > keyMaxLen {
+			keyMaxLen = keyLen
+		}
+	}
+
+	cat("\x1b[36m▶\x1b[0m \x1b[4m%s\x1b[0m\n", fmtHighlights(result.TitleHighlight))
+	for _, line := range kv {
+		if line.v == "" {
+			continue
+		}
+		cat("  %*s : %s\n", -1*keyMaxLen, line.k, line.v)
+	}
+
+	return sb.String()
+}
+
+// fmtHighlights renders the matched terms in the given text in bold.
+func fmtHighlights(text string) string {
+	return entity.ReplaceHighlights(text, "\x1b[1m", "\x1b[22m")
+}
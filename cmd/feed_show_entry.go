@@ -5,19 +5,32 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/render"
 )
 
 func newFeedShowEntryCommand() *cobra.Command {
-	const name = "show-entry"
+	const (
+		name     = "show-entry"
+		rawKey   = "raw"
+		widthKey = "width"
+	)
+	var v = newViper(name)
 
 	command := cobra.Command{
-		Use:                   fmt.Sprintf("%s ENTRY-ID", name),
-		Aliases:               []string{"show-e", "se"},
-		Short:                 "Show a feed entry",
+		Use:     fmt.Sprintf("%s ENTRY-ID", name),
+		Aliases: []string{"show-e", "se"},
+		Short:   "Show a feed entry",
+		Long: `Show a feed entry
+
+HTML content is rendered as styled text wrapped to the terminal width, unless
+--raw is set.`,
 		DisableFlagsInUseLine: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -32,7 +45,7 @@ func newFeedShowEntryCommand() *cobra.Command {
 				return err
 			}
 
-			db, err := dbFromCmdCtx(cmd)
+			db, err := feedStoreFromCmdCtx(cmd)
 			if err != nil {
 				return err
 			}
@@ -42,13 +55,117 @@ func newFeedShowEntryCommand() *cobra.Command {
 				return err
 			}
 
-			if content := entry.Content; content != nil {
+			out, err := outputFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+			if !out.isText() {
+				if err = writeProto(out, entity.ToEntryPb(entry)); err != nil {
+					return err
+				}
+				return out.flushOne()
+			}
+
+			if header := fmtEntryMetadata(entry); header != "" {
+				fmt.Printf("%s\n", header)
+			}
+			content := entry.Content
+			if content == nil {
+				return nil
+			}
+			if v.GetBool(rawKey) {
 				fmt.Printf("%s\n", *content)
+				return nil
 			}
 
+			format, width := render.FormatPlain, v.GetInt(widthKey)
+			if fd := int(os.Stdout.Fd()); term.IsTerminal(fd) {
+				format = render.FormatANSI
+				if tw, _, err := term.GetSize(fd); err == nil && width == 0 {
+					width = tw
+				}
+			}
+			if width == 0 {
+				width = defaultTextWidth
+			}
+			text, err := render.Render(*content, width, format)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s\n", text)
+
 			return nil
 		},
 	}
 
+	flags := command.Flags()
+	flags.Bool(rawKey, false, "show the entry content as-is")
+	flags.IntP(widthKey, "w", 0, "width to which text is wrapped; 0 means the terminal width")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
 	return &command
 }
+
+// defaultTextWidth is the width to which rendered text is wrapped when the terminal width is
+// unknown.
+const defaultTextWidth = 80
+
+func fmtEntryMetadata(entry *entity.Entry) string {
+	var (
+		sb  strings.Builder
+		cat = func(format string, a ...any) { fmt.Fprintf(&sb, format, a...) }
+	)
+
+	kv := []*struct {
+		k, v string
+	}{
+		{"Authors", strings.Join(entry.Authors, ", ")},
+		{"Tags", strings.Join(entry.Tags, ", ")},
+	}
+	for _, enc := range entry.Enclosures {
+		value := enc.URL
+		var extra []string
+		if enc.Type != "" {
+			extra = append(extra, enc.Type)
+		}
+		if enc.Length > 0 {
+			extra = append(extra, fmtByteSize(enc.Length))
+		}
+		if len(extra) > 0 {
+			value = fmt.Sprintf("%s (%s)", value, strings.Join(extra, ", "))
+		}
+		kv = append(kv, &struct{ k, v string }{"Enclosure", value})
+	}
+
+	keyMaxLen := 0
+	for _, line := range kv {
+		if line.v != "" && len(line.k) > keyMaxLen {
+			keyMaxLen = len(line.k)
+		}
+	}
+	for _, line := range kv {
+		if line.v == "" {
+			continue
+		}
+		cat("%*s : %s\n", -1*keyMaxLen, line.k, line.v)
+	}
+
+	return sb.String()
+}
+
+// fmtByteSize formats the given number of bytes using binary units.
+func fmtByteSize(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,318 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputKey = "output"
+
+	outputText     = "text"
+	outputJSON     = "json"
+	outputNDJSON   = "ndjson"
+	outputYAML     = "yaml"
+	outputCSV      = "csv"
+	outputTemplate = "template"
+
+	defaultOutput = outputText
+)
+
+// outputHelp describes the output formats and the schema of their records.
+const outputHelp = `Output formats
+
+The --output flag selects how commands print the objects they show or change:
+
+  text            human-readable text (default)
+  json            a JSON array of objects, or a single object for single-object commands
+  ndjson          one JSON object per line, written as soon as it is available
+  yaml            a YAML sequence of mappings, or a single mapping
+  csv             a header row followed by one row per object
+  template=TEXT   a Go text/template executed once per object, each followed by a newline
+
+Objects follow the messages of the gRPC API (see 'neon server show-proto') in their JSON
+form, with keys named exactly like the protobuf fields:
+
+  list, add, edit,
+  remove --dry-run                  Feed
+  import                            ImportOPMLResponse
+  list-entries, show-entry,
+  fetch-content, bookmark,
+  mark-read                         Entry
+  search                            SearchEntriesResponse.Result
+  prune                             PruneEntriesResponse.Result
+  pull                              PullFeedsResponse, with status as success, fail,
+                                    not_modified, or skipped
+  folder add, folder edit,
+  folder list                       Folder
+  retention list                    RetentionPolicy, with max_age as a duration string
+
+Every field is present in every object; unset fields are null. Timestamps are RFC 3339
+strings. In csv output, lists and nested objects are written as JSON.`
+
+// record is a single object written by an output. Its fields keep the order in which they are
+// declared, which is the column order of csv output.
+type record struct {
+	fields []string
+	values map[string]any
+}
+
+var protoRecordOptions = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// newProtoRecord creates a record from the JSON form of the given message.
+func newProtoRecord(msg proto.Message) (record, error) {
+	raw, err := protoRecordOptions.Marshal(msg)
+	if err != nil {
+		return record{}, err
+	}
+	var values map[string]any
+	if err = json.Unmarshal(raw, &values); err != nil {
+		return record{}, err
+	}
+	desc := msg.ProtoReflect().Descriptor()
+	fillProtoFields(values, desc)
+
+	fields := make([]string, desc.Fields().Len())
+	for i := range fields {
+		fields[i] = string(desc.Fields().Get(i).Name())
+	}
+
+	return record{fields: fields, values: values}, nil
+}
+
+// fillProtoFields sets the unset optional fields of the given decoded message and its nested
+// messages to nil, so all records of a message have the same keys.
+func fillProtoFields(values map[string]any, desc protoreflect.MessageDescriptor) {
+	if desc.FullName() == "google.protobuf.Timestamp" {
+		return
+	}
+	fds := desc.Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		name := string(fd.Name())
+		value, exists := values[name]
+		if !exists {
+			values[name] = nil
+			continue
+		}
+		if fd.Message() == nil || fd.IsMap() {
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]any:
+			fillProtoFields(v, fd.Message())
+		case []any:
+			for _, item := range v {
+				if m, ok := item.(map[string]any); ok {
+					fillProtoFields(m, fd.Message())
+				}
+			}
+		}
+	}
+}
+
+// output writes records in the format selected with the output flag.
+type output struct {
+	format string
+	tmpl   *template.Template
+	w      io.Writer
+
+	// buffered holds the records of formats that can only be written in full.
+	buffered []map[string]any
+	csvw     *csv.Writer
+	header   []string
+}
+
+func newOutput(spec string, w io.Writer) (*output, error) {
+	format, text, hasText := strings.Cut(spec, "=")
+	if hasText && format != outputTemplate {
+		return nil, fmt.Errorf("output format %q does not take a value", format)
+	}
+
+	out := output{format: format, w: w}
+	switch format {
+	case outputText, outputJSON, outputNDJSON, outputYAML:
+	case outputCSV:
+		out.csvw = csv.NewWriter(w)
+	case outputTemplate:
+		if !hasText {
+			return nil, fmt.Errorf("output format %q requires a template, e.g. %s={{.id}}",
+				format, format)
+		}
+		tmpl, err := template.New(outputKey).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid output template: %w", err)
+		}
+		out.tmpl = tmpl
+	default:
+		return nil, fmt.Errorf("unknown output format %q", spec)
+	}
+
+	return &out, nil
+}
+
+// isText returns whether the human-readable text output was selected.
+func (o *output) isText() bool {
+	return o.format == outputText
+}
+
+// write writes the given record, or buffers it if the output format can not be written
+// incrementally. Buffered records are written by flush.
+func (o *output) write(rec record) error {
+	switch o.format {
+	case outputJSON, outputYAML:
+		o.buffered = append(o.buffered, rec.values)
+		return nil
+	case outputNDJSON:
+		return json.NewEncoder(o.w).Encode(rec.values)
+	case outputCSV:
+		return o.writeCSV(rec)
+	case outputTemplate:
+		if err := o.tmpl.Execute(o.w, rec.values); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintln(o.w)
+		return err
+	default:
+		return fmt.Errorf("output format %q can not write records", o.format)
+	}
+}
+
+// writeProto writes the given messages as records.
+func writeProto[T proto.Message](o *output, msgs ...T) error {
+	for _, msg := range msgs {
+		rec, err := newProtoRecord(msg)
+		if err != nil {
+			return err
+		}
+		if err = o.write(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flush writes all buffered records as a list.
+func (o *output) flush() error {
+	values := o.buffered
+	if values == nil {
+		values = make([]map[string]any, 0)
+	}
+	return o.flushValue(values)
+}
+
+// flushOne writes the single buffered record as an object instead of as a list.
+func (o *output) flushOne() error {
+	if len(o.buffered) != 1 {
+		return o.flush()
+	}
+	return o.flushValue(o.buffered[0])
+}
+
+func (o *output) flushValue(value any) error {
+	defer func() { o.buffered = nil }()
+
+	switch o.format {
+	case outputJSON:
+		enc := json.NewEncoder(o.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(value)
+	case outputYAML:
+		enc := yaml.NewEncoder(o.w)
+		enc.SetIndent(2)
+		if err := enc.Encode(value); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		return nil
+	}
+}
+
+func (o *output) writeCSV(rec record) error {
+	if o.header == nil {
+		o.header = rec.fields
+		if err := o.csvw.Write(o.header); err != nil {
+			return err
+		}
+	}
+	row := make([]string, len(o.header))
+	for i, field := range o.header {
+		cell, err := fmtCSVCell(rec.values[field])
+		if err != nil {
+			return err
+		}
+		row[i] = cell
+	}
+	if err := o.csvw.Write(row); err != nil {
+		return err
+	}
+	o.csvw.Flush()
+	return o.csvw.Error()
+}
+
+func fmtCSVCell(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(raw), nil
+	}
+}
+
+// writeChanged writes the messages changed by a command in machine-readable output formats.
+// Text output only logs the changes, so nothing is written for it.
+func writeChanged[T proto.Message](cmd *cobra.Command, msgs ...T) error {
+	out, err := outputFromCmdCtx(cmd)
+	if err != nil || out.isText() {
+		return err
+	}
+	if err = writeProto(out, msgs...); err != nil {
+		return err
+	}
+	return out.flush()
+}
+
+// writeChangedOne is like writeChanged, for commands that change a single object.
+func writeChangedOne[T proto.Message](cmd *cobra.Command, msg T) error {
+	out, err := outputFromCmdCtx(cmd)
+	if err != nil || out.isText() {
+		return err
+	}
+	if err = writeProto(out, msg); err != nil {
+		return err
+	}
+	return out.flushOne()
+}
+
+func outputToCmdCtx(cmd *cobra.Command, out *output) {
+	toCmdContext(cmd, outputKey, out)
+}
+
+func outputFromCmdCtx(cmd *cobra.Command) (*output, error) {
+	return fromCmdContext[*output](cmd, outputKey)
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/api"
+	"github.com/bow/neon/internal/entity"
+)
+
+func newTestFeedPbs() []*api.Feed {
+	updated := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	return entity.ToFeedPbs([]*entity.Feed{
+		{
+			ID:      1,
+			Title:   "Feed A",
+			FeedURL: "http://a.com/feed.xml",
+			SiteURL: pointer("http://a.com"),
+			Tags:    []string{"news", "tech"},
+			Updated: &updated,
+		},
+		{ID: 2, Title: "Feed B, \"quoted\"", FeedURL: "http://b.com/feed.xml"},
+	})
+}
+
+// writeTestOutput writes the given feeds in the given output format and returns the output.
+func writeTestOutput(t *testing.T, spec string, feeds ...*api.Feed) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	out, err := newOutput(spec, &buf)
+	require.NoError(t, err)
+	require.NoError(t, writeProto(out, feeds...))
+	require.NoError(t, out.flush())
+
+	return buf.String()
+}
+
+func TestOutputJSONOk(t *testing.T) {
+	a := assert.New(t)
+	r := require.New(t)
+
+	var got []map[string]any
+	r.NoError(json.Unmarshal([]byte(writeTestOutput(t, "json", newTestFeedPbs()...)), &got))
+	r.Len(got, 2)
+
+	a.Equal(float64(1), got[0]["id"])
+	a.Equal("http://a.com/feed.xml", got[0]["feed_url"])
+	a.Equal("http://a.com", got[0]["site_url"])
+	a.Equal([]any{"news", "tech"}, got[0]["tags"])
+	a.Equal("2024-03-01T10:00:00Z", got[0]["update_time"])
+	// Unset fields are present, so all objects have the same keys.
+	a.Contains(got[1], "site_url")
+	a.Nil(got[1]["site_url"])
+	a.Nil(got[1]["update_time"])
+	a.Equal(len(got[0]), len(got[1]))
+}
+
+func TestOutputJSONEmpty(t *testing.T) {
+	assert.Equal(t, "[]\n", writeTestOutput(t, "json"))
+}
+
+func TestOutputNDJSONOk(t *testing.T) {
+	a := assert.New(t)
+	r := require.New(t)
+
+	lines := strings.Split(strings.TrimSpace(writeTestOutput(t, "ndjson", newTestFeedPbs()...)), "\n")
+	r.Len(lines, 2)
+	for i, title := range []string{"Feed A", "Feed B, \"quoted\""} {
+		var got map[string]any
+		r.NoError(json.Unmarshal([]byte(lines[i]), &got))
+		a.Equal(title, got["title"])
+	}
+}
+
+func TestOutputYAMLOk(t *testing.T) {
+	got := writeTestOutput(t, "yaml", newTestFeedPbs()[0])
+
+	assert.Contains(t, got, "- description: null\n")
+	assert.Contains(t, got, "  feed_url: http://a.com/feed.xml\n")
+	assert.Contains(t, got, "  tags:\n    - news\n    - tech\n")
+}
+
+func TestOutputCSVOk(t *testing.T) {
+	a := assert.New(t)
+	r := require.New(t)
+
+	lines := strings.Split(strings.TrimSpace(writeTestOutput(t, "csv", newTestFeedPbs()...)), "\n")
+	r.Len(lines, 3)
+	a.True(strings.HasPrefix(lines[0], "id,title,feed_url,tags,site_url,description,"))
+	a.True(strings.HasPrefix(
+		lines[1],
+		`1,Feed A,http://a.com/feed.xml,"[""news"",""tech""]",http://a.com,,`,
+	))
+	a.True(strings.HasPrefix(lines[2], `2,"Feed B, ""quoted""",http://b.com/feed.xml,[],,,`))
+}
+
+func TestOutputTemplateOk(t *testing.T) {
+	got := writeTestOutput(t, "template={{.id}}\t{{.title}}", newTestFeedPbs()...)
+
+	assert.Equal(t, "1\tFeed A\n2\tFeed B, \"quoted\"\n", got)
+}
+
+func TestOutputFlushOneOk(t *testing.T) {
+	var buf bytes.Buffer
+	out, err := newOutput("json", &buf)
+	require.NoError(t, err)
+	require.NoError(t, writeProto(out, newTestFeedPbs()[0]))
+	require.NoError(t, out.flushOne())
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, "Feed A", got["title"])
+}
+
+func TestOutputErrInvalidSpec(t *testing.T) {
+	for spec, msg := range map[string]string{
+		"xml":              `unknown output format "xml"`,
+		"json=x":           `output format "json" does not take a value`,
+		"template":         `output format "template" requires a template`,
+		"template={{.id}":  "invalid output template",
+		"template={{.id}}": "",
+	} {
+		_, err := newOutput(spec, &bytes.Buffer{})
+		if msg == "" {
+			assert.NoError(t, err, spec)
+		} else {
+			assert.ErrorContains(t, err, msg, spec)
+		}
+	}
+}
+
+func TestOutputPullRecordOk(t *testing.T) {
+	a := assert.New(t)
+	r := require.New(t)
+
+	rec, err := newPullRecord(entity.NewPullResultNotModified(pointer("http://a.com/feed.xml")))
+	r.NoError(err)
+
+	a.Equal([]string{"url", "feed", "error", "new_url", "status"}, rec.fields)
+	a.Equal("http://a.com/feed.xml", rec.values["url"])
+	a.Nil(rec.values["feed"])
+	a.Equal("not_modified", rec.values["status"])
+}
+
+func TestFeedListOutputJSONOk(t *testing.T) {
+	dbPath := filepath.Join(createTestDir(t, ""), "test.db")
+
+	stdout, _, err := execCommand([]string{"feed", "-d", dbPath, "-o", "json", "list"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "[]\n", stdout)
+}
@@ -6,6 +6,8 @@ package cmd
 import (
 	"fmt"
 	"net"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -14,6 +16,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/bow/neon/internal/reader"
+	"github.com/bow/neon/internal/reader/keymap"
+	"github.com/bow/neon/internal/reader/ui"
 	"github.com/bow/neon/internal/server"
 )
 
@@ -24,6 +28,8 @@ func newReaderCommand() *cobra.Command {
 		addrKey           = "address"
 		connectKey        = "connect"
 		connectTimeoutKey = "connect-timeout"
+		themeKey          = "theme"
+		keymapKey         = "keymap"
 	)
 	var (
 		v                  = newViper(name)
@@ -37,6 +43,10 @@ func newReaderCommand() *cobra.Command {
 		Short:   "Open the feed reader",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 
+			if err := applyConfig(cmd, v, name); err != nil {
+				return err
+			}
+
 			var (
 				err            error
 				connectAddr    net.Addr
@@ -55,6 +65,11 @@ func newReaderCommand() *cobra.Command {
 				}
 				connectTimeout = v.GetDuration(connectTimeoutKey)
 
+				dialOpts, err = clientDialOpts(v)
+				if err != nil {
+					return err
+				}
+
 			} else {
 				server, ierr := makeServer(cmd, v, addr)
 				if ierr != nil {
@@ -69,8 +84,17 @@ func newReaderCommand() *cobra.Command {
 				connectAddr = server.Addr()
 			}
 
+			dir, err := configDir()
+			if err != nil {
+				return err
+			}
+
 			rdr, err := reader.NewBuilder(cmd.Context()).
 				Context(ctx).
+				Theme(v.GetString(themeKey)).
+				ThemeDir(filepath.Join(dir, themesDirName)).
+				Keymap(v.GetString(keymapKey)).
+				KeymapDir(filepath.Join(dir, keymapsDirName)).
 				ConnectTimeout(connectTimeout).
 				Address(connectAddr.String()).
 				DialOpts(dialOpts...).
@@ -103,14 +127,43 @@ func newReaderCommand() *cobra.Command {
 		`timeout for initial server connection, ignored if "-c" is unset`,
 	)
 	flags.StringP(dbPathKey, "d", defaultDBPath, `datastore location, ignored if "-c" is set`)
+	flags.String(
+		themeKey,
+		"dark",
+		fmt.Sprintf(
+			"theme name, looked up in $XDG_CONFIG_HOME/neon/%s, or theme file path; "+
+				"built-in themes: %s",
+			themesDirName,
+			strings.Join(ui.BuiltinThemes(), ", "),
+		),
+	)
+	flags.String(
+		keymapKey,
+		keymap.DefaultPreset,
+		fmt.Sprintf(
+			"key bindings name, looked up in $XDG_CONFIG_HOME/neon/%s, or keymap file path; "+
+				"presets: %s",
+			keymapsDirName,
+			strings.Join(keymap.Presets(), ", "),
+		),
+	)
+	addClientFlags(flags, "-c")
 
 	if err := v.BindPFlags(flags); err != nil {
 		panic(err)
 	}
+	bindClientEnv(v, name)
 
 	return &command
 }
 
+const (
+	// themesDirName is the name of the directory of theme files in the configuration directory.
+	themesDirName = "themes"
+	// keymapsDirName is the name of the directory of keymap files in the configuration directory.
+	keymapsDirName = "keymaps"
+)
+
 func resolveAddr(
 	v *viper.Viper,
 	addrKey string,
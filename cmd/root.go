@@ -14,9 +14,17 @@ import (
 	"github.com/bow/neon/internal"
 )
 
+func init() {
+	// Run the persistent hooks of all parents of a command, so that the configuration file is
+	// loaded by the root command before the hooks of its subcommands use it.
+	cobra.EnableTraverseRunHooks = true
+}
+
 // New creates a new command along with its command-line flags.
 func New() *cobra.Command {
 
+	var v = newViper("")
+
 	command := cobra.Command{
 		Use:                internal.AppName(),
 		Short:              "Feed reader suite",
@@ -24,7 +32,21 @@ func New() *cobra.Command {
 		SilenceErrors:      true,
 		DisableSuggestions: true,
 		CompletionOptions:  cobra.CompletionOptions{DisableDefaultCmd: true},
-		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+
+			cfg, err := newConfig(v.GetString(configKey), v.GetString(profileKey))
+			if err != nil {
+				return err
+			}
+			if _, skip := cmd.Annotations[skipConfigAnnotation]; !skip {
+				if err = cfg.load(); err != nil {
+					return err
+				}
+				if err = cfg.setupLogging(cmd.ErrOrStderr()); err != nil {
+					return err
+				}
+			}
+			configToCmdCtx(cmd, cfg)
 
 			caser := cases.Title(language.English)
 
@@ -37,8 +59,24 @@ func New() *cobra.Command {
 		},
 	}
 
+	pflags := command.PersistentFlags()
+
+	pflags.String(
+		configKey,
+		"",
+		"configuration file (default: config.toml, config.yaml, or config.yml in "+
+			"$XDG_CONFIG_HOME/neon)",
+	)
+	pflags.String(profileKey, "", "configuration profile, overriding the one set in the file")
+
+	if err := v.BindPFlags(pflags); err != nil {
+		panic(err)
+	}
+
+	command.AddCommand(newConfigCommand())
 	command.AddCommand(newFeedCommand())
 	command.AddCommand(newReaderCommand())
+	command.AddCommand(newRuleCommand())
 	command.AddCommand(newServerCommand())
 	command.AddCommand(newVersionCommand())
 
@@ -0,0 +1,74 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func newRuleCommand() *cobra.Command {
+
+	const name = "rule"
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:   name,
+		Short: "View or modify entry rules",
+		Long: `View or modify entry rules
+
+Rules are applied to new entries when feeds are pulled. Entries whose title,
+content, author, or URL matches a rule are marked as read, bookmarked, tagged,
+or dropped, according to the rule action. Existing entries are not changed.`,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+
+			dbPath, err := resolveDBPath(v.GetString(dbPathKey))
+			if err != nil {
+				return err
+			}
+			dbPathToCmdCtx(cmd, dbPath)
+
+			return nil
+		},
+	}
+
+	pflags := command.PersistentFlags()
+
+	pflags.StringP(dbPathKey, "d", defaultDBPath, "datastore location")
+
+	if err := v.BindPFlags(pflags); err != nil {
+		panic(err)
+	}
+
+	command.AddCommand(newRuleAddCommand())
+	command.AddCommand(newRuleListCommand())
+	command.AddCommand(newRuleRemoveCommand())
+
+	return &command
+}
+
+func fmtRule(rule *entity.Rule) string {
+	scope := "all feeds"
+	if rule.FeedID != nil {
+		scope = fmt.Sprintf("feed ID=%d", *rule.FeedID)
+	}
+
+	action := rule.Action.String()
+	if rule.Action == entity.RuleActionTag {
+		action = fmt.Sprintf("%s %q", action, rule.Tag)
+	}
+
+	return fmt.Sprintf(
+		"\x1b[36m▶\x1b[0m ID=%d: %s %s '%s' on %s, %s",
+		rule.ID,
+		rule.Field,
+		rule.MatchType,
+		rule.Pattern,
+		scope,
+		action,
+	)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func newRuleAddCommand() *cobra.Command {
+
+	const (
+		name      = "add"
+		fieldKey  = "field"
+		matchKey  = "match"
+		actionKey = "action"
+		tagKey    = "tag"
+		feedKey   = "feed"
+		dryRunKey = "dry-run"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s PATTERN", name),
+		Args:    cobra.ExactArgs(1),
+		Aliases: makeAlias(name),
+		Short:   "Add an entry rule",
+		Long: `Add an entry rule
+
+Substring patterns match regardless of case, while regular expression patterns
+use the RE2 syntax and are case-sensitive unless they start with '(?i)'. With
+--dry-run, the existing entries matching the rule are listed and the rule is
+not added.`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			rule := entity.Rule{Pattern: args[0], Tag: v.GetString(tagKey)}
+
+			var err error
+			if rule.Field, err = entity.ParseRuleField(v.GetString(fieldKey)); err != nil {
+				return err
+			}
+			if rule.MatchType, err = entity.ParseRuleMatchType(v.GetString(matchKey)); err != nil {
+				return err
+			}
+			if rule.Action, err = entity.ParseRuleAction(v.GetString(actionKey)); err != nil {
+				return err
+			}
+			if rawID := v.GetString(feedKey); rawID != "" {
+				id, ferr := entity.ToFeedID(rawID)
+				if ferr != nil {
+					return ferr
+				}
+				rule.FeedID = &id
+			}
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			if v.GetBool(dryRunKey) {
+				entries, merr := db.MatchRule(cmd.Context(), &rule)
+				if merr != nil {
+					return merr
+				}
+				for _, entry := range entries {
+					fmt.Printf("%s\n", fmtListEntry(entry))
+				}
+				fmt.Printf("%d existing entries match the rule\n", len(entries))
+				return nil
+			}
+
+			created, err := db.CreateRule(cmd.Context(), &rule)
+			if err != nil {
+				return err
+			}
+			log.Info().Uint32("id", created.ID).Msg("added rule")
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.String(fieldKey, "title", "entry field to match: title, content, author, or url")
+	flags.StringP(matchKey, "m", "substring", "how to match the pattern: substring or regex")
+	flags.StringP(actionKey, "a", "", "action on matching entries: read, bookmark, tag, or drop")
+	flags.StringP(tagKey, "t", "", "tag added to matching entries by tag rules")
+	flags.StringP(feedKey, "f", "", "ID of the feed to which the rule applies")
+	flags.Bool(dryRunKey, false, "only list the existing entries matching the rule")
+
+	if err := command.MarkFlagRequired(actionKey); err != nil {
+		panic(err)
+	}
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newRuleListCommand() *cobra.Command {
+
+	const name = "list"
+
+	command := cobra.Command{
+		Use:     name,
+		Aliases: makeAlias(name),
+		Short:   "List entry rules",
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			rules, err := db.ListRules(cmd.Context())
+			if err != nil {
+				return err
+			}
+			for _, rule := range rules {
+				fmt.Printf("%s\n", fmtRule(rule))
+			}
+
+			return nil
+		},
+	}
+
+	return &command
+}
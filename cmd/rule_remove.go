@@ -0,0 +1,52 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+func newRuleRemoveCommand() *cobra.Command {
+
+	const name = "remove"
+
+	command := cobra.Command{
+		Use:     fmt.Sprintf("%s RULE-ID...", name),
+		Args:    cobra.MinimumNArgs(1),
+		Aliases: []string{"rm"},
+		Short:   "Remove entry rules",
+		Long: `Remove entry rules
+
+Entries already acted upon by the removed rules are not changed.`,
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			ids, err := entity.ToRuleIDs(sliceutil.Dedup(args))
+			if err != nil {
+				return err
+			}
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err = db.DeleteRules(cmd.Context(), ids); err != nil {
+				return err
+			}
+			log.Info().Int("num_rules", len(ids)).Msg("removed rules")
+
+			return nil
+		},
+	}
+
+	return &command
+}
@@ -4,15 +4,19 @@
 package cmd
 
 import (
+	"crypto/tls"
 	"fmt"
 	"strings"
+	"time"
 
 	zlog "github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
 	"github.com/bow/neon/internal/server"
+	"github.com/bow/neon/internal/tlsutil"
 )
 
 // newServerCommand creates a new 'server' subcommand along with its command-line flags.
@@ -31,6 +35,10 @@ func newServerCommand() *cobra.Command {
 		Short:   "Start a gRPC server",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 
+			if err := applyConfig(cmd, v, name); err != nil {
+				return err
+			}
+
 			datastore.SetLogger(zlog.Logger)
 			server.SetLogger(zlog.Logger)
 
@@ -52,16 +60,49 @@ func newServerCommand() *cobra.Command {
 	flags.BoolP(quietKey, "q", false, "hide startup banner")
 	flags.StringP(addrKey, "a", defaultServerAddr, "listening address")
 	flags.StringP(dbPathKey, "d", defaultDBPath, "datastore location")
+	flags.Duration(pullIntervalKey, 0, "interval between scheduled pulls; 0 disables them")
+	flags.StringToString(
+		pullIntervalFeedKey,
+		nil,
+		"per-feed pull interval overrides, as FEED-ID=DURATION pairs",
+	)
+	flags.Duration(pullJitterKey, 0, "maximum random delay added to each scheduled pull")
+	flags.Int(pullMaxConcurrentKey, 4, "maximum number of feeds pulled at the same time")
+	flags.Duration(pullTimeoutKey, 0, "timeout of each scheduled feed pull")
+	flags.Bool(pruneAfterPullKey, false, "prune entries by their retention policies after pulls")
+	flags.Bool(authKey, false, "require clients to authenticate with API tokens")
+	flags.String(tlsCertKey, "", "TLS certificate file; enables TLS when set together with a key")
+	flags.String(tlsKeyKey, "", "TLS private key file")
+	flags.String(tlsClientCAKey, "", "CA file for verifying client certificates; enables mTLS")
+	flags.String(metricsAddrKey, "", "HTTP address serving Prometheus metrics; unset disables it")
+	flags.String(gatewayAddrKey, "", "HTTP address serving the JSON API; unset disables it")
 
 	if err := v.BindPFlags(flags); err != nil {
 		panic(err)
 	}
 
 	command.AddCommand(newServerShowProtoCommand())
+	command.AddCommand(newServerShowOpenAPICommand())
+	command.AddCommand(newServerTokenCommand())
 
 	return &command
 }
 
+const (
+	pullIntervalKey      = "pull-interval"
+	pullIntervalFeedKey  = "pull-interval-feed"
+	pullJitterKey        = "pull-jitter"
+	pullMaxConcurrentKey = "pull-max-concurrent"
+	pullTimeoutKey       = "pull-timeout"
+	pruneAfterPullKey    = "prune-after-pull"
+	authKey              = "auth"
+	tlsCertKey           = "tls-cert"
+	tlsKeyKey            = "tls-key"
+	tlsClientCAKey       = "tls-client-ca"
+	metricsAddrKey       = "metrics-addr"
+	gatewayAddrKey       = "gateway-addr"
+)
+
 func makeServer(cmd *cobra.Command, v *viper.Viper, addr string) (*server.Server, error) {
 
 	dbPath, err := resolveDBPath(v.GetString(dbPathKey))
@@ -69,15 +110,66 @@ func makeServer(cmd *cobra.Command, v *viper.Viper, addr string) (*server.Server
 		return nil, err
 	}
 
+	feedIntervals, err := parseFeedPullIntervals(v.GetStringMapString(pullIntervalFeedKey))
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := makeServerTLSConfig(v)
+	if err != nil {
+		return nil, err
+	}
+
 	srv, err := server.NewBuilder().
 		Context(cmd.Context()).
 		Address(addr).
 		SQLite(dbPath).
+		PullInterval(v.GetDuration(pullIntervalKey)).
+		FeedPullIntervals(feedIntervals).
+		PullJitter(v.GetDuration(pullJitterKey)).
+		MaxConcurrentPulls(v.GetInt(pullMaxConcurrentKey)).
+		PullTimeout(v.GetDuration(pullTimeoutKey)).
+		PruneAfterPull(v.GetBool(pruneAfterPullKey)).
+		TLSConfig(tlsConfig).
+		RequireAuth(v.GetBool(authKey)).
+		MetricsAddress(v.GetString(metricsAddrKey)).
+		GatewayAddress(v.GetString(gatewayAddrKey)).
 		Build()
 
 	return srv, err
 }
 
+// makeServerTLSConfig creates the server TLS configuration from the TLS flags. It returns nil if
+// none of the flags are set.
+func makeServerTLSConfig(v *viper.Viper) (*tls.Config, error) {
+	var (
+		certFile     = v.GetString(tlsCertKey)
+		keyFile      = v.GetString(tlsKeyKey)
+		clientCAFile = v.GetString(tlsClientCAKey)
+	)
+	if certFile == "" && keyFile == "" && clientCAFile == "" {
+		return nil, nil
+	}
+	return tlsutil.ServerConfig(certFile, keyFile, clientCAFile)
+}
+
+// parseFeedPullIntervals parses the given feed ID to duration string mapping.
+func parseFeedPullIntervals(raw map[string]string) (map[entity.ID]time.Duration, error) {
+	intervals := make(map[entity.ID]time.Duration, len(raw))
+	for rawID, rawInterval := range raw {
+		id, err := entity.ToFeedID(rawID)
+		if err != nil {
+			return nil, err
+		}
+		interval, err := time.ParseDuration(rawInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pull interval of feed %s: %w", rawID, err)
+		}
+		intervals[id] = interval
+	}
+	return intervals, nil
+}
+
 // normalizeAddr ensures the specified address has either a 'tcp' or 'file' protocol. If the
 // input has no protocol prefix, 'tcp' is assumed.
 func normalizeAddr(addr string) string {
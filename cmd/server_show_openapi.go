@@ -0,0 +1,33 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/api"
+)
+
+// newServerShowOpenAPICommand creates a new subcommand for showing the OpenAPI document of the
+// server's HTTP/JSON gateway.
+func newServerShowOpenAPICommand() *cobra.Command {
+
+	const name = "show-openapi"
+
+	command := cobra.Command{
+		Use:     name,
+		Aliases: []string{"so"},
+		Short:   "Show the server OpenAPI document",
+
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fmt.Fprintf(cmd.OutOrStdout(), "%s", api.OpenAPI())
+			return nil
+		},
+	}
+
+	return &command
+}
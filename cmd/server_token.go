@@ -0,0 +1,167 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// newServerTokenCommand creates a new subcommand for managing the API tokens that clients
+// authenticate with.
+func newServerTokenCommand() *cobra.Command {
+
+	const name = "token"
+	var v = newViper("server-" + name)
+
+	command := cobra.Command{
+		Use:   name,
+		Short: "Manage API tokens",
+		Long: `Manage API tokens
+
+Tokens are required for calling the server when it is started with '--auth'.
+Each token has a scope: 'read' tokens may only view feeds and entries, 'write'
+tokens may also add, edit, and pull feeds and edit entries, and 'admin' tokens
+may call everything, including deleting feeds and importing subscriptions.`,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+
+			dbPath, err := resolveDBPath(v.GetString(dbPathKey))
+			if err != nil {
+				return err
+			}
+			dbPathToCmdCtx(cmd, dbPath)
+
+			return nil
+		},
+	}
+
+	pflags := command.PersistentFlags()
+
+	pflags.StringP(dbPathKey, "d", defaultDBPath, "datastore location")
+
+	if err := v.BindPFlags(pflags); err != nil {
+		panic(err)
+	}
+
+	command.AddCommand(newServerTokenCreateCommand())
+	command.AddCommand(newServerTokenListCommand())
+	command.AddCommand(newServerTokenRevokeCommand())
+
+	return &command
+}
+
+func newServerTokenCreateCommand() *cobra.Command {
+
+	const (
+		name     = "create"
+		scopeKey = "scope"
+	)
+	var v = newViper(name)
+
+	command := cobra.Command{
+		Use:   fmt.Sprintf("%s NAME", name),
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a new token",
+		Long: `Create a new token
+
+The token secret is shown only once, as it is not stored by the server.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			scope, err := entity.ParseTokenScope(v.GetString(scopeKey))
+			if err != nil {
+				return err
+			}
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, secret, err := db.CreateToken(cmd.Context(), args[0], scope)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", secret)
+
+			return nil
+		},
+	}
+
+	flags := command.Flags()
+
+	flags.StringP(scopeKey, "s", "read", "token scope: read, write, or admin")
+
+	if err := v.BindPFlags(flags); err != nil {
+		panic(err)
+	}
+
+	return &command
+}
+
+func newServerTokenListCommand() *cobra.Command {
+
+	const name = "list"
+
+	command := cobra.Command{
+		Use:     name,
+		Aliases: makeAlias(name),
+		Short:   "List tokens",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokens, err := db.ListTokens(cmd.Context())
+			if err != nil {
+				return err
+			}
+			for _, token := range tokens {
+				fmt.Fprintf(
+					cmd.OutOrStdout(),
+					"%s\t%s\t%s\n",
+					token.Name,
+					token.Scope,
+					fmtTime(token.CreateTime),
+				)
+			}
+
+			return nil
+		},
+	}
+
+	return &command
+}
+
+func newServerTokenRevokeCommand() *cobra.Command {
+
+	const name = "revoke"
+
+	command := cobra.Command{
+		Use:   fmt.Sprintf("%s NAME...", name),
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Revoke tokens",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			db, err := dbFromCmdCtx(cmd)
+			if err != nil {
+				return err
+			}
+
+			for _, tokenName := range args {
+				if err := db.RevokeToken(cmd.Context(), tokenName); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return &command
+}
@@ -63,6 +63,7 @@
               protobuf
               protoc-gen-go
               protoc-gen-go-grpc
+              grpc-gateway
               # nix-only
               deadnix
               nixfmt-rfc-style
@@ -36,6 +36,15 @@ type Datastore interface {
 		err error,
 	)
 
+	DiscoverFeeds(
+		ctx context.Context,
+		pageURL string,
+		timeout *time.Duration,
+	) (
+		candidates []*entity.FeedCandidate,
+		err error,
+	)
+
 	EditFeeds(
 		ctx context.Context,
 		ops []*entity.FeedEditOp,
@@ -47,8 +56,12 @@ type Datastore interface {
 	ListFeeds(
 		ctx context.Context,
 		maxEntriesPerFeed *uint32,
+		filter *entity.FeedFilter,
+		order entity.FeedSortOrder,
+		page *entity.Page,
 	) (
 		feeds []*entity.Feed,
+		nextPageToken string,
 		err error,
 	)
 
@@ -58,6 +71,7 @@ type Datastore interface {
 		entryReadStatus *bool,
 		maxEntriesPerFeed *uint32,
 		timeoutPerFeed *time.Duration,
+		force bool,
 	) (
 		results <-chan entity.PullResult,
 	)
@@ -71,10 +85,12 @@ type Datastore interface {
 
 	ListEntries(
 		ctx context.Context,
-		feedIDs []entity.ID,
-		isBookmarked *bool,
+		filter *entity.EntryFilter,
+		order entity.EntrySortOrder,
+		page *entity.Page,
 	) (
 		entries []*entity.Entry,
+		nextPageToken string,
 		err error,
 	)
 
@@ -94,6 +110,93 @@ type Datastore interface {
 		err error,
 	)
 
+	FetchEntryContent(
+		ctx context.Context,
+		id entity.ID,
+		timeout *time.Duration,
+	) (
+		entry *entity.Entry,
+		err error,
+	)
+
+	SearchEntries(
+		ctx context.Context,
+		query string,
+		feedIDs []entity.ID,
+		maxResults *uint32,
+	) (
+		results []*entity.SearchResult,
+		err error,
+	)
+
+	PruneEntries(
+		ctx context.Context,
+		feedIDs []entity.ID,
+		dryRun bool,
+	) (
+		results []*entity.PruneResult,
+		err error,
+	)
+
+	SetRetentionPolicy(
+		ctx context.Context,
+		policy *entity.RetentionPolicy,
+	) (
+		err error,
+	)
+
+	DeleteRetentionPolicy(
+		ctx context.Context,
+		feedID *entity.ID,
+	) (
+		err error,
+	)
+
+	ListRetentionPolicies(
+		ctx context.Context,
+	) (
+		policies []*entity.RetentionPolicy,
+		err error,
+	)
+
+	Optimize(
+		ctx context.Context,
+	) (
+		err error,
+	)
+
+	CreateToken(
+		ctx context.Context,
+		name string,
+		scope entity.TokenScope,
+	) (
+		token *entity.Token,
+		secret string,
+		err error,
+	)
+
+	ListTokens(
+		ctx context.Context,
+	) (
+		tokens []*entity.Token,
+		err error,
+	)
+
+	RevokeToken(
+		ctx context.Context,
+		name string,
+	) (
+		err error,
+	)
+
+	AuthenticateToken(
+		ctx context.Context,
+		secret string,
+	) (
+		token *entity.Token,
+		err error,
+	)
+
 	ExportSubscription(
 		ctx context.Context,
 		title *string,
@@ -111,6 +214,67 @@ type Datastore interface {
 		err error,
 	)
 
+	CreateFolder(
+		ctx context.Context,
+		name string,
+		parentID *entity.ID,
+	) (
+		folder *entity.Folder,
+		err error,
+	)
+
+	ListFolders(
+		ctx context.Context,
+	) (
+		folders []*entity.Folder,
+		err error,
+	)
+
+	EditFolders(
+		ctx context.Context,
+		ops []*entity.FolderEditOp,
+	) (
+		folders []*entity.Folder,
+		err error,
+	)
+
+	DeleteFolders(
+		ctx context.Context,
+		ids []entity.ID,
+	) (
+		err error,
+	)
+
+	CreateRule(
+		ctx context.Context,
+		rule *entity.Rule,
+	) (
+		created *entity.Rule,
+		err error,
+	)
+
+	ListRules(
+		ctx context.Context,
+	) (
+		rules []*entity.Rule,
+		err error,
+	)
+
+	DeleteRules(
+		ctx context.Context,
+		ids []entity.ID,
+	) (
+		err error,
+	)
+
+	MatchRule(
+		ctx context.Context,
+		rule *entity.Rule,
+	) (
+		entries []*entity.Entry,
+		err error,
+	)
+
 	GetGlobalStats(
 		ctx context.Context,
 	) (
@@ -138,9 +302,15 @@ type entriesTableType struct{}
 func (t *entriesTableType) name() string            { return "entries" }
 func (t *entriesTableType) errNotFound(id ID) error { return entity.EntryNotFoundError{ID: id} }
 
+type foldersTableType struct{}
+
+func (t *foldersTableType) name() string            { return "folders" }
+func (t *foldersTableType) errNotFound(id ID) error { return entity.FolderNotFoundError{ID: id} }
+
 var (
 	feedsTable   = &feedsTableType{}
 	entriesTable = &entriesTableType{}
+	foldersTable = &foldersTableType{}
 )
 
 func tableFieldSetter[T any](
@@ -168,11 +338,11 @@ func tableFieldSetter[T any](
 		var updatedID ID
 		err = stmt1.QueryRowContext(ctx, id, fieldValue).Scan(&updatedID)
 		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return table.errNotFound(id)
+			}
 			return err
 		}
-		if updatedID == 0 {
-			return table.errNotFound(id)
-		}
 		return nil
 	}
 }
@@ -0,0 +1,185 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// Discoverer is a Parser that can find the feeds published by a website.
+type Discoverer interface {
+	Parser
+	DiscoverFeedsWithContext(pageURL string, ctx context.Context) ([]*entity.FeedCandidate, error)
+}
+
+// Ensure feedParser implements Discoverer.
+var _ Discoverer = new(feedParser)
+
+// maxDiscoveryBodySize is the maximum number of bytes read from each fetched page.
+const maxDiscoveryBodySize = 5 << 20
+
+// feedLinkTypes are the media types of feeds advertised in HTML link tags. Plain JSON is not one
+// of them, since sites such as WordPress advertise their REST API with it on every page.
+var feedLinkTypes = []string{
+	"application/rss+xml",
+	"application/atom+xml",
+	"application/rdf+xml",
+	"application/feed+json",
+}
+
+// commonFeedPaths are the paths probed, in order, when a page advertises no feeds.
+var commonFeedPaths = []string{
+	"/feed",
+	"/rss.xml",
+	"/atom.xml",
+	"/feed.xml",
+	"/index.xml",
+	"/rss",
+}
+
+// DiscoverFeedsWithContext finds the feeds published by the website at the given URL. If the
+// URL points to a feed, it is the only candidate. Otherwise, the page is searched for feeds
+// advertised in its link tags, falling back to the first of the common feed paths that hosts a
+// feed.
+func (fp *feedParser) DiscoverFeedsWithContext(
+	pageURL string,
+	ctx context.Context,
+) ([]*entity.FeedCandidate, error) {
+
+	body, finalURL, err := fp.fetch(ctx, pageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if feed, perr := fp.parser.Parse(bytes.NewReader(body)); perr == nil {
+		return []*entity.FeedCandidate{{URL: pageURL, Title: feed.Title}}, nil
+	}
+
+	if candidates := extractFeedLinks(body, finalURL); len(candidates) > 0 {
+		return candidates, nil
+	}
+
+	for _, path := range commonFeedPaths {
+		probeURL := finalURL.ResolveReference(&url.URL{Path: path})
+		pbody, _, perr := fp.fetch(ctx, probeURL.String())
+		if perr != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			continue
+		}
+		if feed, perr := fp.parser.Parse(bytes.NewReader(pbody)); perr == nil {
+			return []*entity.FeedCandidate{{URL: probeURL.String(), Title: feed.Title}}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// fetch returns the body of the given URL and the URL it was fetched from, after redirects.
+func (fp *feedParser) fetch(ctx context.Context, rawURL string) ([]byte, *url.URL, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("User-Agent", fp.parser.UserAgent)
+
+	rsp, err := fp.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return nil, nil, gofeed.HTTPError{StatusCode: rsp.StatusCode, Status: rsp.Status}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(rsp.Body, maxDiscoveryBodySize))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body, rsp.Request.URL, nil
+}
+
+// extractFeedLinks returns the feeds advertised in the link tags of the given HTML page. Relative
+// links are resolved against the page URL, or against the page base tag if present.
+func extractFeedLinks(page []byte, pageURL *url.URL) []*entity.FeedCandidate {
+	var (
+		base       = pageURL
+		seen       = make(map[string]struct{})
+		candidates = make([]*entity.FeedCandidate, 0)
+		tokenizer  = html.NewTokenizer(bytes.NewReader(page))
+	)
+
+	for {
+		tt := tokenizer.Next()
+		if tt == html.ErrorToken {
+			return candidates
+		}
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			continue
+		}
+
+		token := tokenizer.Token()
+		switch token.DataAtom {
+		case atom.Base:
+			if href := attrValue(token, "href"); href != "" {
+				if ref, err := pageURL.Parse(href); err == nil {
+					base = ref
+				}
+			}
+		case atom.Link:
+			if !isFeedLink(token) {
+				continue
+			}
+			ref, err := base.Parse(attrValue(token, "href"))
+			if err != nil {
+				continue
+			}
+			feedURL := ref.String()
+			if _, ok := seen[feedURL]; ok {
+				continue
+			}
+			seen[feedURL] = struct{}{}
+			candidates = append(
+				candidates,
+				&entity.FeedCandidate{URL: feedURL, Title: attrValue(token, "title")},
+			)
+		}
+	}
+}
+
+func isFeedLink(token html.Token) bool {
+	if attrValue(token, "href") == "" {
+		return false
+	}
+	rels := strings.Fields(strings.ToLower(attrValue(token, "rel")))
+	if !slices.Contains(rels, "alternate") {
+		return false
+	}
+	mediaType, _, _ := strings.Cut(strings.ToLower(attrValue(token, "type")), ";")
+	return slices.Contains(feedLinkTypes, strings.TrimSpace(mediaType))
+}
+
+func attrValue(token html.Token, key string) string {
+	for _, attr := range token.Attr {
+		if attr.Key == key {
+			return strings.TrimSpace(attr.Val)
+		}
+	}
+	return ""
+}
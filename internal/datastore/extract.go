@@ -0,0 +1,377 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ContentExtractor is a Parser that can extract the main article of web pages.
+type ContentExtractor interface {
+	Parser
+	ExtractContentWithContext(pageURL string, ctx context.Context) (content string, err error)
+}
+
+// Ensure feedParser implements ContentExtractor.
+var _ ContentExtractor = new(feedParser)
+
+// errNoArticle is returned when no article can be found in a page.
+var errNoArticle = errors.New("no article found in page")
+
+// ExtractContentWithContext fetches the page at the given URL and returns the HTML of its main
+// article, stripped of scripts, navigation, and other page clutter. Relative links in the
+// article are resolved against the page URL.
+func (fp *feedParser) ExtractContentWithContext(
+	pageURL string,
+	ctx context.Context,
+) (string, error) {
+
+	body, finalURL, err := fp.fetch(ctx, pageURL)
+	if err != nil {
+		return "", err
+	}
+
+	return extractArticle(body, finalURL)
+}
+
+var (
+	// unlikelyCandidatePattern matches class names and IDs of elements that are unlikely to be
+	// part of the article.
+	unlikelyCandidatePattern = regexp.MustCompile(
+		`(?i)banner|breadcrumb|combx|comment|community|cookie|disqus|extra|footer|header|legend|` +
+			`menu|modal|nav|newsletter|pager|popup|related|remark|rss|share|shoutbox|sidebar|` +
+			`skyscraper|social|sponsor|subscribe|tool`,
+	)
+	// maybeCandidatePattern matches class names and IDs of elements that may be part of the
+	// article despite also matching unlikelyCandidatePattern.
+	maybeCandidatePattern = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
+	// positiveWeightPattern matches class names and IDs of elements likely to contain the
+	// article.
+	positiveWeightPattern = regexp.MustCompile(
+		`(?i)article|body|content|entry|hentry|h-entry|main|page|post|text|blog|story`,
+	)
+	// negativeWeightPattern matches class names and IDs of elements likely to contain clutter.
+	negativeWeightPattern = regexp.MustCompile(
+		`(?i)hidden|^hid$|banner|combx|comment|com-|contact|foot|footer|footnote|masthead|media|` +
+			`meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|` +
+			`shopping|tags|tool|widget`,
+	)
+)
+
+// keptAttrs are the element attributes kept in extracted articles.
+var keptAttrs = map[string]struct{}{
+	"href": {}, "src": {}, "alt": {}, "title": {}, "colspan": {}, "rowspan": {},
+}
+
+// extractArticle returns the HTML of the main article of the given page. Elements are scored by
+// the amount of paragraph text they contain, in the manner of Arc90's readability, and the best
+// scoring element is returned together with related siblings.
+func extractArticle(page []byte, pageURL *url.URL) (string, error) {
+	doc, err := html.Parse(bytes.NewReader(page))
+	if err != nil {
+		return "", err
+	}
+
+	base := pageURL
+	if node := findElement(doc, atom.Base); node != nil {
+		if ref, perr := pageURL.Parse(nodeAttr(node, "href")); perr == nil {
+			base = ref
+		}
+	}
+
+	body := findElement(doc, atom.Body)
+	if body == nil {
+		return "", errNoArticle
+	}
+	removeClutter(body)
+
+	scores, candidates := scoreCandidates(body)
+	var (
+		top      *html.Node
+		topScore float64
+	)
+	for _, node := range candidates {
+		score := scores[node] * (1 - linkDensity(node))
+		scores[node] = score
+		if top == nil || score > topScore {
+			top, topScore = node, score
+		}
+	}
+	if top == nil {
+		return "", errNoArticle
+	}
+
+	var (
+		buf       bytes.Buffer
+		threshold = max(10, topScore*0.2)
+	)
+	for _, node := range articleNodes(top, scores, threshold) {
+		cleanArticleNode(node, base)
+		if err := html.Render(&buf, node); err != nil {
+			return "", err
+		}
+	}
+
+	return buf.String(), nil
+}
+
+// removeClutter removes elements that are never part of the article.
+func removeClutter(root *html.Node) {
+	var remove []*html.Node
+	walkElements(root, func(node *html.Node) bool {
+		switch node.DataAtom {
+		case atom.Script, atom.Style, atom.Noscript, atom.Template, atom.Iframe, atom.Form,
+			atom.Nav, atom.Aside, atom.Footer, atom.Button, atom.Input, atom.Select,
+			atom.Textarea, atom.Svg, atom.Object, atom.Embed, atom.Link, atom.Meta:
+			remove = append(remove, node)
+			return false
+		case atom.Body, atom.Article, atom.Main:
+			return true
+		}
+		names := nodeAttr(node, "class") + " " + nodeAttr(node, "id")
+		if unlikelyCandidatePattern.MatchString(names) &&
+			!maybeCandidatePattern.MatchString(names) {
+			remove = append(remove, node)
+			return false
+		}
+		return true
+	})
+	for _, node := range remove {
+		node.Parent.RemoveChild(node)
+	}
+}
+
+// scoreCandidates scores the parents and grandparents of paragraphs by the length and number of
+// clauses of the paragraph text. The scored candidates are also returned in document order.
+func scoreCandidates(root *html.Node) (map[*html.Node]float64, []*html.Node) {
+	var (
+		scores     = make(map[*html.Node]float64)
+		candidates []*html.Node
+	)
+
+	addScore := func(node *html.Node, score float64) {
+		if node == nil || node.Type != html.ElementNode || node.DataAtom == atom.Html {
+			return
+		}
+		if _, exists := scores[node]; !exists {
+			scores[node] = initialScore(node)
+			candidates = append(candidates, node)
+		}
+		scores[node] += score
+	}
+
+	walkElements(root, func(node *html.Node) bool {
+		switch node.DataAtom {
+		case atom.P, atom.Pre, atom.Td:
+		case atom.Div, atom.Section:
+			if hasBlockChildren(node) {
+				return true
+			}
+		default:
+			return true
+		}
+
+		text := innerText(node)
+		if len(text) < 25 {
+			return false
+		}
+		score := 1 + float64(strings.Count(text, ",")) + min(float64(len(text)/100), 3)
+		addScore(node.Parent, score)
+		if node.Parent != nil {
+			addScore(node.Parent.Parent, score/2)
+		}
+		return false
+	})
+
+	return scores, candidates
+}
+
+func initialScore(node *html.Node) float64 {
+	var score float64
+	switch node.DataAtom {
+	case atom.Article:
+		score = 10
+	case atom.Div, atom.Main:
+		score = 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		score = 3
+	case atom.Address, atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li:
+		score = -3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		score = -5
+	}
+	return score + classWeight(node)
+}
+
+func classWeight(node *html.Node) float64 {
+	var weight float64
+	for _, name := range []string{nodeAttr(node, "class"), nodeAttr(node, "id")} {
+		if name == "" {
+			continue
+		}
+		if negativeWeightPattern.MatchString(name) {
+			weight -= 25
+		}
+		if positiveWeightPattern.MatchString(name) {
+			weight += 25
+		}
+	}
+	return weight
+}
+
+// articleNodes returns the given top candidate along with its siblings that are likely part of
+// the article as well.
+func articleNodes(top *html.Node, scores map[*html.Node]float64, threshold float64) []*html.Node {
+	if top.Parent == nil {
+		return []*html.Node{top}
+	}
+
+	var nodes []*html.Node
+	for sibling := top.Parent.FirstChild; sibling != nil; sibling = sibling.NextSibling {
+		if sibling.Type != html.ElementNode {
+			continue
+		}
+		if sibling == top {
+			nodes = append(nodes, sibling)
+			continue
+		}
+		if score, ok := scores[sibling]; ok && score >= threshold {
+			nodes = append(nodes, sibling)
+			continue
+		}
+		if sibling.DataAtom == atom.P {
+			text, density := innerText(sibling), linkDensity(sibling)
+			if len(text) > 80 && density < 0.25 ||
+				len(text) > 0 && density == 0 && strings.Contains(text, ". ") {
+				nodes = append(nodes, sibling)
+			}
+		}
+	}
+	return nodes
+}
+
+// cleanArticleNode removes presentational attributes and link-heavy blocks from the given
+// article node, and resolves its relative links against the given base URL.
+func cleanArticleNode(root *html.Node, base *url.URL) {
+	cleanAttrs(root, base)
+
+	var remove []*html.Node
+	walkElements(root, func(node *html.Node) bool {
+		switch node.DataAtom {
+		case atom.Div, atom.Section, atom.Ul, atom.Ol, atom.Table:
+			if len(innerText(node)) < 200 && linkDensity(node) > 0.5 {
+				remove = append(remove, node)
+				return false
+			}
+		}
+		cleanAttrs(node, base)
+		return true
+	})
+	for _, node := range remove {
+		node.Parent.RemoveChild(node)
+	}
+}
+
+func cleanAttrs(node *html.Node, base *url.URL) {
+	attrs := node.Attr[:0]
+	for _, attr := range node.Attr {
+		if _, keep := keptAttrs[attr.Key]; !keep {
+			continue
+		}
+		if attr.Key == "href" || attr.Key == "src" {
+			if ref, err := base.Parse(strings.TrimSpace(attr.Val)); err == nil {
+				attr.Val = ref.String()
+			}
+		}
+		attrs = append(attrs, attr)
+	}
+	node.Attr = attrs
+}
+
+// walkElements calls the given function on the element descendants of the given node, in
+// document order. Children of elements for which the function returns false are skipped.
+func walkElements(node *html.Node, f func(*html.Node) bool) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type != html.ElementNode {
+			continue
+		}
+		if f(child) {
+			walkElements(child, f)
+		}
+	}
+}
+
+func findElement(node *html.Node, a atom.Atom) *html.Node {
+	var found *html.Node
+	walkElements(node, func(n *html.Node) bool {
+		if found == nil && n.DataAtom == a {
+			found = n
+		}
+		return found == nil
+	})
+	return found
+}
+
+func hasBlockChildren(node *html.Node) bool {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		switch child.DataAtom {
+		case atom.Address, atom.Article, atom.Blockquote, atom.Div, atom.Dl, atom.Figure,
+			atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Ol, atom.P, atom.Pre,
+			atom.Section, atom.Table, atom.Ul:
+			return true
+		}
+	}
+	return false
+}
+
+// innerText returns the text content of the given node, with consecutive whitespace collapsed.
+func innerText(node *html.Node) string {
+	var sb strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+			sb.WriteString(" ")
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(node)
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// linkDensity returns the fraction of the text of the given node that is inside links.
+func linkDensity(node *html.Node) float64 {
+	textLen := len(innerText(node))
+	if textLen == 0 {
+		return 0
+	}
+	var linkLen int
+	walkElements(node, func(n *html.Node) bool {
+		if n.DataAtom == atom.A {
+			linkLen += len(innerText(n))
+			return false
+		}
+		return true
+	})
+	return float64(linkLen) / float64(textLen)
+}
+
+func nodeAttr(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
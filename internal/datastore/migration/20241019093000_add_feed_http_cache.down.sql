@@ -0,0 +1,3 @@
+ALTER TABLE feeds DROP COLUMN last_http_status;
+ALTER TABLE feeds DROP COLUMN last_modified;
+ALTER TABLE feeds DROP COLUMN etag;
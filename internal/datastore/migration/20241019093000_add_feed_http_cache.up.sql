@@ -0,0 +1,10 @@
+-- etag is the ETag header value returned by the last successful fetch of the feed.
+ALTER TABLE feeds ADD COLUMN etag TEXT NULL CHECK(etag IS NULL or length(etag) > 0);
+
+-- last_modified is the Last-Modified header value returned by the last successful fetch of the
+-- feed.
+ALTER TABLE feeds ADD COLUMN last_modified TEXT NULL
+  CHECK(last_modified IS NULL or length(last_modified) > 0);
+
+-- last_http_status is the HTTP status code of the last fetch of the feed.
+ALTER TABLE feeds ADD COLUMN last_http_status INTEGER NULL;
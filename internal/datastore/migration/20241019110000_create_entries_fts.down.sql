@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS entries_fts_delete;
+DROP TABLE IF EXISTS entries_fts;
@@ -0,0 +1,23 @@
+CREATE VIRTUAL TABLE IF NOT EXISTS
+  -- entries_fts is the full-text search index of entries; its rowid is the ID of the entry.
+  entries_fts
+  USING fts5
+  -- title is the entry title.
+  ( title
+  -- description is the entry description, stripped of markup.
+  , description
+  -- content is the entry content, stripped of markup.
+  , content
+  , tokenize = 'porter unicode61 remove_diacritics 2'
+  );
+
+-- Existing entries are indexed by the datastore once the migrations are done, so that their
+-- markup is stripped like that of entries inserted or updated afterwards.
+
+CREATE TRIGGER IF NOT EXISTS
+  -- entries_fts_delete removes deleted entries, including cascaded deletes, from the index.
+  entries_fts_delete
+  AFTER DELETE ON entries
+  BEGIN
+    DELETE FROM entries_fts WHERE rowid = old.id;
+  END;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS entry_tombstones;
+DROP INDEX IF EXISTS retention_policies_global;
+DROP TABLE IF EXISTS retention_policies;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS
+  -- retention_policies contains the rules that determine which entries are pruned.
+  retention_policies
+  -- id is the internal database ID of the policy.
+  ( id INTEGER PRIMARY KEY AUTOINCREMENT
+  -- feed_id is the internal database ID of the feed to which the policy applies; the global
+  -- policy, which applies to feeds without their own policies, has no feed ID.
+  , feed_id INTEGER NULL
+  -- max_age_secs is the maximum age of entries in seconds, based on their update or publication
+  -- time.
+  , max_age_secs INTEGER NULL CHECK(max_age_secs IS NULL or max_age_secs > 0)
+  -- max_entries is the maximum number of entries kept per feed, newest first.
+  , max_entries INTEGER NULL CHECK(max_entries IS NULL or max_entries >= 0)
+  -- keep_bookmarked indicates whether bookmarked entries are never pruned.
+  , keep_bookmarked BOOLEAN NOT NULL DEFAULT true
+  -- keep_unread indicates whether unread entries are never pruned.
+  , keep_unread BOOLEAN NOT NULL DEFAULT false
+  -- there is at most one policy per feed.
+  , UNIQUE(feed_id)
+  , FOREIGN KEY(feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+  );
+-- there is at most one global policy.
+CREATE UNIQUE INDEX IF NOT EXISTS retention_policies_global
+  ON retention_policies((feed_id IS NULL)) WHERE feed_id IS NULL;
+
+CREATE TABLE IF NOT EXISTS
+  -- entry_tombstones records pruned entries, so that they are not added again by later pulls
+  -- while their feeds still contain them.
+  entry_tombstones
+  -- feed_id is the internal database ID of the feed of the pruned entry.
+  ( feed_id INTEGER NOT NULL
+  -- external_id is the externally-defined ID value of the pruned entry.
+  , external_id TEXT NOT NULL
+  , PRIMARY KEY (feed_id, external_id)
+  , FOREIGN KEY(feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+  );
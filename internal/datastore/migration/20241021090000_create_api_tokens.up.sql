@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS
+  -- api_tokens contains the tokens used for authenticating service clients.
+  api_tokens
+  -- id is the internal database ID of the token.
+  ( id INTEGER PRIMARY KEY AUTOINCREMENT
+  -- name is the user-defined name of the token.
+  , name TEXT UNIQUE NOT NULL CHECK(name != '')
+  -- secret_hash is the hex-encoded SHA-256 hash of the token secret; the secret itself is not
+  -- stored.
+  , secret_hash TEXT UNIQUE NOT NULL
+  -- scope determines the operations allowed for the token.
+  , scope TEXT NOT NULL CHECK(scope IN ('read', 'write', 'admin'))
+  -- create_time is when the token was created.
+  , create_time TIMESTAMP NOT NULL
+  );
@@ -0,0 +1,3 @@
+ALTER TABLE feeds DROP COLUMN folder_id;
+DROP INDEX IF EXISTS folders_parent_name;
+DROP TABLE IF EXISTS folders;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS
+  -- folders contains the user-defined, possibly nested, folders of feeds.
+  folders
+  -- id is the internal database ID of the folder.
+  ( id INTEGER PRIMARY KEY AUTOINCREMENT
+  -- name is the user-defined name of the folder.
+  , name TEXT NOT NULL CHECK(name != '')
+  -- parent_id is the internal database ID of the folder containing the folder; top-level folders
+  -- have no parent ID.
+  , parent_id INTEGER NULL
+  , FOREIGN KEY(parent_id) REFERENCES folders(id) ON DELETE CASCADE
+  );
+-- folder names are unique among the folders of the same parent.
+CREATE UNIQUE INDEX IF NOT EXISTS folders_parent_name
+  ON folders(COALESCE(parent_id, 0), name);
+
+-- folder_id is the internal database ID of the folder containing the feed; feeds outside of any
+-- folder have no folder ID.
+ALTER TABLE feeds ADD COLUMN folder_id INTEGER NULL REFERENCES folders(id) ON DELETE SET NULL;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS
+  -- entry_rules contains the user-defined rules applied to new entries when feeds are pulled.
+  entry_rules
+  -- id is the internal database ID of the rule.
+  ( id INTEGER PRIMARY KEY AUTOINCREMENT
+  -- feed_id is the internal database ID of the feed to whose entries the rule applies; rules
+  -- without feed IDs apply to the entries of all feeds.
+  , feed_id INTEGER NULL
+  -- field is the entry field matched by the rule.
+  , field TEXT NOT NULL CHECK(field IN ('title', 'content', 'author', 'url'))
+  -- match_type determines how the pattern is matched against the field.
+  , match_type TEXT NOT NULL CHECK(match_type IN ('substring', 'regex'))
+  -- pattern is the substring or regular expression matched against the field.
+  , pattern TEXT NOT NULL CHECK(pattern != '')
+  -- action is what is done with matching entries.
+  , action TEXT NOT NULL CHECK(action IN ('read', 'bookmark', 'tag', 'drop'))
+  -- tag is the tag added to matching entries by tag rules.
+  , tag TEXT NULL CHECK((action = 'tag') = (tag IS NOT NULL AND tag != ''))
+  -- create_time is when the rule was created.
+  , create_time TIMESTAMP NOT NULL
+  , FOREIGN KEY(feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+  );
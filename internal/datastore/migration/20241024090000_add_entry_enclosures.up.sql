@@ -0,0 +1,3 @@
+-- enclosures lists the files attached to the entry, such as podcast audio or images, as objects
+-- with the url, type, and length keys.
+ALTER TABLE entries ADD COLUMN enclosures JSON NOT NULL DEFAULT '[]';
@@ -0,0 +1,3 @@
+-- fetch_content is whether the full content of new entries is fetched from their URL when the
+-- feed is pulled.
+ALTER TABLE feeds ADD COLUMN fetch_content BOOLEAN NOT NULL DEFAULT false;
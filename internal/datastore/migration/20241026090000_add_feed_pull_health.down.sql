@@ -0,0 +1,3 @@
+ALTER TABLE feeds DROP COLUMN next_pull_time;
+ALTER TABLE feeds DROP COLUMN last_pull_error;
+ALTER TABLE feeds DROP COLUMN pull_failures;
@@ -0,0 +1,6 @@
+-- pull_failures is the number of consecutive failed pulls of the feed.
+ALTER TABLE feeds ADD COLUMN pull_failures INTEGER NOT NULL DEFAULT 0 CHECK (pull_failures >= 0);
+-- last_pull_error is the error message of the latest failed pull, cleared on successful pulls.
+ALTER TABLE feeds ADD COLUMN last_pull_error TEXT NULL;
+-- next_pull_time is the earliest time the feed is pulled again after failed pulls.
+ALTER TABLE feeds ADD COLUMN next_pull_time TIMESTAMP NULL;
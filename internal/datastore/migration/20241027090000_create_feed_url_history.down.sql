@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS feed_url_history_feed_id;
+DROP TABLE IF EXISTS feed_url_history;
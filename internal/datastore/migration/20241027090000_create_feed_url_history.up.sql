@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS
+  -- feed_url_history contains the URLs feeds were subscribed with before they moved.
+  feed_url_history
+  -- url is the former URL of the feed.
+  ( url TEXT PRIMARY KEY CHECK(length(url) > 0)
+  -- feed_id is the internal database ID of the feed that moved.
+  , feed_id INTEGER NOT NULL
+  -- move_time is when the move of the feed was detected.
+  , move_time TIMESTAMP NOT NULL
+  , FOREIGN KEY(feed_id) REFERENCES feeds(id) ON DELETE CASCADE
+  );
+CREATE INDEX IF NOT EXISTS feed_url_history_feed_id ON feed_url_history(feed_id);
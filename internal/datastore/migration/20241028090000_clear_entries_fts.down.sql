@@ -0,0 +1,2 @@
+-- Removed entries are indexed again when the datastore is opened, so nothing is restored here.
+SELECT 1;
@@ -0,0 +1,3 @@
+-- Entries indexed with their markup by an earlier version of the entries_fts migration are
+-- removed from the index. They are indexed again by the datastore once the migrations are done.
+DELETE FROM entries_fts;
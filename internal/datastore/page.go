@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// pageCursor points to the last item of a returned page. The next page starts with the item
+// that directly follows it in the sort order.
+type pageCursor struct {
+	// Kind identifies the listing and its sort order, so that tokens can not be reused across
+	// listings.
+	Kind string `json:"k"`
+	// SortKey is the sort key value of the item.
+	SortKey string `json:"s"`
+	// ID is the ID of the item, which breaks ties between equal sort keys.
+	ID ID `json:"i"`
+}
+
+func (c *pageCursor) token() string {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// parsePageToken returns the cursor encoded in the given token, or nil if the token is empty.
+func parsePageToken(token string, kind string) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	invalid := entity.InvalidArgumentError{Reason: "invalid page token"}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	var c pageCursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, invalid
+	}
+	if c.Kind != kind {
+		return nil, invalid
+	}
+
+	return &c, nil
+}
+
+// pageLimit returns the number of rows to query for the given page. One row more than the page
+// size is queried to find out whether there is a next page. Negative values mean no limits.
+func pageLimit(page *entity.Page) int64 {
+	if page == nil || page.Size == 0 {
+		return -1
+	}
+	return int64(page.Size) + 1
+}
+
+func pageToken(page *entity.Page) string {
+	if page == nil {
+		return ""
+	}
+	return page.Token
+}
@@ -5,6 +5,7 @@ package datastore
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/mmcdole/gofeed"
 )
@@ -13,3 +14,138 @@ import (
 type Parser interface {
 	ParseURLWithContext(feedURL string, ctx context.Context) (feed *gofeed.Feed, err error)
 }
+
+// ConditionalParser is a Parser that can skip downloading and parsing feeds that have not
+// changed since a previous fetch, using HTTP cache validators.
+type ConditionalParser interface {
+	Parser
+	ParseURLConditionallyWithContext(
+		feedURL string,
+		cache *HTTPCache,
+		ctx context.Context,
+	) (
+		feed *gofeed.Feed,
+		meta *HTTPCache,
+		err error,
+	)
+}
+
+// HTTPCache contains the HTTP caching metadata of a feed fetch.
+type HTTPCache struct {
+	ETag         *string
+	LastModified *string
+	Status       *int
+	// MovedTo is the URL the feed was permanently redirected to, if any.
+	MovedTo *string
+	// Redirected is whether the feed was served from another URL than the requested one.
+	Redirected bool
+}
+
+// NotModified returns true if the fetch was answered with a 304 status.
+func (hc *HTTPCache) NotModified() bool {
+	return hc != nil && hc.Status != nil && *hc.Status == http.StatusNotModified
+}
+
+// feedParser is a ConditionalParser that fetches feeds over HTTP before parsing them with
+// gofeed.
+type feedParser struct {
+	client *http.Client
+	parser *gofeed.Parser
+}
+
+// Ensure feedParser implements ConditionalParser.
+var _ ConditionalParser = new(feedParser)
+
+func newFeedParser() *feedParser {
+	return &feedParser{client: http.DefaultClient, parser: gofeed.NewParser()}
+}
+
+func (fp *feedParser) ParseURLWithContext(
+	feedURL string,
+	ctx context.Context,
+) (*gofeed.Feed, error) {
+	feed, _, err := fp.ParseURLConditionallyWithContext(feedURL, nil, ctx)
+	return feed, err
+}
+
+// ParseURLConditionallyWithContext fetches and parses the feed at the given URL. If the given
+// cache metadata contains validators, they are sent along with the request. When the server
+// responds that the feed has not been modified, the returned feed is nil and the returned
+// metadata carries the validators of the given cache.
+func (fp *feedParser) ParseURLConditionallyWithContext(
+	feedURL string,
+	cache *HTTPCache,
+	ctx context.Context,
+) (*gofeed.Feed, *HTTPCache, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feedURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("User-Agent", fp.parser.UserAgent)
+	if cache != nil {
+		if cache.ETag != nil {
+			req.Header.Set("If-None-Match", *cache.ETag)
+		}
+		if cache.LastModified != nil {
+			req.Header.Set("If-Modified-Since", *cache.LastModified)
+		}
+	}
+
+	rsp, err := fp.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rsp.Body.Close()
+
+	meta := HTTPCache{
+		Status:     &rsp.StatusCode,
+		MovedTo:    permanentRedirect(rsp),
+		Redirected: rsp.Request.Response != nil,
+	}
+
+	if rsp.StatusCode == http.StatusNotModified {
+		if cache != nil {
+			meta.ETag = cache.ETag
+			meta.LastModified = cache.LastModified
+		}
+		return nil, &meta, nil
+	}
+
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return nil, &meta, gofeed.HTTPError{StatusCode: rsp.StatusCode, Status: rsp.Status}
+	}
+
+	meta.ETag = pointerOrNil(rsp.Header.Get("ETag"))
+	meta.LastModified = pointerOrNil(rsp.Header.Get("Last-Modified"))
+
+	feed, err := fp.parser.Parse(rsp.Body)
+	if err != nil {
+		return nil, &meta, err
+	}
+
+	return feed, &meta, nil
+}
+
+// permanentRedirect returns the URL the request of the given response was permanently redirected
+// to. Only permanent redirects directly following the original request are taken into account,
+// so a permanent redirect after a temporary one is ignored. It returns nil if the original
+// request was not permanently redirected.
+func permanentRedirect(rsp *http.Response) *string {
+	// Requests are collected from the last one, which received the given response.
+	reqs := []*http.Request{rsp.Request}
+	for req := rsp.Request; req.Response != nil; req = req.Response.Request {
+		reqs = append(reqs, req.Response.Request)
+	}
+
+	var movedTo *string
+	for i := len(reqs) - 1; i > 0; i-- {
+		switch reqs[i-1].Response.StatusCode {
+		case http.StatusMovedPermanently, http.StatusPermanentRedirect:
+			movedTo = pointer(reqs[i-1].URL.String())
+		default:
+			return movedTo
+		}
+	}
+	return movedTo
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=internal/datastore/parser.go -package=datastore Parser
+//	mockgen -source=internal/datastore/parser.go -package=datastore -self_package=github.com/bow/neon/internal/datastore Parser
 //
 
 // Package datastore is a generated GoMock package.
@@ -54,3 +54,57 @@ func (mr *MockParserMockRecorder) ParseURLWithContext(feedURL, ctx any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseURLWithContext", reflect.TypeOf((*MockParser)(nil).ParseURLWithContext), feedURL, ctx)
 }
+
+// MockConditionalParser is a mock of ConditionalParser interface.
+type MockConditionalParser struct {
+	ctrl     *gomock.Controller
+	recorder *MockConditionalParserMockRecorder
+}
+
+// MockConditionalParserMockRecorder is the mock recorder for MockConditionalParser.
+type MockConditionalParserMockRecorder struct {
+	mock *MockConditionalParser
+}
+
+// NewMockConditionalParser creates a new mock instance.
+func NewMockConditionalParser(ctrl *gomock.Controller) *MockConditionalParser {
+	mock := &MockConditionalParser{ctrl: ctrl}
+	mock.recorder = &MockConditionalParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConditionalParser) EXPECT() *MockConditionalParserMockRecorder {
+	return m.recorder
+}
+
+// ParseURLConditionallyWithContext mocks base method.
+func (m *MockConditionalParser) ParseURLConditionallyWithContext(feedURL string, cache *HTTPCache, ctx context.Context) (*gofeed.Feed, *HTTPCache, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseURLConditionallyWithContext", feedURL, cache, ctx)
+	ret0, _ := ret[0].(*gofeed.Feed)
+	ret1, _ := ret[1].(*HTTPCache)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ParseURLConditionallyWithContext indicates an expected call of ParseURLConditionallyWithContext.
+func (mr *MockConditionalParserMockRecorder) ParseURLConditionallyWithContext(feedURL, cache, ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseURLConditionallyWithContext", reflect.TypeOf((*MockConditionalParser)(nil).ParseURLConditionallyWithContext), feedURL, cache, ctx)
+}
+
+// ParseURLWithContext mocks base method.
+func (m *MockConditionalParser) ParseURLWithContext(feedURL string, ctx context.Context) (*gofeed.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseURLWithContext", feedURL, ctx)
+	ret0, _ := ret[0].(*gofeed.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseURLWithContext indicates an expected call of ParseURLWithContext.
+func (mr *MockConditionalParserMockRecorder) ParseURLWithContext(feedURL, ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseURLWithContext", reflect.TypeOf((*MockConditionalParser)(nil).ParseURLWithContext), feedURL, ctx)
+}
@@ -8,6 +8,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,33 +19,46 @@ import (
 )
 
 type feedRecord struct {
-	id          ID
-	title       string
-	description sql.NullString
-	feedURL     string
-	siteURL     sql.NullString
-	subscribed  time.Time
-	lastPulled  time.Time
-	updated     sql.NullTime
-	isStarred   bool
-	tags        jsonArrayString
-	entries     []*entryRecord
+	id           ID
+	title        string
+	description  sql.NullString
+	feedURL      string
+	siteURL      sql.NullString
+	subscribed   time.Time
+	lastPulled   time.Time
+	updated      sql.NullTime
+	isStarred    bool
+	tags         jsonArrayString
+	folderID     sql.NullInt64
+	fetchContent bool
+	pullFailures uint32
+	pullError    sql.NullString
+	nextPull     sql.NullTime
+	entries      []*entryRecord
 }
 
 func (rec *feedRecord) feed() *entity.Feed {
-	return &entity.Feed{
-		ID:          rec.id,
-		Title:       rec.title,
-		Description: fromNullString(rec.description),
-		FeedURL:     rec.feedURL,
-		SiteURL:     fromNullString(rec.siteURL),
-		Subscribed:  rec.subscribed,
-		LastPulled:  rec.lastPulled,
-		Updated:     fromNullTime(rec.updated),
-		IsStarred:   rec.isStarred,
-		Tags:        []string(rec.tags),
-		Entries:     entryRecords(rec.entries).entriesMap(),
+	feed := entity.Feed{
+		ID:            rec.id,
+		Title:         rec.title,
+		Description:   fromNullString(rec.description),
+		FeedURL:       rec.feedURL,
+		SiteURL:       fromNullString(rec.siteURL),
+		Subscribed:    rec.subscribed,
+		LastPulled:    rec.lastPulled,
+		Updated:       fromNullTime(rec.updated),
+		IsStarred:     rec.isStarred,
+		Tags:          []string(rec.tags),
+		FetchContent:  rec.fetchContent,
+		PullFailures:  rec.pullFailures,
+		LastPullError: fromNullString(rec.pullError),
+		NextPullTime:  fromNullTime(rec.nextPull),
+		Entries:       entryRecords(rec.entries).entriesMap(),
+	}
+	if rec.folderID.Valid {
+		feed.FolderID = pointer(ID(rec.folderID.Int64))
 	}
+	return &feed
 }
 
 type feedRecords []*feedRecord
@@ -70,6 +85,9 @@ type entryRecord struct {
 	description  sql.NullString
 	content      sql.NullString
 	url          sql.NullString
+	authors      jsonArrayString
+	tags         jsonArrayString
+	enclosures   jsonEnclosures
 }
 
 func (rec *entryRecord) entry() *entity.Entry {
@@ -85,6 +103,9 @@ func (rec *entryRecord) entry() *entity.Entry {
 		Description:  fromNullString(rec.description),
 		Content:      fromNullString(rec.content),
 		URL:          fromNullString(rec.url),
+		Authors:      []string(rec.authors),
+		Tags:         []string(rec.tags),
+		Enclosures:   rec.enclosures.enclosures(),
 	}
 }
 
@@ -145,6 +166,47 @@ func (aggr *statsAggregateRecord) stats() *entity.Stats {
 	return &stats
 }
 
+type retentionPolicyRecord struct {
+	feedID         sql.NullInt64
+	maxAgeSecs     sql.NullInt64
+	maxEntries     sql.NullInt64
+	keepBookmarked bool
+	keepUnread     bool
+}
+
+func (rec *retentionPolicyRecord) policy() *entity.RetentionPolicy {
+	policy := entity.RetentionPolicy{
+		KeepBookmarked: rec.keepBookmarked,
+		KeepUnread:     rec.keepUnread,
+	}
+	if rec.feedID.Valid {
+		policy.FeedID = pointer(ID(rec.feedID.Int64))
+	}
+	if rec.maxAgeSecs.Valid {
+		policy.MaxAge = pointer(time.Duration(rec.maxAgeSecs.Int64) * time.Second)
+	}
+	if rec.maxEntries.Valid {
+		policy.MaxEntries = pointer(uint32(rec.maxEntries.Int64))
+	}
+	return &policy
+}
+
+type pruneRecord struct {
+	feedID    ID
+	feedTitle string
+	numPruned uint32
+	numKept   uint32
+}
+
+func (rec *pruneRecord) pruneResult() *entity.PruneResult {
+	return &entity.PruneResult{
+		FeedID:    rec.feedID,
+		FeedTitle: rec.feedTitle,
+		NumPruned: rec.numPruned,
+		NumKept:   rec.numKept,
+	}
+}
+
 // jsonArrayString is a wrapper type that implements Scan() for database-compatible
 // (de)serialization.
 type jsonArrayString []string
@@ -173,6 +235,85 @@ func (arr *jsonArrayString) Scan(value any) error {
 	return json.Unmarshal(bv, arr)
 }
 
+// jsonEnclosures is a wrapper type that implements Scan() for deserializing entry enclosures.
+type jsonEnclosures []*enclosureRecord
+
+type enclosureRecord struct {
+	URL    string `json:"url"`
+	Type   string `json:"type,omitempty"`
+	Length uint64 `json:"length,omitempty"`
+}
+
+// Scan implements the database scanner interface for deserialization out of the database.
+func (arr *jsonEnclosures) Scan(value any) error {
+	var bv []byte
+
+	switch v := value.(type) {
+	case []byte:
+		bv = v
+	case string:
+		bv = []byte(v)
+	default:
+		return fmt.Errorf("value of type %T can not be scanned into enclosures", v)
+	}
+
+	return json.Unmarshal(bv, arr)
+}
+
+func (arr jsonEnclosures) enclosures() []*entity.Enclosure {
+	if len(arr) == 0 {
+		return nil
+	}
+	enclosures := make([]*entity.Enclosure, len(arr))
+	for i, rec := range arr {
+		enclosures[i] = &entity.Enclosure{URL: rec.URL, Type: rec.Type, Length: rec.Length}
+	}
+	return enclosures
+}
+
+// resolveEntryEnclosures returns the enclosures of the given entry. Enclosures without URLs are
+// skipped, and invalid lengths are treated as unknown.
+func resolveEntryEnclosures(entry *gofeed.Item) []*enclosureRecord {
+	recs := make([]*enclosureRecord, 0)
+	for _, enc := range entry.Enclosures {
+		if enc == nil || enc.URL == "" {
+			continue
+		}
+		length, err := strconv.ParseUint(strings.TrimSpace(enc.Length), 10, 64)
+		if err != nil {
+			length = 0
+		}
+		recs = append(recs, &enclosureRecord{URL: enc.URL, Type: enc.Type, Length: length})
+	}
+	return recs
+}
+
+// resolveEntryCategories returns the distinct, non-empty categories of the given entry.
+func resolveEntryCategories(entry *gofeed.Item) []string {
+	categories := make([]string, 0)
+	for _, category := range entry.Categories {
+		category = strings.TrimSpace(category)
+		if category != "" && !slices.Contains(categories, category) {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// resolveEntryAuthors returns the names of the authors of the given entry.
+func resolveEntryAuthors(entry *gofeed.Item) []string {
+	names := make([]string, 0)
+	for _, person := range entry.Authors {
+		if person != nil && person.Name != "" {
+			names = append(names, person.Name)
+		}
+	}
+	if len(names) == 0 && entry.Author != nil && entry.Author.Name != "" {
+		names = append(names, entry.Author.Name)
+	}
+	return names
+}
+
 func resolveFeedUpdateTime(feed *gofeed.Feed) *time.Time {
 	// Use feed value if defined.
 	var latest = feed.UpdatedParsed
@@ -221,6 +362,24 @@ func fromNullString(v sql.NullString) *string {
 	return &v.String
 }
 
+// toNullStringOrNil wraps the given string pointer into a sql.NullString pointer, with empty
+// strings becoming NULL. If the input pointer is nil, nil is returned.
+func toNullStringOrNil(v *string) *sql.NullString {
+	if v == nil {
+		return nil
+	}
+	return &sql.NullString{String: *v, Valid: *v != ""}
+}
+
+// toNullIDOrNil wraps the given ID pointer into a sql.NullInt64 pointer, with zero IDs becoming
+// NULL. If the input pointer is nil, nil is returned.
+func toNullIDOrNil(v *ID) *sql.NullInt64 {
+	if v == nil {
+		return nil
+	}
+	return &sql.NullInt64{Int64: int64(*v), Valid: *v != 0}
+}
+
 func fromNullTime(v sql.NullTime) *time.Time {
 	if !v.Valid {
 		return nil
@@ -244,5 +403,102 @@ func deref[T any](v *T, def T) T {
 	return def
 }
 
+// toJSON returns the JSON serialization of the given value as a string.
+func toJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 // pointer returns a pointer to the value.
 func pointer[T any](value T) *T { return &value }
+
+type tokenRecord struct {
+	id         ID
+	name       string
+	scope      string
+	createTime time.Time
+}
+
+func (rec *tokenRecord) token() *entity.Token {
+	// The scope column is constrained to valid values, so parsing can not fail.
+	scope, _ := entity.ParseTokenScope(rec.scope)
+	return &entity.Token{
+		ID:         rec.id,
+		Name:       rec.name,
+		Scope:      scope,
+		CreateTime: rec.createTime,
+	}
+}
+
+type folderRecord struct {
+	id       ID
+	name     string
+	parentID sql.NullInt64
+}
+
+func (rec *folderRecord) folder() *entity.Folder {
+	folder := entity.Folder{ID: rec.id, Name: rec.name}
+	if rec.parentID.Valid {
+		folder.ParentID = pointer(ID(rec.parentID.Int64))
+	}
+	return &folder
+}
+
+type folderRecords []*folderRecord
+
+func (recs folderRecords) folders() []*entity.Folder {
+
+	folders := make([]*entity.Folder, len(recs))
+	for i, rec := range recs {
+		folders[i] = rec.folder()
+	}
+
+	return folders
+}
+
+type ruleRecord struct {
+	id         ID
+	feedID     sql.NullInt64
+	field      string
+	matchType  string
+	pattern    string
+	action     string
+	tag        sql.NullString
+	createTime time.Time
+}
+
+func (rec *ruleRecord) rule() *entity.Rule {
+	// The field, match type, and action columns are constrained to valid values, so parsing can
+	// not fail.
+	field, _ := entity.ParseRuleField(rec.field)
+	matchType, _ := entity.ParseRuleMatchType(rec.matchType)
+	action, _ := entity.ParseRuleAction(rec.action)
+	rule := entity.Rule{
+		ID:         rec.id,
+		Field:      field,
+		MatchType:  matchType,
+		Pattern:    rec.pattern,
+		Action:     action,
+		Tag:        rec.tag.String,
+		CreateTime: rec.createTime,
+	}
+	if rec.feedID.Valid {
+		rule.FeedID = pointer(ID(rec.feedID.Int64))
+	}
+	return &rule
+}
+
+type ruleRecords []*ruleRecord
+
+func (recs ruleRecords) rules() []*entity.Rule {
+
+	rules := make([]*entity.Rule, len(recs))
+	for i, rec := range recs {
+		rules[i] = rec.rule()
+	}
+
+	return rules
+}
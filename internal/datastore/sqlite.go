@@ -9,9 +9,9 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
-	"github.com/mmcdole/gofeed"
 
 	"github.com/bow/neon/internal/datastore/migration"
 )
@@ -20,13 +20,22 @@ type SQLite struct {
 	mu     sync.RWMutex
 	handle *sql.DB
 	parser Parser
+
+	txObserver TxObserver
 }
 
+// indexBatchSize is the number of entries indexed per transaction when indexing entries missing
+// from the full-text search index.
+const indexBatchSize = 500
+
+// TxObserver is called after every transaction with its duration and its error, if any.
+type TxObserver func(duration time.Duration, err error)
+
 // Ensure SQLite implements Datastore.
 var _ Datastore = new(SQLite)
 
 func NewSQLite(filename string) (*SQLite, error) {
-	return newSQLiteWithParser(filename, gofeed.NewParser())
+	return newSQLiteWithParser(filename, newFeedParser())
 }
 
 func newSQLiteWithParser(filename string, parser Parser) (*SQLite, error) {
@@ -65,13 +74,28 @@ func newSQLiteWithParser(filename string, parser Parser) (*SQLite, error) {
 
 	db := SQLite{handle: handle, parser: parser}
 
+	if err = db.indexMissingEntries(context.Background(), indexBatchSize); err != nil {
+		return nil, fail(err)
+	}
+
 	return &db, nil
 }
 
+// ObserveTx sets the function called after every transaction. It must be set before the
+// datastore is used.
+func (db *SQLite) ObserveTx(observer TxObserver) {
+	db.txObserver = observer
+}
+
 func (db *SQLite) withTx(
 	ctx context.Context,
 	dbFunc func(context.Context, *sql.Tx) error,
 ) (err error) {
+	if observe := db.txObserver; observe != nil {
+		start := time.Now()
+		defer func() { observe(time.Since(start), err) }()
+	}
+
 	tx, err := db.handle.BeginTx(ctx, nil)
 	if err != nil {
 		return err
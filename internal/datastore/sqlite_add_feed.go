@@ -6,6 +6,9 @@ package datastore
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"slices"
 	"time"
 
 	"github.com/mmcdole/gofeed"
@@ -13,7 +16,9 @@ import (
 	"github.com/bow/neon/internal/entity"
 )
 
-// AddFeed adds the given feed into the database.
+// AddFeed adds the given feed into the database. If the URL points to a website instead of a
+// feed, the feed published by the website is added. The returned feed contains only the entries
+// that were inserted or updated.
 func (db *SQLite) AddFeed(
 	ctx context.Context,
 	feedURL string,
@@ -35,13 +40,18 @@ func (db *SQLite) AddFeed(
 		defer cancel()
 	}
 
-	feed, err := db.parser.ParseURLWithContext(feedURL, actx)
+	feed, meta, err := db.parseFeed(actx, feedURL)
+	if errors.Is(err, gofeed.ErrFeedTypeNotDetected) {
+		feed, meta, feedURL, err = db.parseDiscoveredFeed(actx, feedURL, err)
+	}
 	if err != nil {
 		return nil, false, err
 	}
-	// Handle possible specs deviations.
-	if feed.FeedLink == "" {
-		feed.FeedLink = feedURL
+	subURL := feedURL
+	if movedURL := movedFeedURL(feedURL, meta); movedURL != "" {
+		subURL = movedURL
+	} else if link := selfLinkURL(feedURL, feed); link != "" && servesFeed(actx, db.parser, link) {
+		subURL = link
 	}
 
 	var (
@@ -52,10 +62,22 @@ func (db *SQLite) AddFeed(
 
 		now := time.Now()
 
+		// Feeds that have moved are only known by their current URL.
+		for _, u := range []string{subURL, feedURL} {
+			currentURL, ierr := getMovedFeedURL(ctx, tx, u)
+			if ierr != nil {
+				return ierr
+			}
+			if currentURL != "" {
+				subURL = currentURL
+				break
+			}
+		}
+
 		feedID, feedAdded, ierr := upsertFeed(
 			ctx,
 			tx,
-			feed.FeedLink,
+			subURL,
 			pointerOrNil(deref(title, feed.Title)),
 			pointerOrNil(deref(desc, feed.Description)),
 			pointerOrNil(feed.Link),
@@ -66,8 +88,18 @@ func (db *SQLite) AddFeed(
 		if ierr != nil {
 			return ierr
 		}
+		if subURL != feedURL {
+			if ierr = addFeedURLHistory(ctx, tx, feedID, feedURL, subURL, now); ierr != nil {
+				return ierr
+			}
+		}
 
-		if ierr = upsertEntries(ctx, tx, feedID, feed.Items); ierr != nil {
+		changedIDs, ierr := upsertEntries(ctx, tx, feedID, feed.Items)
+		if ierr != nil {
+			return ierr
+		}
+		changed, ierr := getEntriesByIDs(ctx, tx, changedIDs)
+		if ierr != nil {
 			return ierr
 		}
 
@@ -84,6 +116,7 @@ func (db *SQLite) AddFeed(
 		if record, ierr = getFeed(ctx, tx, feedID); ierr != nil {
 			return ierr
 		}
+		record.entries = changed
 		added = &feedAdded
 
 		return nil
@@ -100,6 +133,46 @@ func (db *SQLite) AddFeed(
 	return record.feed(), *added, nil
 }
 
+// parseFeed parses the feed at the given URL. The fetch metadata is returned as well if the
+// parser provides it.
+func (db *SQLite) parseFeed(ctx context.Context, feedURL string) (*gofeed.Feed, *HTTPCache, error) {
+	if cp, ok := db.parser.(ConditionalParser); ok {
+		return cp.ParseURLConditionallyWithContext(feedURL, nil, ctx)
+	}
+	feed, err := db.parser.ParseURLWithContext(feedURL, ctx)
+	return feed, nil, err
+}
+
+// parseDiscoveredFeed parses the feed published by the website at the given URL, for URLs that
+// do not point to feeds themselves. The given parse error is returned if no feed is found.
+func (db *SQLite) parseDiscoveredFeed(
+	ctx context.Context,
+	pageURL string,
+	parseErr error,
+) (*gofeed.Feed, *HTTPCache, string, error) {
+
+	discoverer, ok := db.parser.(Discoverer)
+	if !ok {
+		return nil, nil, pageURL, parseErr
+	}
+
+	candidates, err := discoverer.DiscoverFeedsWithContext(pageURL, ctx)
+	if err != nil {
+		return nil, nil, pageURL, err
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, nil, pageURL, parseErr
+	case 1:
+		feedURL := candidates[0].URL
+		feed, meta, err := db.parseFeed(ctx, feedURL)
+		return feed, meta, feedURL, err
+	default:
+		return nil, nil, pageURL, entity.AmbiguousFeedURLError{URL: pageURL, Candidates: candidates}
+	}
+}
+
 func upsertFeed(
 	ctx context.Context,
 	tx *sql.Tx,
@@ -197,7 +270,7 @@ func editFeedWithFeedURL(
 	if err := setFeedTitle(ctx, tx, feedID, title); err != nil {
 		return 0, err
 	}
-	if err := setFeedDescription(ctx, tx, feedID, desc); err != nil {
+	if err := setFeedDescription(ctx, tx, feedID, toNullStringOrNil(desc)); err != nil {
 		return 0, err
 	}
 	if err := setFeedIsStarred(ctx, tx, feedID, isStarred); err != nil {
@@ -209,12 +282,14 @@ func editFeedWithFeedURL(
 	return feedID, nil
 }
 
+// upsertEntries inserts new entries and updates changed entries of the given feed, applying the
+// entry rules to the inserted entries. It returns the IDs of the inserted and updated entries.
 func upsertEntries(
 	ctx context.Context,
 	tx *sql.Tx,
 	feedID ID,
 	entries []*gofeed.Item,
-) error {
+) ([]ID, error) {
 
 	sql1 := `
 		INSERT INTO
@@ -227,22 +302,50 @@ func upsertEntries(
 				, content
 				, pub_time
 				, update_time
+				, authors
+				, tags
+				, enclosures
 			)
-			VALUES(?, ?, ?, ?, ?, ?, ?, ?)
+			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+			WHERE NOT EXISTS (
+				SELECT 1 FROM entry_tombstones t WHERE t.feed_id = $1 AND t.external_id = $2
+			)
+		RETURNING
+			id
 `
 	stmt1, err := tx.PrepareContext(ctx, sql1)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer stmt1.Close()
 
-	// TODO: Also update other entry columns.
 	sql2 := `
 		UPDATE
 			entries
 		SET
 			is_read = false,
-			update_time = $1
+			update_time = $1,
+			url = $4,
+			title = $5,
+			description = $6,
+			content = $7,
+			authors = $8,
+			enclosures = $9,
+			-- Existing tags are kept, since they may have been added by rules.
+			tags = (
+				SELECT
+					json_group_array(value)
+				FROM (
+					SELECT value FROM json_each(entries.tags)
+					UNION ALL
+					SELECT
+						value
+					FROM
+						json_each($10)
+					WHERE
+						value NOT IN (SELECT value FROM json_each(entries.tags))
+				)
+			)
 		WHERE
 			feed_id = $2
 			AND external_id = $3
@@ -251,48 +354,208 @@ func upsertEntries(
 				OR update_time IS NOT NULL AND $1 IS NULL
 				OR update_time != $1
 			)
+		RETURNING
+			id
 `
 	stmt2, err := tx.PrepareContext(ctx, sql2)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer stmt2.Close()
 
+	idx, err := newEntryIndexer(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	defer idx.close()
+
+	matchers, err := getRuleMatchers(ctx, tx, feedID)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]ID, 0)
 	upsert := func(entry *gofeed.Item, insertStmt, updateStmt *sql.Stmt) error {
-		updateTime := resolveEntryUpdateTime(entry)
-		_, err := insertStmt.ExecContext(
+		var (
+			entryID    ID
+			updateTime = resolveEntryUpdateTime(entry)
+			desc       = pointerOrNil(entry.Description)
+			content    = pointerOrNil(entry.Content)
+			authors    = resolveEntryAuthors(entry)
+			categories = resolveEntryCategories(entry)
+		)
+		authorsJSON, err := toJSON(authors)
+		if err != nil {
+			return err
+		}
+		categoriesJSON, err := toJSON(categories)
+		if err != nil {
+			return err
+		}
+		enclosuresJSON, err := toJSON(resolveEntryEnclosures(entry))
+		if err != nil {
+			return err
+		}
+		err = insertStmt.QueryRowContext(
 			ctx,
 			feedID,
 			entry.GUID,
 			entry.Link,
 			entry.Title,
-			pointerOrNil(entry.Description),
-			pointerOrNil(entry.Content),
+			desc,
+			content,
 			resolveEntryPublishedTime(entry),
 			updateTime,
-		)
-		if err != nil {
+			authorsJSON,
+			categoriesJSON,
+			enclosuresJSON,
+		).Scan(&entryID)
+		if err == nil {
+			target := entity.RuleTarget{
+				FeedID:      feedID,
+				Title:       entry.Title,
+				Description: entry.Description,
+				Content:     entry.Content,
+				Authors:     authors,
+				URL:         entry.Link,
+			}
+			kept, aerr := applyRules(ctx, tx, entryID, entry.GUID, matchers, &target, categories)
+			if aerr != nil {
+				return aerr
+			}
+			// Dropped entries are neither indexed nor reported.
+			if !kept {
+				return nil
+			}
+		} else {
+			// No rows means the entry was pruned before.
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
 			if !isUniqueErr(err, "UNIQUE constraint failed: entries.feed_id, entries.external_id") {
 				return err
 			}
-			if _, ierr := updateStmt.ExecContext(
+			ierr := updateStmt.QueryRowContext(
 				ctx,
 				updateTime,
 				feedID,
 				entry.GUID,
-			); ierr != nil {
+				entry.Link,
+				entry.Title,
+				desc,
+				content,
+				authorsJSON,
+				enclosuresJSON,
+				categoriesJSON,
+			).Scan(&entryID)
+			if ierr != nil {
+				// No rows means the entry has not changed.
+				if errors.Is(ierr, sql.ErrNoRows) {
+					return nil
+				}
 				return ierr
 			}
 		}
-		return nil
+		changed = append(changed, entryID)
+		return idx.index(ctx, entryID, entry.Title, desc, content)
 	}
 
 	for _, entry := range entries {
 		if err := upsert(entry, stmt1, stmt2); err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+
+	// Forget pruned entries that the feed no longer contains.
+	extIDs := make([]string, len(entries))
+	for i, entry := range entries {
+		extIDs[i] = entry.GUID
+	}
+	extIDsJSON, err := json.Marshal(extIDs)
+	if err != nil {
+		return nil, err
+	}
+	sql3 := `
+		DELETE FROM
+			entry_tombstones
+		WHERE
+			feed_id = $1
+			AND external_id NOT IN (SELECT value FROM json_each($2))
+`
+	if _, err := tx.ExecContext(ctx, sql3, feedID, string(extIDsJSON)); err != nil {
+		return nil, err
+	}
+
+	return changed, nil
+}
+
+// applyRules applies the rules of the given matchers to the newly-inserted entry with the given
+// tags. It returns false if the entry was dropped by any of the rules. Dropped entries are
+// tombstoned like pruned entries, so they are not inserted again by later pulls.
+func applyRules(
+	ctx context.Context,
+	tx *sql.Tx,
+	entryID ID,
+	extID string,
+	matchers []*entity.RuleMatcher,
+	target *entity.RuleTarget,
+	tags []string,
+) (bool, error) {
+
+	var (
+		isRead, isBookmarked bool
+		numTags              = len(tags)
+	)
+	for _, m := range matchers {
+		if !m.Matches(target) {
+			continue
+		}
+		rule := m.Rule()
+		switch rule.Action {
+		case entity.RuleActionDrop:
+			_, err := tx.ExecContext(
+				ctx,
+				`INSERT OR IGNORE INTO entry_tombstones(feed_id, external_id) VALUES (?, ?)`,
+				target.FeedID,
+				extID,
+			)
+			if err != nil {
+				return false, err
+			}
+			_, err = tx.ExecContext(ctx, `DELETE FROM entries WHERE id = ?`, entryID)
+			return false, err
+		case entity.RuleActionMarkRead:
+			isRead = true
+		case entity.RuleActionBookmark:
+			isBookmarked = true
+		case entity.RuleActionTag:
+			if !slices.Contains(tags, rule.Tag) {
+				tags = append(tags, rule.Tag)
+			}
+		}
+	}
+
+	if !isRead && !isBookmarked && len(tags) == numTags {
+		return true, nil
+	}
+
+	tagsJSON, err := toJSON(tags)
+	if err != nil {
+		return false, err
+	}
+	sql1 := `
+		UPDATE
+			entries
+		SET
+			is_read = is_read OR $2,
+			is_bookmarked = is_bookmarked OR $3,
+			tags = $4
+		WHERE
+			id = $1
+`
+	_, err = tx.ExecContext(ctx, sql1, entryID, isRead, isBookmarked, tagsJSON)
+
+	return true, err
 }
 
 func addFeedTags(
@@ -5,12 +5,18 @@ package datastore
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/mmcdole/gofeed"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
 )
 
 func TestAddFeedOkMinimal(t *testing.T) {
@@ -30,6 +36,10 @@ func TestAddFeedOkMinimal(t *testing.T) {
 	db.parser.EXPECT().
 		ParseURLWithContext(feed.Link, gomock.Any()).
 		Return(&feed, nil)
+	// The self link of the feed is checked before subscribing to it.
+	db.parser.EXPECT().
+		ParseURLWithContext(feed.FeedLink, gomock.Any()).
+		Return(&feed, nil)
 
 	existf := func() bool {
 		return db.rowExists(
@@ -105,6 +115,10 @@ func TestAddFeedOkExtended(t *testing.T) {
 	db.parser.EXPECT().
 		ParseURLWithContext(feed.Link, gomock.Any()).
 		Return(&feed, nil)
+	// The self link of the feed is checked before subscribing to it.
+	db.parser.EXPECT().
+		ParseURLWithContext(feed.FeedLink, gomock.Any()).
+		Return(&feed, nil)
 
 	existf1 := func() bool {
 		return db.rowExists(
@@ -150,6 +164,7 @@ func TestAddFeedOkExtended(t *testing.T) {
 	a.Equal(feed.FeedLink, record.FeedURL)
 	a.Equal(tags, record.Tags)
 	a.True(record.IsStarred)
+	a.Len(record.Entries, 2)
 
 	a.Equal(1, db.countFeeds())
 	a.Equal(2, db.countEntries(feed.FeedLink))
@@ -198,6 +213,10 @@ func TestAddFeedOkURLExists(t *testing.T) {
 	db.parser.EXPECT().
 		ParseURLWithContext(feed.Link, gomock.Any()).
 		Return(&feed, nil)
+	// The self link of the feed is checked before subscribing to it.
+	db.parser.EXPECT().
+		ParseURLWithContext(feed.FeedLink, gomock.Any()).
+		Return(&feed, nil)
 
 	db.addFeedWithURL(feed.FeedLink)
 
@@ -241,6 +260,7 @@ func TestAddFeedOkURLExists(t *testing.T) {
 	a.Equal(feed.FeedLink, record.FeedURL)
 	a.Equal(tags, record.Tags)
 	a.True(record.IsStarred)
+	a.Len(record.Entries, 2)
 
 	a.Equal(1, db.countFeeds())
 	a.Equal(2, db.countEntries(feed.FeedLink))
@@ -250,6 +270,163 @@ func TestAddFeedOkURLExists(t *testing.T) {
 	a.True(existe(feed.Items[1]))
 }
 
+func TestAddFeedOkDiscovered(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{
+		"/": `<html><head>
+<link rel="alternate" type="application/rss+xml" href="/feed.xml">
+<link rel="alternate" type="application/json" href="/wp-json/wp/v2/pages/2">
+</head></html>`,
+		"/feed.xml": testRSS,
+	})
+
+	record, added, err := db.AddFeed(context.Background(), srv.URL, nil, nil, nil, nil, nil)
+	r.NoError(err)
+
+	a.True(added)
+	a.Equal("Feed A", record.Title)
+	a.Equal(srv.URL+"/feed.xml", record.FeedURL)
+	a.Equal(1, db.countFeeds())
+	a.Equal(2, db.countEntries(srv.URL+"/feed.xml"))
+}
+
+func TestAddFeedOkMovedPermanently(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/old.xml" {
+			http.Redirect(w, req, "/new.xml", http.StatusMovedPermanently)
+			return
+		}
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer srv.Close()
+
+	oldURL, newURL := srv.URL+"/old.xml", srv.URL+"/new.xml"
+
+	record, added, err := db.AddFeed(context.Background(), oldURL, nil, nil, nil, nil, nil)
+	r.NoError(err)
+	a.True(added)
+	a.Equal(newURL, record.FeedURL)
+	a.Equal([]string{oldURL}, db.getFeedURLHistory(newURL))
+
+	// Adding the feed with either URL does not create duplicates.
+	for _, feedURL := range []string{oldURL, newURL} {
+		record, added, err = db.AddFeed(context.Background(), feedURL, nil, nil, nil, nil, nil)
+		r.NoError(err)
+		a.False(added)
+		a.Equal(newURL, record.FeedURL)
+	}
+	a.Equal(1, db.countFeeds())
+}
+
+func TestAddFeedOkFormerURL(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	// The former URL still serves the feed, without redirecting.
+	srv := newTestSite(t, map[string]string{"/old.xml": testRSS, "/new.xml": testRSS})
+	oldURL, newURL := srv.URL+"/old.xml", srv.URL+"/new.xml"
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: newURL}})
+	tx := db.tx()
+	feedID := keys["Feed A"].ID
+	r.NoError(addFeedURLHistory(context.Background(), tx, feedID, oldURL, newURL, time.Now()))
+	r.NoError(tx.Commit())
+
+	record, added, err := db.AddFeed(context.Background(), oldURL, nil, nil, nil, nil, nil)
+	r.NoError(err)
+	a.False(added)
+	a.Equal(newURL, record.FeedURL)
+	a.Equal(1, db.countFeeds())
+}
+
+func TestAddFeedOkSelfLinkStale(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	// The feed is served over HTTPS, while its self link is an HTTP URL that redirects back.
+	var plainURL string
+	tlsSrv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, testSelfLinkRSS, plainURL+"/feed.xml")
+	}))
+	defer tlsSrv.Close()
+	plainSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Redirect(w, req, tlsSrv.URL+req.URL.Path, http.StatusMovedPermanently)
+	}))
+	defer plainSrv.Close()
+	plainURL = plainSrv.URL
+
+	db := newTestSQLiteDBWithClient(t, tlsSrv.Client())
+	feedURL := tlsSrv.URL + "/feed.xml"
+
+	record, added, err := db.AddFeed(context.Background(), feedURL, nil, nil, nil, nil, nil)
+	r.NoError(err)
+	a.True(added)
+	a.Equal(feedURL, record.FeedURL)
+	a.Empty(db.getFeedURLHistory(feedURL))
+}
+
+func TestAddFeedErrAmbiguous(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{
+		"/": `<html><head>
+<link rel="alternate" type="application/rss+xml" title="Posts" href="/posts.xml">
+<link rel="alternate" type="application/rss+xml" title="Comments" href="/comments.xml">
+</head></html>`,
+	})
+
+	record, added, err := db.AddFeed(context.Background(), srv.URL, nil, nil, nil, nil, nil)
+	r.Nil(record)
+	a.False(added)
+
+	var aerr entity.AmbiguousFeedURLError
+	r.ErrorAs(err, &aerr)
+	a.Equal(srv.URL, aerr.URL)
+	a.Equal(
+		[]*entity.FeedCandidate{
+			{URL: srv.URL + "/posts.xml", Title: "Posts"},
+			{URL: srv.URL + "/comments.xml", Title: "Comments"},
+		},
+		aerr.Candidates,
+	)
+	a.Equal(0, db.countFeeds())
+}
+
+func TestAddFeedErrNoFeedDiscovered(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{"/": `<html><head></head></html>`})
+
+	record, _, err := db.AddFeed(context.Background(), srv.URL, nil, nil, nil, nil, nil)
+	r.Nil(record)
+	a.ErrorIs(err, gofeed.ErrFeedTypeNotDetected)
+	a.Equal(0, db.countFeeds())
+}
+
 // Query for checking that a feed exists.
 const feedExistSQL = `
 	SELECT
@@ -276,3 +453,192 @@ const feedEntryExistSQL = `
 		AND e.title = ?
 		AND e.url = ?
 `
+
+func TestUpsertEntriesOkRules(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{
+		{title: "Feed A", feedURL: "https://a.com/feed.xml"},
+		{title: "Feed B", feedURL: "https://b.com/feed.xml"},
+	})
+	feedA, feedB := keys["Feed A"].ID, keys["Feed B"].ID
+
+	db.addRule(&entity.Rule{
+		Field:     entity.RuleFieldURL,
+		MatchType: entity.RuleMatchSubstring,
+		Pattern:   "/ads/",
+		Action:    entity.RuleActionDrop,
+	})
+	db.addRule(&entity.Rule{
+		Field:     entity.RuleFieldTitle,
+		MatchType: entity.RuleMatchSubstring,
+		Pattern:   "release",
+		Action:    entity.RuleActionTag,
+		Tag:       "releases",
+	})
+	db.addRule(&entity.Rule{
+		Field:     entity.RuleFieldTitle,
+		MatchType: entity.RuleMatchRegex,
+		Pattern:   `v\d+`,
+		Action:    entity.RuleActionTag,
+		Tag:       "versions",
+	})
+	db.addRule(&entity.Rule{
+		FeedID:    &feedA,
+		Field:     entity.RuleFieldAuthor,
+		MatchType: entity.RuleMatchSubstring,
+		Pattern:   "bot",
+		Action:    entity.RuleActionMarkRead,
+	})
+	db.addRule(&entity.Rule{
+		Field:     entity.RuleFieldContent,
+		MatchType: entity.RuleMatchSubstring,
+		Pattern:   "must read",
+		Action:    entity.RuleActionBookmark,
+	})
+
+	tx := db.tx()
+	changedA, err := upsertEntries(context.Background(), tx, feedA, []*gofeed.Item{
+		{GUID: "a1", Title: "Sale", Link: "https://a.com/ads/1"},
+		{GUID: "a2", Title: "Release v2", Link: "https://a.com/a2"},
+		{
+			GUID:    "a3",
+			Title:   "Digest",
+			Link:    "https://a.com/a3",
+			Authors: []*gofeed.Person{{Name: "digest-bot"}},
+		},
+		{GUID: "a4", Title: "Essay", Link: "https://a.com/a4", Description: "A must read."},
+	})
+	r.NoError(err)
+	changedB, err := upsertEntries(context.Background(), tx, feedB, []*gofeed.Item{
+		{
+			GUID:    "b1",
+			Title:   "Digest",
+			Link:    "https://b.com/b1",
+			Authors: []*gofeed.Person{{Name: "digest-bot"}},
+		},
+	})
+	r.NoError(err)
+	r.NoError(tx.Commit())
+
+	a.Len(changedA, 3)
+	a.Len(changedB, 1)
+	a.Equal(3, db.countEntries("https://a.com/feed.xml"))
+
+	entries, _, err := db.ListEntries(
+		context.Background(),
+		nil,
+		entity.EntrySortUpdateTimeDesc,
+		nil,
+	)
+	r.NoError(err)
+	byKey := make(map[string]*entity.Entry)
+	for _, entry := range entries {
+		byKey[fmt.Sprintf("%d/%s", entry.FeedID, entry.ExtID)] = entry
+	}
+	r.Len(byKey, 4)
+
+	release := byKey[fmt.Sprintf("%d/a2", feedA)]
+	a.Equal([]string{"releases", "versions"}, release.Tags)
+	a.False(release.IsRead)
+	a.False(release.IsBookmarked)
+
+	a.True(byKey[fmt.Sprintf("%d/a3", feedA)].IsRead)
+	a.False(byKey[fmt.Sprintf("%d/b1", feedB)].IsRead)
+
+	essay := byKey[fmt.Sprintf("%d/a4", feedA)]
+	a.True(essay.IsBookmarked)
+	a.Empty(essay.Tags)
+
+	// Rules only apply to new entries.
+	db.addRule(&entity.Rule{
+		Field:     entity.RuleFieldTitle,
+		MatchType: entity.RuleMatchSubstring,
+		Pattern:   "essay",
+		Action:    entity.RuleActionDrop,
+	})
+	db.upsertEntries(feedA, []*gofeed.Item{
+		{GUID: "a4", Title: "Essay", Link: "https://a.com/a4", Description: "A must read."},
+	})
+	a.Equal(3, db.countEntries("https://a.com/feed.xml"))
+}
+
+func TestUpsertEntriesOkRulesDropTombstoned(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "https://a.com/feed.xml"}})
+	feedID := keys["Feed A"].ID
+
+	db.addRule(&entity.Rule{
+		Field:     entity.RuleFieldURL,
+		MatchType: entity.RuleMatchSubstring,
+		Pattern:   "/ads/",
+		Action:    entity.RuleActionDrop,
+	})
+
+	ad := gofeed.Item{GUID: "a1", Title: "Sale", Link: "https://a.com/ads/1"}
+	db.upsertEntries(feedID, []*gofeed.Item{&ad})
+	a.Equal(0, db.countEntries("https://a.com/feed.xml"))
+	a.True(
+		db.rowExists(
+			`SELECT 1 FROM entry_tombstones WHERE feed_id = ? AND external_id = ?`,
+			feedID,
+			"a1",
+		),
+	)
+
+	// The dropped entry is skipped by later pulls, without taking up another ID.
+	post := gofeed.Item{GUID: "a2", Title: "Post", Link: "https://a.com/a2"}
+	db.upsertEntries(feedID, []*gofeed.Item{&ad, &post})
+	a.Equal(1, db.countEntries("https://a.com/feed.xml"))
+	a.True(db.rowExists(`SELECT 1 FROM entries WHERE id = 2 AND external_id = ?`, "a2"))
+}
+
+func TestUpsertEntriesOkUpdateKeepsTags(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "https://a.com/feed.xml"}})
+	feedID := keys["Feed A"].ID
+
+	db.addRule(&entity.Rule{
+		Field:     entity.RuleFieldTitle,
+		MatchType: entity.RuleMatchSubstring,
+		Pattern:   "essay",
+		Action:    entity.RuleActionTag,
+		Tag:       "long",
+	})
+
+	item := gofeed.Item{
+		GUID:          "a1",
+		Title:         "Essay",
+		Link:          "https://a.com/a1",
+		Categories:    []string{"culture"},
+		UpdatedParsed: pointer(mustTime(t, "2024-03-01T10:00:00Z")),
+	}
+	db.upsertEntries(feedID, []*gofeed.Item{&item})
+
+	item.Categories = []string{"culture", "history"}
+	item.Enclosures = []*gofeed.Enclosure{{URL: "https://a.com/a1.jpg", Type: "image/jpeg"}}
+	item.UpdatedParsed = pointer(mustTime(t, "2024-03-02T10:00:00Z"))
+	db.upsertEntries(feedID, []*gofeed.Item{&item})
+
+	entries, _, err := db.ListEntries(context.Background(), nil, entity.EntrySortUpdateTimeDesc, nil)
+	r.NoError(err)
+	r.Len(entries, 1)
+	a.Equal([]string{"culture", "long", "history"}, entries[0].Tags)
+	a.Equal(
+		[]*entity.Enclosure{{URL: "https://a.com/a1.jpg", Type: "image/jpeg"}},
+		entries[0].Enclosures,
+	)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// AuthenticateToken returns the API token with the given secret. An InvalidTokenError is
+// returned if no such token exists.
+func (db *SQLite) AuthenticateToken(ctx context.Context, secret string) (*entity.Token, error) {
+
+	var rec *tokenRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		recs, err := getTokens(ctx, tx, pointer(hashTokenSecret(secret)))
+		if err != nil {
+			return err
+		}
+		if len(recs) == 0 {
+			return entity.InvalidTokenError{}
+		}
+		rec = recs[0]
+		return nil
+	}
+
+	fail := failF("SQLite.AuthenticateToken")
+
+	if secret == "" {
+		return nil, fail(entity.InvalidTokenError{})
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return rec.token(), nil
+}
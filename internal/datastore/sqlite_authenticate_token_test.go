@@ -0,0 +1,50 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestAuthenticateTokenOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	created, secret, err := db.CreateToken(context.Background(), "laptop", entity.TokenScopeWrite)
+	r.NoError(err)
+	_, _, err = db.CreateToken(context.Background(), "phone", entity.TokenScopeRead)
+	r.NoError(err)
+
+	token, err := db.AuthenticateToken(context.Background(), secret)
+	r.NoError(err)
+	a.Equal(created.ID, token.ID)
+	a.Equal("laptop", token.Name)
+	a.Equal(entity.TokenScopeWrite, token.Scope)
+}
+
+func TestAuthenticateTokenErrInvalid(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	_, secret, err := db.CreateToken(context.Background(), "laptop", entity.TokenScopeWrite)
+	r.NoError(err)
+
+	for _, value := range []string{"", secret + "x", hashTokenSecret(secret)} {
+		token, err := db.AuthenticateToken(context.Background(), value)
+		a.Nil(token)
+		a.EqualError(err, "SQLite.AuthenticateToken: invalid token")
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// CreateFolder creates a new folder with the given name, inside the folder with the given parent
+// ID. Folders without parent IDs are created at the top level.
+func (db *SQLite) CreateFolder(
+	ctx context.Context,
+	name string,
+	parentID *entity.ID,
+) (*entity.Folder, error) {
+
+	fail := failF("SQLite.CreateFolder")
+
+	if name == "" {
+		return nil, fail(entity.InvalidArgumentError{Reason: "folder name is empty"})
+	}
+
+	var rec *folderRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		if parentID != nil && *parentID != 0 {
+			if err := checkFolderExists(ctx, tx, *parentID); err != nil {
+				return err
+			}
+		}
+		irec, err := insertFolder(ctx, tx, name, toNullIDOrNil(parentID))
+		if err != nil {
+			return err
+		}
+		rec = irec
+		return nil
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return rec.folder(), nil
+}
+
+func insertFolder(
+	ctx context.Context,
+	tx *sql.Tx,
+	name string,
+	parentID *sql.NullInt64,
+) (*folderRecord, error) {
+
+	sql1 := `INSERT INTO folders(name, parent_id) VALUES (?, ?) RETURNING id`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rec := folderRecord{name: name}
+	if parentID != nil {
+		rec.parentID = *parentID
+	}
+	if err = stmt1.QueryRowContext(ctx, rec.name, rec.parentID).Scan(&rec.id); err != nil {
+		if isUniqueErr(err, "") {
+			return nil, errFolderExists(name)
+		}
+		return nil, err
+	}
+
+	return &rec, nil
+}
+
+// checkFolderExists returns a FolderNotFoundError if there is no folder with the given ID.
+func checkFolderExists(ctx context.Context, tx *sql.Tx, id ID) error {
+	var exists bool
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM folders WHERE id = ?)`,
+		id,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return entity.FolderNotFoundError{ID: id}
+	}
+	return nil
+}
+
+func errFolderExists(name string) error {
+	return entity.InvalidArgumentError{
+		Reason: fmt.Sprintf("folder %q already exists in the parent folder", name),
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFolderOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	parent, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+	a.Equal("News", parent.Name)
+	a.Nil(parent.ParentID)
+
+	child, err := db.CreateFolder(context.Background(), "Tech", &parent.ID)
+	r.NoError(err)
+	a.Equal("Tech", child.Name)
+	a.Equal(&parent.ID, child.ParentID)
+
+	// Names only need to be unique within the same parent.
+	_, err = db.CreateFolder(context.Background(), "Tech", nil)
+	r.NoError(err)
+
+	a.Equal(3, db.countTableRows("folders"))
+}
+
+func TestCreateFolderErrEmptyName(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	folder, err := db.CreateFolder(context.Background(), "", nil)
+	a.Nil(folder)
+	a.EqualError(err, "SQLite.CreateFolder: invalid argument: folder name is empty")
+}
+
+func TestCreateFolderErrParentNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	folder, err := db.CreateFolder(context.Background(), "Tech", pointer(ID(5)))
+	a.Nil(folder)
+	a.EqualError(err, "SQLite.CreateFolder: folder with ID=5 not found")
+}
+
+func TestCreateFolderErrExists(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	_, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+
+	folder, err := db.CreateFolder(context.Background(), "News", nil)
+	a.Nil(folder)
+	a.EqualError(
+		err,
+		`SQLite.CreateFolder: invalid argument: folder "News" already exists in the parent folder`,
+	)
+	a.Equal(1, db.countTableRows("folders"))
+}
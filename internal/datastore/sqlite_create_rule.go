@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// CreateRule creates a new entry rule, applied to entries inserted by later pulls. The ID and
+// creation time of the given rule are ignored.
+func (db *SQLite) CreateRule(ctx context.Context, rule *entity.Rule) (*entity.Rule, error) {
+
+	fail := failF("SQLite.CreateRule")
+
+	if _, err := entity.NewRuleMatcher(rule); err != nil {
+		return nil, fail(err)
+	}
+
+	var rec *ruleRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		if rule.FeedID != nil {
+			if err := ensureFeedExists(ctx, tx, *rule.FeedID); err != nil {
+				return err
+			}
+		}
+		irec, err := insertRule(ctx, tx, rule, time.Now())
+		if err != nil {
+			return err
+		}
+		rec = irec
+		return nil
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return rec.rule(), nil
+}
+
+func insertRule(
+	ctx context.Context,
+	tx *sql.Tx,
+	rule *entity.Rule,
+	createTime time.Time,
+) (*ruleRecord, error) {
+
+	sql1 := `
+		INSERT INTO
+			entry_rules(
+				feed_id
+				, field
+				, match_type
+				, pattern
+				, action
+				, tag
+				, create_time
+			)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		RETURNING
+			id
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rec := ruleRecord{
+		field:      rule.Field.String(),
+		matchType:  rule.MatchType.String(),
+		pattern:    rule.Pattern,
+		action:     rule.Action.String(),
+		tag:        sql.NullString{String: rule.Tag, Valid: rule.Tag != ""},
+		createTime: createTime.UTC(),
+	}
+	if rule.FeedID != nil {
+		rec.feedID = sql.NullInt64{Int64: int64(*rule.FeedID), Valid: true}
+	}
+	if err = stmt1.QueryRowContext(
+		ctx,
+		rec.feedID,
+		rec.field,
+		rec.matchType,
+		rec.pattern,
+		rec.action,
+		rec.tag,
+		rec.createTime,
+	).Scan(&rec.id); err != nil {
+		return nil, err
+	}
+
+	return &rec, nil
+}
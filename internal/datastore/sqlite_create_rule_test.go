@@ -0,0 +1,90 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestCreateRuleOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "https://a.com/feed.xml"}})
+	feedID := keys["Feed A"].ID
+
+	rule, err := db.CreateRule(
+		context.Background(),
+		&entity.Rule{
+			FeedID:    &feedID,
+			Field:     entity.RuleFieldTitle,
+			MatchType: entity.RuleMatchRegex,
+			Pattern:   `^\[Ad\]`,
+			Action:    entity.RuleActionTag,
+			Tag:       "ads",
+		},
+	)
+	r.NoError(err)
+
+	a.NotZero(rule.ID)
+	a.Equal(&feedID, rule.FeedID)
+	a.Equal(entity.RuleFieldTitle, rule.Field)
+	a.Equal(entity.RuleMatchRegex, rule.MatchType)
+	a.Equal(`^\[Ad\]`, rule.Pattern)
+	a.Equal(entity.RuleActionTag, rule.Action)
+	a.Equal("ads", rule.Tag)
+	a.False(rule.CreateTime.IsZero())
+
+	a.Equal(1, db.countTableRows("entry_rules"))
+}
+
+func TestCreateRuleErrInvalid(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	rule, err := db.CreateRule(
+		context.Background(),
+		&entity.Rule{
+			Field:     entity.RuleFieldTitle,
+			MatchType: entity.RuleMatchSubstring,
+			Pattern:   "sponsored",
+			Action:    entity.RuleActionTag,
+		},
+	)
+	a.Nil(rule)
+	a.EqualError(err, "SQLite.CreateRule: invalid argument: tag rules require a tag")
+	a.Equal(0, db.countTableRows("entry_rules"))
+}
+
+func TestCreateRuleErrFeedNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	rule, err := db.CreateRule(
+		context.Background(),
+		&entity.Rule{
+			FeedID:    pointer(ID(3)),
+			Field:     entity.RuleFieldTitle,
+			MatchType: entity.RuleMatchSubstring,
+			Pattern:   "sponsored",
+			Action:    entity.RuleActionDrop,
+		},
+	)
+	a.Nil(rule)
+	a.EqualError(err, "SQLite.CreateRule: feed with ID=3 not found")
+	a.Equal(0, db.countTableRows("entry_rules"))
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// tokenSecretPrefix is prepended to all token secrets, to make them recognizable.
+const tokenSecretPrefix = "neon_"
+
+// CreateToken creates a new API token with the given name and scope. The returned secret is
+// what clients authenticate with. Only its hash is stored, so it can not be retrieved later.
+func (db *SQLite) CreateToken(
+	ctx context.Context,
+	name string,
+	scope entity.TokenScope,
+) (*entity.Token, string, error) {
+
+	fail := failF("SQLite.CreateToken")
+
+	if name == "" {
+		return nil, "", fail(entity.InvalidArgumentError{Reason: "token name is empty"})
+	}
+	if scope < entity.TokenScopeRead || scope > entity.TokenScopeAdmin {
+		return nil, "", fail(entity.InvalidArgumentError{Reason: "token scope is unknown"})
+	}
+
+	secret, err := newTokenSecret()
+	if err != nil {
+		return nil, "", fail(err)
+	}
+
+	var rec *tokenRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		exists, err := tokenExists(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return entity.InvalidArgumentError{
+				Reason: fmt.Sprintf("token %q already exists", name),
+			}
+		}
+		irec, err := insertToken(ctx, tx, name, hashTokenSecret(secret), scope, time.Now())
+		if err != nil {
+			return err
+		}
+		rec = irec
+		return nil
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err = db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, "", fail(err)
+	}
+
+	return rec.token(), secret, nil
+}
+
+func newTokenSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return tokenSecretPrefix + base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func hashTokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func tokenExists(ctx context.Context, tx *sql.Tx, name string) (bool, error) {
+	var exists bool
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM api_tokens WHERE name = ?)`,
+		name,
+	).Scan(&exists)
+	return exists, err
+}
+
+func insertToken(
+	ctx context.Context,
+	tx *sql.Tx,
+	name string,
+	secretHash string,
+	scope entity.TokenScope,
+	createTime time.Time,
+) (*tokenRecord, error) {
+
+	sql1 := `
+		INSERT INTO
+			api_tokens(
+				name
+				, secret_hash
+				, scope
+				, create_time
+			)
+			VALUES (?, ?, ?, ?)
+		RETURNING
+			id
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rec := tokenRecord{name: name, scope: scope.String(), createTime: createTime.UTC()}
+	if err = stmt1.QueryRowContext(
+		ctx,
+		rec.name,
+		secretHash,
+		rec.scope,
+		rec.createTime,
+	).Scan(&rec.id); err != nil {
+		return nil, err
+	}
+
+	return &rec, nil
+}
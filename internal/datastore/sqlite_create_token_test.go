@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestCreateTokenOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	a.Equal(0, db.countTableRows("api_tokens"))
+
+	token, secret, err := db.CreateToken(context.Background(), "laptop", entity.TokenScopeWrite)
+	r.NoError(err)
+
+	a.Equal("laptop", token.Name)
+	a.Equal(entity.TokenScopeWrite, token.Scope)
+	a.False(token.CreateTime.IsZero())
+	a.True(strings.HasPrefix(secret, tokenSecretPrefix))
+
+	a.Equal(1, db.countTableRows("api_tokens"))
+	a.False(db.rowExists(`SELECT * FROM api_tokens WHERE secret_hash = ?`, secret))
+	a.True(db.rowExists(`SELECT * FROM api_tokens WHERE secret_hash = ?`, hashTokenSecret(secret)))
+
+	_, secret2, err := db.CreateToken(context.Background(), "phone", entity.TokenScopeRead)
+	r.NoError(err)
+	a.NotEqual(secret, secret2)
+}
+
+func TestCreateTokenErrExists(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	_, _, err := db.CreateToken(context.Background(), "laptop", entity.TokenScopeWrite)
+	r.NoError(err)
+
+	token, secret, err := db.CreateToken(context.Background(), "laptop", entity.TokenScopeRead)
+	a.Nil(token)
+	a.Empty(secret)
+	a.EqualError(err, `SQLite.CreateToken: invalid argument: token "laptop" already exists`)
+	a.Equal(1, db.countTableRows("api_tokens"))
+}
+
+func TestCreateTokenErrInvalid(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	_, _, err := db.CreateToken(context.Background(), "", entity.TokenScopeRead)
+	a.EqualError(err, "SQLite.CreateToken: invalid argument: token name is empty")
+
+	_, _, err = db.CreateToken(context.Background(), "laptop", entity.TokenScope(0))
+	a.EqualError(err, "SQLite.CreateToken: invalid argument: token scope is unknown")
+
+	a.Equal(0, db.countTableRows("api_tokens"))
+}
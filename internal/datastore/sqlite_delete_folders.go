@@ -0,0 +1,55 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+// DeleteFolders removes folders together with their subfolders. Feeds in removed folders are not
+// removed, but moved out of any folder.
+func (db *SQLite) DeleteFolders(ctx context.Context, ids []entity.ID) error {
+
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+
+		sql1 := `DELETE FROM folders WHERE id = ?`
+		stmt1, err := tx.PrepareContext(ctx, sql1)
+		if err != nil {
+			return err
+		}
+		defer stmt1.Close()
+
+		// Existence is checked first, since removing a folder also removes its subfolders.
+		ids = sliceutil.Dedup(ids)
+		for _, id := range ids {
+			if err := checkFolderExists(ctx, tx, id); err != nil {
+				return err
+			}
+		}
+
+		for _, id := range ids {
+			if _, err := stmt1.ExecContext(ctx, id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	fail := failF("SQLite.DeleteFolders")
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return fail(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestDeleteFoldersOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	news, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+	tech, err := db.CreateFolder(context.Background(), "Tech", &news.ID)
+	r.NoError(err)
+	_, err = db.CreateFolder(context.Background(), "Blogs", nil)
+	r.NoError(err)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}})
+	ops := []*entity.FeedEditOp{{ID: keys["Feed A"].ID, FolderID: &tech.ID}}
+	_, err = db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+
+	err = db.DeleteFolders(context.Background(), []entity.ID{news.ID, tech.ID})
+	r.NoError(err)
+
+	a.Equal(1, db.countTableRows("folders"))
+	a.Equal(1, db.countFeeds())
+	a.True(db.rowExists(`SELECT * FROM feeds WHERE folder_id IS NULL`))
+}
+
+func TestDeleteFoldersErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	news, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+
+	err = db.DeleteFolders(context.Background(), []entity.ID{news.ID, 4})
+	a.EqualError(err, "SQLite.DeleteFolders: folder with ID=4 not found")
+	a.Equal(1, db.countTableRows("folders"))
+}
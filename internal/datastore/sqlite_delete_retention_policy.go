@@ -0,0 +1,51 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// DeleteRetentionPolicy removes the retention policy of the given feed, or the global policy if
+// the feed ID is nil. Feeds without policies fall back to the global policy.
+func (db *SQLite) DeleteRetentionPolicy(ctx context.Context, feedID *entity.ID) error {
+
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		n, err := deleteRetentionPolicy(ctx, tx, feedID)
+		if err != nil {
+			return err
+		}
+		if n == 0 && feedID != nil {
+			return ensureFeedExists(ctx, tx, *feedID)
+		}
+		return nil
+	}
+
+	fail := failF("SQLite.DeleteRetentionPolicy")
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return fail(err)
+	}
+
+	return nil
+}
+
+func deleteRetentionPolicy(ctx context.Context, tx *sql.Tx, feedID *ID) (int64, error) {
+	res, err := tx.ExecContext(
+		ctx,
+		`DELETE FROM retention_policies WHERE feed_id IS ?`,
+		feedID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestDeleteRetentionPolicyOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "https://a.com/feed.xml"}})
+	feedID := keys["Feed A"].ID
+
+	global := entity.RetentionPolicy{MaxEntries: pointer(uint32(5))}
+	r.NoError(db.SetRetentionPolicy(context.Background(), &global))
+	r.NoError(db.SetRetentionPolicy(
+		context.Background(),
+		&entity.RetentionPolicy{FeedID: &feedID, MaxEntries: pointer(uint32(1))},
+	))
+
+	r.NoError(db.DeleteRetentionPolicy(context.Background(), &feedID))
+	got, err := db.ListRetentionPolicies(context.Background())
+	r.NoError(err)
+	a.Equal([]*entity.RetentionPolicy{&global}, got)
+
+	r.NoError(db.DeleteRetentionPolicy(context.Background(), nil))
+	got, err = db.ListRetentionPolicies(context.Background())
+	r.NoError(err)
+	a.Empty(got)
+
+	// Deleting policies that do not exist is not an error.
+	r.NoError(db.DeleteRetentionPolicy(context.Background(), nil))
+	r.NoError(db.DeleteRetentionPolicy(context.Background(), &feedID))
+}
+
+func TestDeleteRetentionPolicyErrFeedNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	err := db.DeleteRetentionPolicy(context.Background(), pointer(ID(3)))
+	a.EqualError(err, "SQLite.DeleteRetentionPolicy: feed with ID=3 not found")
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+// DeleteRules removes entry rules. Entries already acted upon by the rules are not changed.
+func (db *SQLite) DeleteRules(ctx context.Context, ids []entity.ID) error {
+
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+
+		sql1 := `DELETE FROM entry_rules WHERE id = ?`
+		stmt1, err := tx.PrepareContext(ctx, sql1)
+		if err != nil {
+			return err
+		}
+		defer stmt1.Close()
+
+		for _, id := range sliceutil.Dedup(ids) {
+			res, err := stmt1.ExecContext(ctx, id)
+			if err != nil {
+				return err
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if n != int64(1) {
+				return entity.RuleNotFoundError{ID: id}
+			}
+		}
+
+		return nil
+	}
+
+	fail := failF("SQLite.DeleteRules")
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return fail(err)
+	}
+
+	return nil
+}
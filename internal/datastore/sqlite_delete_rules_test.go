@@ -0,0 +1,61 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func (db *testSQLiteDB) addRule(rule *entity.Rule) *entity.Rule {
+	db.t.Helper()
+
+	created, err := db.CreateRule(context.Background(), rule)
+	require.NoError(db.t, err)
+
+	return created
+}
+
+func TestDeleteRulesOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	rule := db.addRule(&entity.Rule{
+		Field:     entity.RuleFieldURL,
+		MatchType: entity.RuleMatchSubstring,
+		Pattern:   "/ads/",
+		Action:    entity.RuleActionDrop,
+	})
+	a.Equal(1, db.countTableRows("entry_rules"))
+
+	err := db.DeleteRules(context.Background(), []ID{rule.ID})
+	r.NoError(err)
+	a.Equal(0, db.countTableRows("entry_rules"))
+}
+
+func TestDeleteRulesErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	rule := db.addRule(&entity.Rule{
+		Field:     entity.RuleFieldURL,
+		MatchType: entity.RuleMatchSubstring,
+		Pattern:   "/ads/",
+		Action:    entity.RuleActionDrop,
+	})
+
+	err := db.DeleteRules(context.Background(), []ID{rule.ID, rule.ID + 1})
+	a.EqualError(err, "SQLite.DeleteRules: rule with ID=2 not found")
+	a.Equal(1, db.countTableRows("entry_rules"))
+}
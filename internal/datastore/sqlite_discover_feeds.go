@@ -0,0 +1,41 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// DiscoverFeeds finds the feeds published by the website at the given URL. If the URL points to
+// a feed, it is returned as the only candidate.
+func (db *SQLite) DiscoverFeeds(
+	ctx context.Context,
+	pageURL string,
+	timeout *time.Duration,
+) ([]*entity.FeedCandidate, error) {
+
+	fail := failF("SQLite.DiscoverFeeds")
+
+	discoverer, ok := db.parser.(Discoverer)
+	if !ok {
+		return nil, fail(fmt.Errorf("feed discovery is not supported by the parser"))
+	}
+
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	candidates, err := discoverer.DiscoverFeedsWithContext(pageURL, ctx)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// newTestSite creates a test website serving the given pages, keyed by path.
+func newTestSite(t *testing.T, pages map[string]string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		page, ok := pages[req.URL.Path]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = w.Write([]byte(page))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestDiscoverFeedsOkFeedURL(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{"/feed.xml": testRSS})
+
+	candidates, err := db.DiscoverFeeds(context.Background(), srv.URL+"/feed.xml", nil)
+	r.NoError(err)
+
+	a.Equal([]*entity.FeedCandidate{{URL: srv.URL + "/feed.xml", Title: "Feed A"}}, candidates)
+}
+
+func TestDiscoverFeedsOkLinkTags(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{
+		"/blog/": `<!DOCTYPE html>
+<html>
+  <head>
+    <link rel="stylesheet" href="/style.css">
+    <link rel="alternate" type="application/rss+xml" title="Posts" href="posts.xml">
+    <link rel="Alternate" type="application/atom+xml; charset=utf-8" href="/atom.xml">
+    <link rel="alternate" type="application/rss+xml" href="posts.xml">
+    <link rel="alternate" type="text/html" hreflang="de" href="/de/blog/">
+    <link rel="alternate" type="application/feed+json" href="https://c.com/feed.json">
+    <link rel="alternate" type="application/json" href="/wp-json/wp/v2/pages/2">
+  </head>
+  <body></body>
+</html>`,
+	})
+
+	candidates, err := db.DiscoverFeeds(context.Background(), srv.URL+"/blog/", nil)
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.FeedCandidate{
+			{URL: srv.URL + "/blog/posts.xml", Title: "Posts"},
+			{URL: srv.URL + "/atom.xml"},
+			{URL: "https://c.com/feed.json"},
+		},
+		candidates,
+	)
+}
+
+func TestDiscoverFeedsOkBaseTag(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{
+		"/": `<html><head>
+<base href="/static/">
+<link rel="alternate" type="application/rss+xml" href="rss.xml" />
+</head></html>`,
+	})
+
+	candidates, err := db.DiscoverFeeds(context.Background(), srv.URL, nil)
+	r.NoError(err)
+
+	a.Equal([]*entity.FeedCandidate{{URL: srv.URL + "/static/rss.xml"}}, candidates)
+}
+
+func TestDiscoverFeedsOkCommonPath(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{
+		"/":          `<html><head><title>No feeds here</title></head></html>`,
+		"/feed":      `<html><body>Not a feed either</body></html>`,
+		"/atom.xml":  testRSS,
+		"/index.xml": testRSS,
+	})
+
+	candidates, err := db.DiscoverFeeds(context.Background(), srv.URL+"/about", nil)
+	r.Error(err)
+	a.Nil(candidates)
+
+	candidates, err = db.DiscoverFeeds(context.Background(), srv.URL, nil)
+	r.NoError(err)
+	a.Equal([]*entity.FeedCandidate{{URL: srv.URL + "/atom.xml", Title: "Feed A"}}, candidates)
+}
+
+func TestDiscoverFeedsOkNone(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{"/": `<html><head></head></html>`})
+
+	candidates, err := db.DiscoverFeeds(context.Background(), srv.URL, nil)
+	r.NoError(err)
+	a.Empty(candidates)
+}
+
+func TestDiscoverFeedsErrNotSupported(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	candidates, err := db.DiscoverFeeds(context.Background(), "https://a.com", nil)
+	a.Nil(candidates)
+	a.EqualError(err, "SQLite.DiscoverFeeds: feed discovery is not supported by the parser")
+}
@@ -23,7 +23,8 @@ func (db *SQLite) EditFeeds(
 		if err := setFeedTitle(ctx, tx, op.ID, op.Title); err != nil {
 			return nil, err
 		}
-		if err := setFeedDescription(ctx, tx, op.ID, op.Description); err != nil {
+		desc := toNullStringOrNil(op.Description)
+		if err := setFeedDescription(ctx, tx, op.ID, desc); err != nil {
 			return nil, err
 		}
 		if err := setFeedTags(ctx, tx, op.ID, op.Tags); err != nil {
@@ -32,6 +33,17 @@ func (db *SQLite) EditFeeds(
 		if err := setFeedIsStarred(ctx, tx, op.ID, op.IsStarred); err != nil {
 			return nil, err
 		}
+		if op.FolderID != nil && *op.FolderID != 0 {
+			if err := checkFolderExists(ctx, tx, *op.FolderID); err != nil {
+				return nil, err
+			}
+		}
+		if err := setFeedFolderID(ctx, tx, op.ID, toNullIDOrNil(op.FolderID)); err != nil {
+			return nil, err
+		}
+		if err := setFeedFetchContent(ctx, tx, op.ID, op.FetchContent); err != nil {
+			return nil, err
+		}
 		return getFeed(ctx, tx, op.ID)
 	}
 
@@ -74,6 +86,11 @@ func getFeed(ctx context.Context, tx *sql.Tx, feedID ID) (*feedRecord, error) {
 			, f.update_time AS update_time
 			, f.last_pull_time AS last_pull_time
 			, json_group_array(fc.name) FILTER (WHERE fc.name IS NOT NULL) AS tags
+			, f.folder_id AS folder_id
+			, f.fetch_content AS fetch_content
+			, f.pull_failures AS pull_failures
+			, f.last_pull_error AS last_pull_error
+			, f.next_pull_time AS next_pull_time
 		FROM
 			feeds f
 			LEFT JOIN feeds_x_feed_tags fxfc ON fxfc.feed_id = f.id
@@ -98,6 +115,11 @@ func getFeed(ctx context.Context, tx *sql.Tx, feedID ID) (*feedRecord, error) {
 			&feed.updated,
 			&feed.lastPulled,
 			&feed.tags,
+			&feed.folderID,
+			&feed.fetchContent,
+			&feed.pullFailures,
+			&feed.pullError,
+			&feed.nextPull,
 		); err != nil {
 			return nil, err
 		}
@@ -117,10 +139,12 @@ func getFeed(ctx context.Context, tx *sql.Tx, feedID ID) (*feedRecord, error) {
 }
 
 var (
-	setFeedTitle       = tableFieldSetter[string](feedsTable, "title")
-	setFeedDescription = tableFieldSetter[string](feedsTable, "description")
-	setFeedIsStarred   = tableFieldSetter[bool](feedsTable, "is_starred")
-	setFeedSiteURL     = tableFieldSetter[string](feedsTable, "site_url")
+	setFeedTitle        = tableFieldSetter[string](feedsTable, "title")
+	setFeedDescription  = tableFieldSetter[sql.NullString](feedsTable, "description")
+	setFeedIsStarred    = tableFieldSetter[bool](feedsTable, "is_starred")
+	setFeedSiteURL      = tableFieldSetter[string](feedsTable, "site_url")
+	setFeedFolderID     = tableFieldSetter[sql.NullInt64](feedsTable, "folder_id")
+	setFeedFetchContent = tableFieldSetter[bool](feedsTable, "fetch_content")
 )
 
 func setFeedTags(
@@ -141,7 +165,7 @@ func setFeedTags(
 	}
 	defer stmt1.Close()
 
-	if _, err = stmt1.ExecContext(ctx); err != nil {
+	if _, err = stmt1.ExecContext(ctx, feedID); err != nil {
 		return err
 	}
 
@@ -150,7 +174,7 @@ func setFeedTags(
 	}
 
 	sql2 := `
-		DELETE
+		DELETE FROM
 			feed_tags
 		WHERE
 			id IN (
@@ -169,5 +193,7 @@ func setFeedTags(
 	}
 	defer stmt2.Close()
 
-	return nil
+	_, err = stmt2.ExecContext(ctx)
+
+	return err
 }
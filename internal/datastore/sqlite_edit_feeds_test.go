@@ -67,3 +67,147 @@ func TestEditFeedsOkExtended(t *testing.T) {
 	a.False(existf("Feed A", false))
 	a.True(existf("Feed X", true))
 }
+
+func TestEditFeedsOkTags(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			tags:    []string{"news", "tech"},
+		},
+		{
+			title:   "Feed B",
+			feedURL: "http://b.com/feed.xml",
+			tags:    []string{"tech"},
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+
+	r.Equal(2, db.countFeedTags())
+
+	ops := []*entity.FeedEditOp{
+		{ID: keys["Feed A"].ID, Tags: pointer([]string{"science"})},
+	}
+	feeds, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+
+	r.Len(feeds, 1)
+	a.Equal([]string{"science"}, feeds[0].Tags)
+	// "news" is no longer used by any feed, while "tech" is still used by Feed B.
+	a.Equal(2, db.countFeedTags())
+	a.False(db.rowExists(`SELECT * FROM feed_tags WHERE name = ?`, "news"))
+
+	ops = []*entity.FeedEditOp{
+		{ID: keys["Feed B"].ID, Tags: pointer([]string{})},
+	}
+	feeds, err = db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+
+	r.Len(feeds, 1)
+	a.Empty(feeds[0].Tags)
+	a.Equal(1, db.countFeedTags())
+}
+
+func TestEditFeedsOkClearDescription(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	dbFeeds := []*feedRecord{
+		{
+			title:       "Feed A",
+			feedURL:     "http://a.com/feed.xml",
+			description: toNullString("Feed A description"),
+		},
+	}
+	keys := db.addFeeds(dbFeeds)
+
+	ops := []*entity.FeedEditOp{{ID: keys["Feed A"].ID, Description: pointer("")}}
+	feeds, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+
+	r.Len(feeds, 1)
+	a.Nil(feeds[0].Description)
+	a.True(db.rowExists(`SELECT * FROM feeds WHERE title = ? AND description IS NULL`, "Feed A"))
+}
+
+func TestEditFeedsOkFolder(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}})
+	folder, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+
+	ops := []*entity.FeedEditOp{{ID: keys["Feed A"].ID, FolderID: &folder.ID}}
+	feeds, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	a.Equal(&folder.ID, feeds[0].FolderID)
+
+	ops = []*entity.FeedEditOp{{ID: keys["Feed A"].ID, FolderID: pointer(ID(0))}}
+	feeds, err = db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	a.Nil(feeds[0].FolderID)
+}
+
+func TestEditFeedsOkFetchContent(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}})
+
+	ops := []*entity.FeedEditOp{{ID: keys["Feed A"].ID, FetchContent: pointer(true)}}
+	feeds, err := db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	a.True(feeds[0].FetchContent)
+
+	// Unrelated edits keep the setting.
+	ops = []*entity.FeedEditOp{{ID: keys["Feed A"].ID, Title: pointer("Feed X")}}
+	feeds, err = db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+	r.Len(feeds, 1)
+	a.True(feeds[0].FetchContent)
+}
+
+func TestEditFeedsErrFolderNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}})
+
+	ops := []*entity.FeedEditOp{{ID: keys["Feed A"].ID, FolderID: pointer(ID(3))}}
+	feeds, err := db.EditFeeds(context.Background(), ops)
+	a.Nil(feeds)
+	a.EqualError(err, "SQLite.EditFeed: folder with ID=3 not found")
+}
+
+func TestEditFeedsErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	ops := []*entity.FeedEditOp{{ID: 8, Title: pointer("Feed X")}}
+	feeds, err := db.EditFeeds(context.Background(), ops)
+	a.Nil(feeds)
+	a.EqualError(err, "SQLite.EditFeed: feed with ID=8 not found")
+}
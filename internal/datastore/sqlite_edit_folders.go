@@ -0,0 +1,132 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// EditFolders renames folders and moves them into other folders.
+func (db *SQLite) EditFolders(
+	ctx context.Context,
+	ops []*entity.FolderEditOp,
+) ([]*entity.Folder, error) {
+
+	updateFunc := func(
+		ctx context.Context,
+		tx *sql.Tx,
+		op *entity.FolderEditOp,
+	) (*folderRecord, error) {
+		if op.Name != nil {
+			if *op.Name == "" {
+				return nil, entity.InvalidArgumentError{Reason: "folder name is empty"}
+			}
+			if err := setFolderName(ctx, tx, op.ID, op.Name); err != nil {
+				if isUniqueErr(err, "") {
+					return nil, errFolderExists(*op.Name)
+				}
+				return nil, err
+			}
+		}
+		if op.ParentID != nil && *op.ParentID != 0 {
+			if err := checkFolderExists(ctx, tx, *op.ParentID); err != nil {
+				return nil, err
+			}
+			isCycle, err := isFolderAncestor(ctx, tx, op.ID, *op.ParentID)
+			if err != nil {
+				return nil, err
+			}
+			if isCycle {
+				return nil, entity.InvalidArgumentError{
+					Reason: "folder can not be moved into itself or its subfolders",
+				}
+			}
+		}
+		parentID := toNullIDOrNil(op.ParentID)
+		if err := setFolderParentID(ctx, tx, op.ID, parentID); err != nil {
+			if isUniqueErr(err, "") {
+				return nil, entity.InvalidArgumentError{
+					Reason: "a folder with the same name already exists in the parent folder",
+				}
+			}
+			return nil, err
+		}
+		return getFolder(ctx, tx, op.ID)
+	}
+
+	var recs = make([]*folderRecord, len(ops))
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		for i, op := range ops {
+			rec, err := updateFunc(ctx, tx, op)
+			if err != nil {
+				return err
+			}
+			recs[i] = rec
+		}
+		return nil
+	}
+
+	fail := failF("SQLite.EditFolders")
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return folderRecords(recs).folders(), nil
+}
+
+var (
+	setFolderName     = tableFieldSetter[string](foldersTable, "name")
+	setFolderParentID = tableFieldSetter[sql.NullInt64](foldersTable, "parent_id")
+)
+
+func getFolder(ctx context.Context, tx *sql.Tx, id ID) (*folderRecord, error) {
+
+	sql1 := `SELECT id, name, parent_id FROM folders WHERE id = ?`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	var rec folderRecord
+	err = stmt1.QueryRowContext(ctx, id).Scan(&rec.id, &rec.name, &rec.parentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.FolderNotFoundError{ID: id}
+		}
+		return nil, err
+	}
+
+	return &rec, nil
+}
+
+// isFolderAncestor returns true if the folder with the given ancestor ID is the folder with the
+// given ID or contains it, directly or through its subfolders.
+func isFolderAncestor(ctx context.Context, tx *sql.Tx, ancestorID, id ID) (bool, error) {
+
+	sql1 := `
+		WITH RECURSIVE
+			ancestors(id, parent_id) AS (
+				SELECT id, parent_id FROM folders WHERE id = $1
+				UNION ALL
+				SELECT fd.id, fd.parent_id FROM folders fd JOIN ancestors a ON fd.id = a.parent_id
+			)
+		SELECT EXISTS (SELECT 1 FROM ancestors WHERE id = $2)
+`
+	var isAncestor bool
+	if err := tx.QueryRowContext(ctx, sql1, id, ancestorID).Scan(&isAncestor); err != nil {
+		return false, err
+	}
+
+	return isAncestor, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestEditFoldersOkEmpty(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	folders, err := db.EditFolders(context.Background(), nil)
+	r.NoError(err)
+
+	a.Empty(folders)
+}
+
+func TestEditFoldersOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	news, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+	tech, err := db.CreateFolder(context.Background(), "Tech", nil)
+	r.NoError(err)
+	blogs, err := db.CreateFolder(context.Background(), "Blogs", &news.ID)
+	r.NoError(err)
+
+	ops := []*entity.FolderEditOp{
+		{ID: tech.ID, ParentID: &news.ID},
+		{ID: blogs.ID, Name: pointer("Personal blogs"), ParentID: pointer(ID(0))},
+	}
+	folders, err := db.EditFolders(context.Background(), ops)
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.Folder{
+			{ID: tech.ID, Name: "Tech", ParentID: &news.ID},
+			{ID: blogs.ID, Name: "Personal blogs"},
+		},
+		folders,
+	)
+}
+
+func TestEditFoldersErrCycle(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	news, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+	tech, err := db.CreateFolder(context.Background(), "Tech", &news.ID)
+	r.NoError(err)
+
+	for _, parentID := range []ID{news.ID, tech.ID} {
+		ops := []*entity.FolderEditOp{{ID: news.ID, ParentID: pointer(parentID)}}
+		folders, err := db.EditFolders(context.Background(), ops)
+		a.Nil(folders)
+		a.EqualError(
+			err,
+			"SQLite.EditFolders: invalid argument: folder can not be moved into itself or"+
+				" its subfolders",
+		)
+	}
+}
+
+func TestEditFoldersErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	news, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+
+	ops := []*entity.FolderEditOp{
+		{ID: news.ID, Name: pointer("Headlines")},
+		{ID: 9, Name: pointer("Tech")},
+	}
+	folders, err := db.EditFolders(context.Background(), ops)
+	a.Nil(folders)
+	a.EqualError(err, "SQLite.EditFolders: folder with ID=9 not found")
+
+	// Edits are all-or-nothing.
+	a.True(db.rowExists(`SELECT * FROM folders WHERE name = ?`, "News"))
+}
@@ -21,9 +21,14 @@ func (db *SQLite) ExportSubscription(
 		if err != nil {
 			return err
 		}
+		frecs, err := getFolders(ctx, tx)
+		if err != nil {
+			return err
+		}
 		isub := entity.Subscription{
-			Title: title,
-			Feeds: feedRecords(recs).feeds(),
+			Title:   title,
+			Feeds:   feedRecords(recs).feeds(),
+			Folders: folderRecords(frecs).folders(),
 		}
 		sub = isub
 		return nil
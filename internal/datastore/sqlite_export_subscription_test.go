@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
 )
 
 func TestExportSubscriptionOkEmpty(t *testing.T) {
@@ -89,3 +91,27 @@ func TestExportSubscriptionOkExtended(t *testing.T) {
 	a.Equal(sub.Feeds[2].IsStarred, dbFeeds[0].isStarred)
 	a.ElementsMatch(sub.Feeds[2].Tags, dbFeeds[0].tags)
 }
+
+func TestExportSubscriptionOkFolders(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "http://a.com/feed.xml"}})
+	news, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+	tech, err := db.CreateFolder(context.Background(), "Tech", &news.ID)
+	r.NoError(err)
+	ops := []*entity.FeedEditOp{{ID: keys["Feed A"].ID, FolderID: &tech.ID}}
+	_, err = db.EditFeeds(context.Background(), ops)
+	r.NoError(err)
+
+	sub, err := db.ExportSubscription(context.Background(), nil)
+	r.NoError(err)
+
+	a.Equal([]*entity.Folder{news, tech}, sub.Folders)
+	r.Len(sub.Feeds, 1)
+	a.Equal(&tech.ID, sub.Feeds[0].FolderID)
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// FetchEntryContent replaces the content of the entry with the given ID with the article
+// extracted from the entry URL.
+func (db *SQLite) FetchEntryContent(
+	ctx context.Context,
+	id entity.ID,
+	timeout *time.Duration,
+) (*entity.Entry, error) {
+
+	fail := failF("SQLite.FetchEntryContent")
+
+	extractor, ok := db.parser.(ContentExtractor)
+	if !ok {
+		return nil, fail(fmt.Errorf("content extraction is not supported by the parser"))
+	}
+
+	var entryURL string
+	getFunc := func(ctx context.Context, tx *sql.Tx) error {
+		rec, err := getEntry(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.EntryNotFoundError{ID: id}
+			}
+			return err
+		}
+		if !rec.url.Valid || rec.url.String == "" {
+			return entity.InvalidArgumentError{Reason: fmt.Sprintf("entry %d has no URL", id)}
+		}
+		entryURL = rec.url.String
+		return nil
+	}
+
+	db.mu.RLock()
+	err := db.withTx(ctx, getFunc)
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	// The page is fetched outside of any transaction, so that other writes are not blocked.
+	fctx := ctx
+	if timeout != nil {
+		var cancel context.CancelFunc
+		fctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+	content, err := extractor.ExtractContentWithContext(entryURL, fctx)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	var rec *entryRecord
+	setFunc := func(ctx context.Context, tx *sql.Tx) error {
+		if err := storeEntryContent(ctx, tx, id, content); err != nil {
+			return err
+		}
+		irec, err := getEntry(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		rec = irec
+		return nil
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if err = db.withTx(ctx, setFunc); err != nil {
+		return nil, fail(err)
+	}
+
+	return rec.entry(), nil
+}
+
+// fetchPulledContent replaces the content of the entries changed by the given pull result with
+// the articles extracted from their URLs. Each article is fetched with the given timeout, outside
+// of the pull transaction. Entries whose article can not be extracted keep their content, without
+// failing the pull.
+func (db *SQLite) fetchPulledContent(
+	ctx context.Context,
+	extractor ContentExtractor,
+	pr *entity.PullResult,
+	timeout *time.Duration,
+) {
+	for _, entry := range pr.ChangedEntries() {
+		if entry.URL == nil || *entry.URL == "" {
+			continue
+		}
+
+		fctx, cancel := ctx, context.CancelFunc(func() {})
+		if timeout != nil {
+			fctx, cancel = context.WithTimeout(ctx, *timeout)
+		}
+		content, err := extractor.ExtractContentWithContext(*entry.URL, fctx)
+		cancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			pkgLogger.Warn().
+				Err(err).
+				Uint32("entry_id", entry.ID).
+				Str("url", *entry.URL).
+				Msg("failed to fetch entry content")
+			continue
+		}
+
+		storeFunc := func(ctx context.Context, tx *sql.Tx) error {
+			return storeEntryContent(ctx, tx, entry.ID, content)
+		}
+		db.mu.Lock()
+		err = db.withTx(ctx, storeFunc)
+		db.mu.Unlock()
+		if err != nil {
+			pkgLogger.Warn().
+				Err(err).
+				Uint32("entry_id", entry.ID).
+				Msg("failed to store entry content")
+			continue
+		}
+
+		entry.Content = &content
+		if feed := pr.Feed(); feed != nil {
+			if fentry, exists := feed.Entries[entry.ID]; exists {
+				fentry.Content = &content
+			}
+		}
+	}
+}
+
+// storeEntryContent sets the content of the given entry and updates its search index.
+func storeEntryContent(ctx context.Context, tx *sql.Tx, entryID ID, content string) error {
+
+	sql1 := `UPDATE entries SET content = $2 WHERE id = $1 RETURNING title, description`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return err
+	}
+	defer stmt1.Close()
+
+	var (
+		title string
+		desc  sql.NullString
+	)
+	if err = stmt1.QueryRowContext(ctx, entryID, content).Scan(&title, &desc); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.EntryNotFoundError{ID: entryID}
+		}
+		return err
+	}
+
+	idx, err := newEntryIndexer(ctx, tx)
+	if err != nil {
+		return err
+	}
+	defer idx.close()
+
+	return idx.index(ctx, entryID, title, fromNullString(desc), &content)
+}
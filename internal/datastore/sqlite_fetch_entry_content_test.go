@@ -0,0 +1,158 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+const testArticlePage = `<!DOCTYPE html>
+<html>
+  <head>
+    <title>The Post</title>
+    <script>var tracker = true;</script>
+  </head>
+  <body>
+    <nav><a href="/">Home</a> <a href="/about">About</a></nav>
+    <div class="sidebar">
+      <p>Subscribe to our newsletter for more posts like this one, every week.</p>
+    </div>
+    <article class="post" style="color: red">
+      <h1>The Post</h1>
+      <p>First paragraph of the article, which is long enough to count, with a
+        <a href="/about" class="inline">link</a>.</p>
+      <p>Second paragraph, also long enough to be scored as part of the article body.</p>
+      <p><img src="images/cat.png" alt="A cat"></p>
+    </article>
+    <div id="comments">
+      <p>Great post, thanks for sharing this with everyone here!</p>
+    </div>
+  </body>
+</html>`
+
+func TestFetchEntryContentOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{"/posts/1": testArticlePage})
+
+	keys := db.addFeeds([]*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: srv.URL + "/feed.xml",
+			entries: []*entryRecord{
+				{title: "Entry A1", url: toNullString(srv.URL + "/posts/1")},
+			},
+		},
+	})
+	entryID := keys["Feed A"].Entries["Entry A1"]
+
+	entry, err := db.FetchEntryContent(context.Background(), entryID, pointer(5*time.Second))
+	r.NoError(err)
+	r.NotNil(entry.Content)
+
+	content := *entry.Content
+	a.Contains(content, "<h1>The Post</h1>")
+	a.Contains(content, "First paragraph of the article")
+	a.Contains(content, "Second paragraph")
+	a.Contains(content, `<a href="`+srv.URL+`/about">link</a>`)
+	a.Contains(content, `<img src="`+srv.URL+`/posts/images/cat.png" alt="A cat"/>`)
+	a.NotContains(content, "tracker")
+	a.NotContains(content, "Subscribe")
+	a.NotContains(content, "Great post")
+	a.NotContains(content, "Home")
+	a.NotContains(content, "class=")
+	a.NotContains(content, "style=")
+
+	results, err := db.SearchEntries(context.Background(), "scored", nil, nil)
+	r.NoError(err)
+	r.Len(results, 1)
+	a.Equal(entryID, results[0].Entry.ID)
+}
+
+func TestFetchEntryContentErrNoURL(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	keys := db.addFeeds([]*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "http://a.com/feed.xml",
+			entries: []*entryRecord{{title: "Entry A1", url: sql.NullString{}}},
+		},
+	})
+
+	entry, err := db.FetchEntryContent(
+		context.Background(),
+		keys["Feed A"].Entries["Entry A1"],
+		nil,
+	)
+	r.Nil(entry)
+	r.ErrorAs(err, &entity.InvalidArgumentError{})
+}
+
+func TestFetchEntryContentErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	entry, err := db.FetchEntryContent(context.Background(), 58, nil)
+	r.Nil(entry)
+	r.EqualError(err, "SQLite.FetchEntryContent: entry with ID=58 not found")
+}
+
+func TestFetchEntryContentErrNoArticle(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := newTestSite(t, map[string]string{"/posts/1": `<html><body><p>Hi</p></body></html>`})
+
+	keys := db.addFeeds([]*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: srv.URL + "/feed.xml",
+			entries: []*entryRecord{
+				{title: "Entry A1", url: toNullString(srv.URL + "/posts/1")},
+			},
+		},
+	})
+
+	entry, err := db.FetchEntryContent(
+		context.Background(),
+		keys["Feed A"].Entries["Entry A1"],
+		nil,
+	)
+	r.Nil(entry)
+	r.ErrorIs(err, errNoArticle)
+}
+
+func TestFetchEntryContentErrNotSupported(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	entry, err := db.FetchEntryContent(context.Background(), 1, nil)
+	r.Nil(entry)
+	r.EqualError(
+		err,
+		"SQLite.FetchEntryContent: content extraction is not supported by the parser",
+	)
+}
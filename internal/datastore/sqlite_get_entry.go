@@ -55,6 +55,9 @@ func getEntry(ctx context.Context, tx *sql.Tx, entryID ID) (*entryRecord, error)
 			, e.description AS description
 			, e.content AS content
 			, e.url AS url
+			, e.authors AS authors
+			, e.tags AS tags
+			, e.enclosures AS enclosures
 			, e.update_time AS update_time
 			, e.pub_time AS pub_time
 		FROM
@@ -76,6 +79,9 @@ func getEntry(ctx context.Context, tx *sql.Tx, entryID ID) (*entryRecord, error)
 			&entry.description,
 			&entry.content,
 			&entry.url,
+			&entry.authors,
+			&entry.tags,
+			&entry.enclosures,
 			&entry.updated,
 			&entry.published,
 		); err != nil {
@@ -92,3 +98,16 @@ func getEntry(ctx context.Context, tx *sql.Tx, entryID ID) (*entryRecord, error)
 
 	return scanRow(stmt1.QueryRowContext(ctx, entryID))
 }
+
+// getEntriesByIDs returns the entries with the given IDs, in the same order.
+func getEntriesByIDs(ctx context.Context, tx *sql.Tx, entryIDs []ID) ([]*entryRecord, error) {
+	recs := make([]*entryRecord, len(entryIDs))
+	for i, id := range entryIDs {
+		rec, err := getEntry(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		recs[i] = rec
+	}
+	return recs, nil
+}
@@ -6,6 +6,7 @@ package datastore
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/bow/neon/internal/entity"
@@ -23,6 +24,11 @@ func (db *SQLite) ImportSubscription(
 	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
 		now := time.Now()
 
+		folderIDs, ierr := importFolders(ctx, tx, sub.Folders)
+		if ierr != nil {
+			return ierr
+		}
+
 		for _, feed := range sub.Feeds {
 			f := feed
 			feedID, isAdded, ierr := upsertFeed(
@@ -43,6 +49,15 @@ func (db *SQLite) ImportSubscription(
 			if ierr = addFeedTags(ctx, tx, feedID, f.Tags); ierr != nil {
 				return ierr
 			}
+			// Feeds outside of any imported folder keep their current folders.
+			if f.FolderID != nil {
+				if folderID, ok := folderIDs[*f.FolderID]; ok {
+					ierr = setFeedFolderID(ctx, tx, feedID, toNullIDOrNil(&folderID))
+					if ierr != nil {
+						return ierr
+					}
+				}
+			}
 			processed++
 			if isAdded {
 				imported++
@@ -64,3 +79,71 @@ func (db *SQLite) ImportSubscription(
 
 	return processed, imported, nil
 }
+
+// importFolders adds the given folders, reusing existing folders with the same names and parents.
+// It returns the database IDs of the folders, keyed by their IDs in the subscription.
+func importFolders(ctx context.Context, tx *sql.Tx, folders []*entity.Folder) (map[ID]ID, error) {
+
+	var (
+		ids      = make(map[ID]ID, len(folders))
+		children = entity.FolderChildren(folders)
+	)
+
+	var importFunc func(subParentID ID, parentID *sql.NullInt64) error
+	importFunc = func(subParentID ID, parentID *sql.NullInt64) error {
+		for _, folder := range children[subParentID] {
+			if _, seen := ids[folder.ID]; seen {
+				continue
+			}
+			id, err := upsertFolder(ctx, tx, folder.Name, parentID)
+			if err != nil {
+				return err
+			}
+			ids[folder.ID] = id
+			err = importFunc(folder.ID, &sql.NullInt64{Int64: int64(id), Valid: true})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := importFunc(0, &sql.NullInt64{}); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// upsertFolder returns the ID of the folder with the given name and parent, adding the folder
+// if it does not exist yet.
+func upsertFolder(
+	ctx context.Context,
+	tx *sql.Tx,
+	name string,
+	parentID *sql.NullInt64,
+) (ID, error) {
+
+	sql1 := `SELECT id FROM folders WHERE COALESCE(parent_id, 0) = COALESCE(?, 0) AND name = ?`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt1.Close()
+
+	var id ID
+	err = stmt1.QueryRowContext(ctx, parentID, name).Scan(&id)
+	if err == nil {
+		return id, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	rec, err := insertFolder(ctx, tx, name, parentID)
+	if err != nil {
+		return 0, err
+	}
+
+	return rec.id, nil
+}
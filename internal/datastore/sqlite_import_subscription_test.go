@@ -150,3 +150,46 @@ func TestImportSubscriptionOkExtended(t *testing.T) {
 	a.True(existfA())
 	a.True(existfBC())
 }
+
+func TestImportSubscriptionOkFolders(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	existing, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+
+	sub := entity.Subscription{
+		Feeds: []*entity.Feed{
+			{Title: "Feed A", FeedURL: "http://a.com/feed.xml", FolderID: pointer(ID(2))},
+			{Title: "Feed B", FeedURL: "http://b.com/feed.xml"},
+		},
+		Folders: []*entity.Folder{
+			{ID: 1, Name: "News"},
+			{ID: 2, Name: "Tech", ParentID: pointer(ID(1))},
+		},
+	}
+
+	nproc, nimp, err := db.ImportSubscription(context.Background(), &sub)
+	r.NoError(err)
+	a.Equal(2, nproc)
+	a.Equal(2, nimp)
+
+	folders, err := db.ListFolders(context.Background())
+	r.NoError(err)
+	r.Len(folders, 2)
+	a.Equal(existing, folders[0])
+	a.Equal("Tech", folders[1].Name)
+	a.Equal(&existing.ID, folders[1].ParentID)
+
+	a.True(
+		db.rowExists(
+			`SELECT * FROM feeds WHERE title = ? AND folder_id = ?`,
+			"Feed A",
+			folders[1].ID,
+		),
+	)
+	a.True(db.rowExists(`SELECT * FROM feeds WHERE title = ? AND folder_id IS NULL`, "Feed B"))
+}
@@ -8,24 +8,29 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/bow/neon/internal/entity"
 )
 
 func (db *SQLite) ListEntries(
 	ctx context.Context,
-	feedIDs []entity.ID,
-	isBookmarked *bool,
-) ([]*entity.Entry, error) {
+	filter *entity.EntryFilter,
+	order entity.EntrySortOrder,
+	page *entity.Page,
+) ([]*entity.Entry, string, error) {
 
-	recs := make([]*entryRecord, 0)
+	var (
+		recs      = make([]*entryRecord, 0)
+		nextToken string
+	)
 	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
-		// TODO: Expose num arg in function signature.
-		irecs, err := getEntries(ctx, tx, feedIDs, nil, nil, isBookmarked)
+		irecs, inext, err := listEntries(ctx, tx, filter, order, page)
 		if err != nil {
 			return err
 		}
 		recs = irecs
+		nextToken = inext
 		return nil
 	}
 
@@ -36,12 +41,13 @@ func (db *SQLite) ListEntries(
 
 	err := db.withTx(ctx, dbFunc)
 	if err != nil {
-		return nil, fail(err)
+		return nil, "", fail(err)
 	}
 
-	return entryRecords(recs).entriesSlice(), nil
+	return entryRecords(recs).entriesSlice(), nextToken, nil
 }
 
+// getEntries returns the most recently updated entries of the given feeds.
 func getEntries(
 	ctx context.Context,
 	tx *sql.Tx,
@@ -51,7 +57,55 @@ func getEntries(
 	isBookmarked *bool,
 ) ([]*entryRecord, error) {
 
-	sql1 := `
+	var page *entity.Page
+	if numMaxEntries != nil {
+		if *numMaxEntries == 0 {
+			return nil, nil
+		}
+		page = &entity.Page{Size: *numMaxEntries}
+	}
+
+	filter := entity.EntryFilter{FeedIDs: feedIDs, IsRead: isRead, IsBookmarked: isBookmarked}
+	recs, _, err := listEntries(ctx, tx, &filter, entity.EntrySortUpdateTimeDesc, page)
+
+	return recs, err
+}
+
+// listEntries returns a page of entries and the token for the next page, if any.
+func listEntries(
+	ctx context.Context,
+	tx *sql.Tx,
+	filter *entity.EntryFilter,
+	order entity.EntrySortOrder,
+	page *entity.Page,
+) ([]*entryRecord, string, error) {
+
+	if filter == nil {
+		filter = &entity.EntryFilter{}
+	}
+
+	var sortKey string
+	switch order {
+	case entity.EntrySortPubTimeDesc, entity.EntrySortPubTimeAsc:
+		sortKey = "COALESCE(e.pub_time, e.update_time, '')"
+	case entity.EntrySortUpdateTimeDesc, entity.EntrySortUpdateTimeAsc:
+		sortKey = "COALESCE(e.update_time, e.pub_time, '')"
+	default:
+		return nil, "", entity.InvalidArgumentError{Reason: "unknown entry sort order"}
+	}
+	cmp, dir := ">", "ASC"
+	if order == entity.EntrySortUpdateTimeDesc || order == entity.EntrySortPubTimeDesc {
+		cmp, dir = "<", "DESC"
+	}
+
+	kind := fmt.Sprintf("entries/%d", order)
+	cursor, err := parsePageToken(pageToken(page), kind)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// #nosec G201
+	sql1 := fmt.Sprintf(`
 		SELECT
 			e.id AS id
 			, e.feed_id AS feed_id
@@ -62,28 +116,75 @@ func getEntries(
 			, e.description AS description
 			, e.content AS content
 			, e.url AS url
+			, e.authors AS authors
+			, e.tags AS tags
+			, e.enclosures AS enclosures
 			, e.update_time AS update_time
 			, e.pub_time AS pub_time
+			, %[1]s AS sort_key
 		FROM
 			entries e
 		WHERE
 			COALESCE(e.feed_id IN (SELECT value FROM json_each($1)), true)
 			AND COALESCE(e.is_read = $2, true)
 			AND COALESCE(e.is_bookmarked = $3, true)
+			AND ($4 IS NULL OR e.feed_id IN (SELECT id FROM feeds WHERE is_starred = $4))
+			AND (
+				$5 IS NULL
+				OR e.feed_id IN (
+					SELECT
+						fxft.feed_id
+					FROM
+						feeds_x_feed_tags fxft
+						INNER JOIN feed_tags ft ON fxft.feed_tag_id = ft.id
+					WHERE
+						ft.name IN (SELECT value FROM json_each($5))
+				)
+			)
+			AND ($6 IS NULL OR e.pub_time >= $6)
+			AND ($7 IS NULL OR e.pub_time < $7)
+			AND ($8 IS NULL OR e.update_time >= $8)
+			AND ($9 IS NULL OR e.update_time < $9)
+			AND ($10 IS NULL OR (%[1]s, e.id) %[2]s ($10, $11))
+			AND (
+				$13 IS NULL
+				OR EXISTS (
+					SELECT
+						1
+					FROM
+						json_each(e.authors) a
+					WHERE
+						lower(a.value) IN (SELECT lower(value) FROM json_each($13))
+				)
+			)
+			AND (
+				$14 IS NULL
+				OR EXISTS (
+					SELECT
+						1
+					FROM
+						json_each(e.tags) t
+					WHERE
+						t.value IN (SELECT value FROM json_each($14))
+				)
+			)
+			AND ($15 IS NULL OR (json_array_length(e.enclosures) > 0) = $15)
 		ORDER BY
-			COALESCE(e.update_time, e.pub_time) DESC
-`
-
-	if numMaxEntries != nil {
-		nmax := *numMaxEntries
-		if nmax == 0 {
-			return nil, nil
-		}
-		sql1 += fmt.Sprintf("\nLIMIT %d", nmax)
-	}
+			sort_key %[3]s
+			, e.id %[3]s
+		LIMIT
+			$12
+`,
+		sortKey,
+		cmp,
+		dir,
+	)
 
-	scanRow := func(rows *sql.Rows) (*entryRecord, error) {
-		var entry entryRecord
+	scanRow := func(rows *sql.Rows) (*entryRecord, string, error) {
+		var (
+			entry entryRecord
+			key   string
+		)
 		if err := rows.Scan(
 			&entry.id,
 			&entry.feedID,
@@ -94,41 +195,122 @@ func getEntries(
 			&entry.description,
 			&entry.content,
 			&entry.url,
+			&entry.authors,
+			&entry.tags,
+			&entry.enclosures,
 			&entry.updated,
 			&entry.published,
+			&key,
 		); err != nil {
-			return nil, err
+			return nil, "", err
 		}
-		return &entry, nil
+		return &entry, key, nil
 	}
 
 	stmt1, err := tx.PrepareContext(ctx, sql1)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer stmt1.Close()
 
-	feedIDsJSON := "null"
-	if len(feedIDs) > 0 {
-		s, merr := json.Marshal(feedIDs)
-		if merr != nil {
-			return nil, merr
+	feedIDsJSON, err := jsonArrayOrNull(filter.FeedIDs)
+	if err != nil {
+		return nil, "", err
+	}
+	var feedTagsJSON *string
+	if len(filter.FeedTags) > 0 {
+		v, err := jsonArrayOrNull(filter.FeedTags)
+		if err != nil {
+			return nil, "", err
+		}
+		feedTagsJSON = &v
+	}
+	var authorsJSON, tagsJSON *string
+	if len(filter.Authors) > 0 {
+		v, err := jsonArrayOrNull(filter.Authors)
+		if err != nil {
+			return nil, "", err
+		}
+		authorsJSON = &v
+	}
+	if len(filter.Tags) > 0 {
+		v, err := jsonArrayOrNull(filter.Tags)
+		if err != nil {
+			return nil, "", err
 		}
-		feedIDsJSON = string(s)
+		tagsJSON = &v
+	}
+	var cursorKey, cursorID any
+	if cursor != nil {
+		cursorKey, cursorID = cursor.SortKey, cursor.ID
 	}
 
-	rows, err := stmt1.QueryContext(ctx, feedIDsJSON, isRead, isBookmarked)
+	rows, err := stmt1.QueryContext(
+		ctx,
+		feedIDsJSON,
+		filter.IsRead,
+		filter.IsBookmarked,
+		filter.IsFeedStarred,
+		feedTagsJSON,
+		utcOrNil(filter.PublishedAfter),
+		utcOrNil(filter.PublishedBefore),
+		utcOrNil(filter.UpdatedAfter),
+		utcOrNil(filter.UpdatedBefore),
+		cursorKey,
+		cursorID,
+		pageLimit(page),
+		authorsJSON,
+		tagsJSON,
+		filter.HasEnclosures,
+	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	entries := make([]*entryRecord, 0)
+	var (
+		entries = make([]*entryRecord, 0)
+		keys    = make([]string, 0)
+	)
 	for rows.Next() {
-		entry, err := scanRow(rows)
+		entry, key, err := scanRow(rows)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		entries = append(entries, entry)
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if page != nil && page.Size > 0 && len(entries) > int(page.Size) {
+		last := int(page.Size) - 1
+		entries = entries[:page.Size]
+		nextToken = (&pageCursor{Kind: kind, SortKey: keys[last], ID: entries[last].id}).token()
+	}
+
+	return entries, nextToken, nil
+}
+
+// jsonArrayOrNull returns the given values as a JSON array, or a JSON null if there are no
+// values.
+func jsonArrayOrNull[T any](values []T) (string, error) {
+	if len(values) == 0 {
+		return "null", nil
+	}
+	s, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(s), nil
+}
+
+// utcOrNil returns the given time in UTC, which is how feed times are stored.
+func utcOrNil(v *time.Time) *time.Time {
+	if v == nil {
+		return nil
 	}
-	return entries, nil
+	utc := v.UTC()
+	return &utc
 }
@@ -7,8 +7,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/mmcdole/gofeed"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
 )
 
 func TestListEntriesOkMinimal(t *testing.T) {
@@ -30,8 +33,14 @@ func TestListEntriesOkMinimal(t *testing.T) {
 	r.Equal(1, db.countFeeds())
 	r.Equal(0, db.countEntries(dbFeeds[0].feedURL))
 
-	entries, err := db.ListEntries(context.Background(), nil, nil)
+	entries, nextToken, err := db.ListEntries(
+		context.Background(),
+		nil,
+		entity.EntrySortUpdateTimeDesc,
+		nil,
+	)
 	r.NoError(err)
+	a.Empty(nextToken)
 
 	a.Len(entries, 0)
 }
@@ -73,12 +82,17 @@ func TestListEntriesOkExtended(t *testing.T) {
 	r.Equal(3, db.countFeeds())
 	r.Equal(3, db.countEntries(dbFeeds[1].feedURL))
 
-	entries, err := db.ListEntries(
+	entries, nextToken, err := db.ListEntries(
 		context.Background(),
-		[]ID{keys[dbFeeds[1].title].ID},
-		pointer(true),
+		&entity.EntryFilter{
+			FeedIDs:      []ID{keys[dbFeeds[1].title].ID},
+			IsBookmarked: pointer(true),
+		},
+		entity.EntrySortUpdateTimeDesc,
+		nil,
 	)
 	r.NoError(err)
+	a.Empty(nextToken)
 
 	a.Len(entries, 1)
 }
@@ -119,8 +133,318 @@ func TestListEntriesOkEmpty(t *testing.T) {
 	r.Equal(3, db.countFeeds())
 	r.Equal(2, db.countEntries(dbFeeds[1].feedURL))
 
-	entries, err := db.ListEntries(context.Background(), []ID{404}, nil)
+	entries, _, err := db.ListEntries(
+		context.Background(),
+		&entity.EntryFilter{FeedIDs: []ID{404}},
+		entity.EntrySortUpdateTimeDesc,
+		nil,
+	)
 	r.NoError(err)
 
 	a.Len(entries, 0)
 }
+
+func setupListEntriesFixture(t *testing.T) (testSQLiteDB, map[string]feedKey) {
+	t.Helper()
+
+	db := newTestSQLiteDB(t)
+	keys := db.addFeeds([]*feedRecord{
+		{
+			title:     "Feed A",
+			feedURL:   "http://a.com/feed.xml",
+			isStarred: true,
+			tags:      []string{"news"},
+			entries: []*entryRecord{
+				{
+					title:     "Entry A1",
+					updated:   toNullTime(mustTime(t, "2024-03-01T10:00:00Z")),
+					published: toNullTime(mustTime(t, "2024-01-01T10:00:00Z")),
+				},
+				{
+					title:     "Entry A2",
+					isRead:    true,
+					updated:   toNullTime(mustTime(t, "2024-03-02T10:00:00Z")),
+					published: toNullTime(mustTime(t, "2024-03-02T10:00:00Z")),
+				},
+			},
+		},
+		{
+			title:   "Feed B",
+			feedURL: "http://b.com/feed.xml",
+			tags:    []string{"tech", "blog"},
+			entries: []*entryRecord{
+				{
+					title:     "Entry B1",
+					updated:   toNullTime(mustTime(t, "2024-03-03T10:00:00Z")),
+					published: toNullTime(mustTime(t, "2024-02-01T10:00:00Z")),
+				},
+				{
+					title:   "Entry B2",
+					isRead:  true,
+					updated: toNullTime(mustTime(t, "2024-03-03T10:00:00Z")),
+				},
+				{
+					title:     "Entry B3",
+					updated:   toNullTime(mustTime(t, "2024-03-04T10:00:00Z")),
+					published: toNullTime(mustTime(t, "2024-03-04T10:00:00Z")),
+				},
+			},
+		},
+	})
+
+	return db, keys
+}
+
+func entryTitles(entries []*entity.Entry) []string {
+	titles := make([]string, len(entries))
+	for i, entry := range entries {
+		titles[i] = entry.Title
+	}
+	return titles
+}
+
+func TestListEntriesOkPaginated(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, _ := setupListEntriesFixture(t)
+
+	tests := []struct {
+		order entity.EntrySortOrder
+		want  []string
+	}{
+		{
+			entity.EntrySortUpdateTimeDesc,
+			[]string{"Entry B3", "Entry B2", "Entry B1", "Entry A2", "Entry A1"},
+		},
+		{
+			entity.EntrySortUpdateTimeAsc,
+			[]string{"Entry A1", "Entry A2", "Entry B1", "Entry B2", "Entry B3"},
+		},
+		{
+			// Entries without publication times are sorted by their update times.
+			entity.EntrySortPubTimeDesc,
+			[]string{"Entry B3", "Entry B2", "Entry A2", "Entry B1", "Entry A1"},
+		},
+		{
+			entity.EntrySortPubTimeAsc,
+			[]string{"Entry A1", "Entry B1", "Entry A2", "Entry B2", "Entry B3"},
+		},
+	}
+
+	for _, test := range tests {
+		var (
+			page   = entity.Page{Size: 2}
+			titles = make([]string, 0)
+			npages = 0
+		)
+		for {
+			entries, nextToken, err := db.ListEntries(context.Background(), nil, test.order, &page)
+			r.NoError(err)
+			r.LessOrEqual(len(entries), 2)
+			titles = append(titles, entryTitles(entries)...)
+			npages++
+			if nextToken == "" {
+				break
+			}
+			page.Token = nextToken
+		}
+		a.Equal(test.want, titles, "order %d", test.order)
+		a.Equal(3, npages, "order %d", test.order)
+	}
+}
+
+func TestListEntriesOkFiltered(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupListEntriesFixture(t)
+
+	tests := []struct {
+		name   string
+		filter entity.EntryFilter
+		want   []string
+	}{
+		{
+			"feed IDs",
+			entity.EntryFilter{FeedIDs: []ID{keys["Feed A"].ID}},
+			[]string{"Entry A2", "Entry A1"},
+		},
+		{
+			"unread",
+			entity.EntryFilter{IsRead: pointer(false)},
+			[]string{"Entry B3", "Entry B1", "Entry A1"},
+		},
+		{
+			"starred feeds",
+			entity.EntryFilter{IsFeedStarred: pointer(true)},
+			[]string{"Entry A2", "Entry A1"},
+		},
+		{
+			"feed tags",
+			entity.EntryFilter{FeedTags: []string{"blog", "other"}},
+			[]string{"Entry B3", "Entry B2", "Entry B1"},
+		},
+		{
+			"published range",
+			entity.EntryFilter{
+				PublishedAfter:  pointer(mustTime(t, "2024-02-01T10:00:00Z")),
+				PublishedBefore: pointer(mustTime(t, "2024-03-04T10:00:00Z")),
+			},
+			[]string{"Entry B1", "Entry A2"},
+		},
+		{
+			"updated range in another time zone",
+			entity.EntryFilter{
+				UpdatedAfter: pointer(mustTime(t, "2024-03-03T12:00:00+02:00")),
+			},
+			[]string{"Entry B3", "Entry B2", "Entry B1"},
+		},
+		{
+			"combined",
+			entity.EntryFilter{FeedTags: []string{"tech"}, IsRead: pointer(true)},
+			[]string{"Entry B2"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		entries, nextToken, err := db.ListEntries(
+			context.Background(),
+			&test.filter,
+			entity.EntrySortUpdateTimeDesc,
+			nil,
+		)
+		r.NoError(err, test.name)
+		a.Empty(nextToken, test.name)
+		a.ElementsMatch(test.want, entryTitles(entries), test.name)
+	}
+}
+
+func TestListEntriesOkFilteredMetadata(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: "https://a.com/feed.xml"}})
+	db.upsertEntries(keys["Feed A"].ID, []*gofeed.Item{
+		{
+			GUID:       "a1",
+			Title:      "Episode 1",
+			Link:       "https://a.com/a1",
+			Authors:    []*gofeed.Person{{Name: "Jane Doe"}, {Name: "John Roe"}},
+			Categories: []string{"podcast", "tech", "podcast", " "},
+			Enclosures: []*gofeed.Enclosure{
+				{URL: "https://a.com/a1.mp3", Type: "audio/mpeg", Length: "1024"},
+				{URL: "https://a.com/a1.jpg", Length: "unknown"},
+				{Type: "image/png"},
+			},
+		},
+		{
+			GUID:       "a2",
+			Title:      "Notes",
+			Link:       "https://a.com/a2",
+			Author:     &gofeed.Person{Name: "Jane Doe"},
+			Categories: []string{"tech"},
+		},
+		{GUID: "a3", Title: "Misc", Link: "https://a.com/a3"},
+	})
+
+	entries, _, err := db.ListEntries(
+		context.Background(),
+		&entity.EntryFilter{HasEnclosures: pointer(true)},
+		entity.EntrySortUpdateTimeDesc,
+		nil,
+	)
+	r.NoError(err)
+	r.Len(entries, 1)
+	a.Equal([]string{"Jane Doe", "John Roe"}, entries[0].Authors)
+	a.Equal([]string{"podcast", "tech"}, entries[0].Tags)
+	a.Equal(
+		[]*entity.Enclosure{
+			{URL: "https://a.com/a1.mp3", Type: "audio/mpeg", Length: 1024},
+			{URL: "https://a.com/a1.jpg"},
+		},
+		entries[0].Enclosures,
+	)
+
+	tests := []struct {
+		name   string
+		filter entity.EntryFilter
+		want   []string
+	}{
+		{
+			"authors ignoring case",
+			entity.EntryFilter{Authors: []string{"jane doe"}},
+			[]string{"Episode 1", "Notes"},
+		},
+		{
+			"tags",
+			entity.EntryFilter{Tags: []string{"podcast", "other"}},
+			[]string{"Episode 1"},
+		},
+		{
+			"without enclosures",
+			entity.EntryFilter{HasEnclosures: pointer(false)},
+			[]string{"Notes", "Misc"},
+		},
+		{
+			"combined",
+			entity.EntryFilter{Authors: []string{"John Roe"}, Tags: []string{"tech"}},
+			[]string{"Episode 1"},
+		},
+	}
+
+	for _, test := range tests {
+		entries, _, err := db.ListEntries(
+			context.Background(),
+			&test.filter,
+			entity.EntrySortUpdateTimeDesc,
+			nil,
+		)
+		r.NoError(err, test.name)
+		a.ElementsMatch(test.want, entryTitles(entries), test.name)
+	}
+}
+
+func TestListEntriesErrPageToken(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, _ := setupListEntriesFixture(t)
+
+	_, nextToken, err := db.ListEntries(
+		context.Background(),
+		nil,
+		entity.EntrySortUpdateTimeDesc,
+		&entity.Page{Size: 1},
+	)
+	r.NoError(err)
+	r.NotEmpty(nextToken)
+
+	for _, token := range []string{"not-a-token!", "bm9wZQ"} {
+		entries, _, err := db.ListEntries(
+			context.Background(),
+			nil,
+			entity.EntrySortUpdateTimeDesc,
+			&entity.Page{Size: 1, Token: token},
+		)
+		a.Nil(entries)
+		a.ErrorAs(err, &entity.InvalidArgumentError{})
+	}
+
+	// Tokens can not be used with a different sort order.
+	entries, _, err := db.ListEntries(
+		context.Background(),
+		nil,
+		entity.EntrySortPubTimeDesc,
+		&entity.Page{Size: 1, Token: nextToken},
+	)
+	a.Nil(entries)
+	a.ErrorAs(err, &entity.InvalidArgumentError{})
+}
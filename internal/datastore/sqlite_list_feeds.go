@@ -6,6 +6,7 @@ package datastore
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/bow/neon/internal/entity"
 )
@@ -13,12 +14,18 @@ import (
 func (db *SQLite) ListFeeds(
 	ctx context.Context,
 	maxEntriesPerFeed *uint32,
-) ([]*entity.Feed, error) {
-
-	recs := make([]*feedRecord, 0)
+	filter *entity.FeedFilter,
+	order entity.FeedSortOrder,
+	page *entity.Page,
+) ([]*entity.Feed, string, error) {
+
+	var (
+		recs      = make([]*feedRecord, 0)
+		nextToken string
+	)
 	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
 
-		irecs, err := getAllFeeds(ctx, tx)
+		irecs, inext, err := listFeeds(ctx, tx, filter, order, page)
 		if err != nil {
 			return err
 		}
@@ -31,6 +38,7 @@ func (db *SQLite) ListFeeds(
 			ifeed.entries = entries
 		}
 		recs = irecs
+		nextToken = inext
 
 		return nil
 	}
@@ -42,15 +50,52 @@ func (db *SQLite) ListFeeds(
 
 	err := db.withTx(ctx, dbFunc)
 	if err != nil {
-		return nil, fail(err)
+		return nil, "", fail(err)
 	}
 
-	return feedRecords(recs).feeds(), nil
+	return feedRecords(recs).feeds(), nextToken, nil
 }
 
 func getAllFeeds(ctx context.Context, tx *sql.Tx) ([]*feedRecord, error) {
+	recs, _, err := listFeeds(ctx, tx, nil, entity.FeedSortUpdateTimeDesc, nil)
+	return recs, err
+}
+
+// listFeeds returns a page of feeds and the token for the next page, if any.
+func listFeeds(
+	ctx context.Context,
+	tx *sql.Tx,
+	filter *entity.FeedFilter,
+	order entity.FeedSortOrder,
+	page *entity.Page,
+) ([]*feedRecord, string, error) {
+
+	if filter == nil {
+		filter = &entity.FeedFilter{}
+	}
+
+	var sortKey string
+	switch order {
+	case entity.FeedSortTitleAsc, entity.FeedSortTitleDesc:
+		sortKey = "lower(f.title)"
+	case entity.FeedSortUpdateTimeDesc, entity.FeedSortUpdateTimeAsc:
+		sortKey = "COALESCE(f.update_time, f.sub_time)"
+	default:
+		return nil, "", entity.InvalidArgumentError{Reason: "unknown feed sort order"}
+	}
+	cmp, dir := ">", "ASC"
+	if order == entity.FeedSortUpdateTimeDesc || order == entity.FeedSortTitleDesc {
+		cmp, dir = "<", "DESC"
+	}
+
+	kind := fmt.Sprintf("feeds/%d", order)
+	cursor, err := parsePageToken(pageToken(page), kind)
+	if err != nil {
+		return nil, "", err
+	}
 
-	sql1 := `
+	// #nosec G201
+	sql1 := fmt.Sprintf(`
 		SELECT
 			f.id AS id
 			, f.title AS title
@@ -62,17 +107,49 @@ func getAllFeeds(ctx context.Context, tx *sql.Tx) ([]*feedRecord, error) {
 			, f.last_pull_time AS last_pull_time
 			, f.update_time AS update_time
 			, json_group_array(fc.name) FILTER (WHERE fc.name IS NOT NULL) AS tags
+			, f.folder_id AS folder_id
+			, f.fetch_content AS fetch_content
+			, f.pull_failures AS pull_failures
+			, f.last_pull_error AS last_pull_error
+			, f.next_pull_time AS next_pull_time
+			, %[1]s AS sort_key
 		FROM
 			feeds f
 			LEFT JOIN feeds_x_feed_tags fxfc ON fxfc.feed_id = f.id
 			LEFT JOIN feed_tags fc ON fxfc.feed_tag_id = fc.id
+		WHERE
+			COALESCE(f.id IN (SELECT value FROM json_each($1)), true)
+			AND COALESCE(f.is_starred = $2, true)
+			AND (
+				$3 IS NULL
+				OR f.id IN (
+					SELECT
+						fxft.feed_id
+					FROM
+						feeds_x_feed_tags fxft
+						INNER JOIN feed_tags ft ON fxft.feed_tag_id = ft.id
+					WHERE
+						ft.name IN (SELECT value FROM json_each($3))
+				)
+			)
+			AND ($4 IS NULL OR (%[1]s, f.id) %[2]s ($4, $5))
 		GROUP BY
 			f.id
 		ORDER BY
-			COALESCE(f.update_time, f.sub_time) DESC
-`
-	scanRow := func(rows *sql.Rows) (*feedRecord, error) {
-		var feed feedRecord
+			sort_key %[3]s
+			, f.id %[3]s
+		LIMIT
+			$6
+`,
+		sortKey,
+		cmp,
+		dir,
+	)
+	scanRow := func(rows *sql.Rows) (*feedRecord, string, error) {
+		var (
+			feed feedRecord
+			key  string
+		)
 		if err := rows.Scan(
 			&feed.id,
 			&feed.title,
@@ -84,31 +161,76 @@ func getAllFeeds(ctx context.Context, tx *sql.Tx) ([]*feedRecord, error) {
 			&feed.lastPulled,
 			&feed.updated,
 			&feed.tags,
+			&feed.folderID,
+			&feed.fetchContent,
+			&feed.pullFailures,
+			&feed.pullError,
+			&feed.nextPull,
+			&key,
 		); err != nil {
-			return nil, err
+			return nil, "", err
 		}
-		return &feed, nil
+		return &feed, key, nil
 	}
 
 	stmt1, err := tx.PrepareContext(ctx, sql1)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer stmt1.Close()
 
-	rows, err := stmt1.QueryContext(ctx)
+	idsJSON, err := jsonArrayOrNull(filter.IDs)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	var tagsJSON *string
+	if len(filter.Tags) > 0 {
+		v, err := jsonArrayOrNull(filter.Tags)
+		if err != nil {
+			return nil, "", err
+		}
+		tagsJSON = &v
+	}
+	var cursorKey, cursorID any
+	if cursor != nil {
+		cursorKey, cursorID = cursor.SortKey, cursor.ID
+	}
+
+	rows, err := stmt1.QueryContext(
+		ctx,
+		idsJSON,
+		filter.IsStarred,
+		tagsJSON,
+		cursorKey,
+		cursorID,
+		pageLimit(page),
+	)
+	if err != nil {
+		return nil, "", err
 	}
 
-	feeds := make([]*feedRecord, 0)
+	var (
+		feeds = make([]*feedRecord, 0)
+		keys  = make([]string, 0)
+	)
 	for rows.Next() {
-		feed, err := scanRow(rows)
+		feed, key, err := scanRow(rows)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		feeds = append(feeds, feed)
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if page != nil && page.Size > 0 && len(feeds) > int(page.Size) {
+		last := int(page.Size) - 1
+		feeds = feeds[:page.Size]
+		nextToken = (&pageCursor{Kind: kind, SortKey: keys[last], ID: feeds[last].id}).token()
 	}
 
-	return feeds, nil
+	return feeds, nextToken, nil
 }
@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
 )
 
 func TestListFeedsOkMinimal(t *testing.T) {
@@ -18,8 +20,15 @@ func TestListFeedsOkMinimal(t *testing.T) {
 	r := require.New(t)
 	db := newTestSQLiteDB(t)
 
-	feeds, err := db.ListFeeds(context.Background(), nil)
+	feeds, nextToken, err := db.ListFeeds(
+		context.Background(),
+		nil,
+		nil,
+		entity.FeedSortUpdateTimeDesc,
+		nil,
+	)
 	r.NoError(err)
+	a.Empty(nextToken)
 
 	a.Empty(feeds)
 }
@@ -55,8 +64,15 @@ func TestListFeedsOkExtendedAllEntries(t *testing.T) {
 
 	r.Equal(2, db.countFeeds())
 
-	feeds, err := db.ListFeeds(context.Background(), nil)
+	feeds, nextToken, err := db.ListFeeds(
+		context.Background(),
+		nil,
+		nil,
+		entity.FeedSortUpdateTimeDesc,
+		nil,
+	)
 	r.NoError(err)
+	a.Empty(nextToken)
 	r.NotEmpty(feeds)
 
 	a.Len(feeds, 2)
@@ -101,8 +117,15 @@ func TestListFeedsOkExtendedLimitedEntries(t *testing.T) {
 
 	r.Equal(2, db.countFeeds())
 
-	feeds, err := db.ListFeeds(context.Background(), pointer(uint32(2)))
+	feeds, nextToken, err := db.ListFeeds(
+		context.Background(),
+		pointer(uint32(2)),
+		nil,
+		entity.FeedSortUpdateTimeDesc,
+		nil,
+	)
 	r.NoError(err)
+	a.Empty(nextToken)
 	r.NotEmpty(feeds)
 
 	a.Len(feeds, 2)
@@ -115,3 +138,66 @@ func TestListFeedsOkExtendedLimitedEntries(t *testing.T) {
 	a.Equal(feed1.FeedURL, dbFeeds[0].feedURL)
 	a.Len(feed1.Entries, 1)
 }
+
+func TestListFeedsOkPaginatedFiltered(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{
+		{title: "delta", feedURL: "http://d.com/feed.xml", tags: []string{"news"}},
+		{title: "Alpha", feedURL: "http://a.com/feed.xml", isStarred: true},
+		{title: "charlie", feedURL: "http://c.com/feed.xml", tags: []string{"news"}},
+		{title: "Bravo", feedURL: "http://b.com/feed.xml", isStarred: true},
+	})
+
+	listTitles := func(filter *entity.FeedFilter, order entity.FeedSortOrder) []string {
+		var (
+			page   = entity.Page{Size: 3}
+			titles = make([]string, 0)
+		)
+		for {
+			feeds, nextToken, err := db.ListFeeds(
+				context.Background(),
+				pointer(uint32(0)),
+				filter,
+				order,
+				&page,
+			)
+			r.NoError(err)
+			for _, feed := range feeds {
+				titles = append(titles, feed.Title)
+			}
+			if nextToken == "" {
+				return titles
+			}
+			page.Token = nextToken
+		}
+	}
+
+	a.Equal(
+		[]string{"Alpha", "Bravo", "charlie", "delta"},
+		listTitles(nil, entity.FeedSortTitleAsc),
+	)
+	a.Equal(
+		[]string{"delta", "charlie", "Bravo", "Alpha"},
+		listTitles(nil, entity.FeedSortTitleDesc),
+	)
+	a.Equal(
+		[]string{"Alpha", "Bravo"},
+		listTitles(&entity.FeedFilter{IsStarred: pointer(true)}, entity.FeedSortTitleAsc),
+	)
+	a.Equal(
+		[]string{"charlie", "delta"},
+		listTitles(&entity.FeedFilter{Tags: []string{"news"}}, entity.FeedSortTitleAsc),
+	)
+	a.Equal(
+		[]string{"delta", "Alpha"},
+		listTitles(
+			&entity.FeedFilter{IDs: []ID{keys["Alpha"].ID, keys["delta"].ID}},
+			entity.FeedSortTitleDesc,
+		),
+	)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// ListFolders returns all folders, ordered by name.
+func (db *SQLite) ListFolders(ctx context.Context) ([]*entity.Folder, error) {
+
+	var recs []*folderRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		irecs, err := getFolders(ctx, tx)
+		if err != nil {
+			return err
+		}
+		recs = irecs
+		return nil
+	}
+
+	fail := failF("SQLite.ListFolders")
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return folderRecords(recs).folders(), nil
+}
+
+func getFolders(ctx context.Context, tx *sql.Tx) ([]*folderRecord, error) {
+
+	sql1 := `
+		SELECT
+			fd.id AS id
+			, fd.name AS name
+			, fd.parent_id AS parent_id
+		FROM
+			folders fd
+		ORDER BY
+			lower(fd.name)
+			, fd.id
+`
+	scanRow := func(rows *sql.Rows) (*folderRecord, error) {
+		var rec folderRecord
+		if err := rows.Scan(&rec.id, &rec.name, &rec.parentID); err != nil {
+			return nil, err
+		}
+		return &rec, nil
+	}
+
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rows, err := stmt1.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make([]*folderRecord, 0)
+	for rows.Next() {
+		rec, err := scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return recs, nil
+}
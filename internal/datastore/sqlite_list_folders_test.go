@@ -0,0 +1,54 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestListFoldersOkEmpty(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	folders, err := db.ListFolders(context.Background())
+	r.NoError(err)
+
+	a.Empty(folders)
+}
+
+func TestListFoldersOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	news, err := db.CreateFolder(context.Background(), "news", nil)
+	r.NoError(err)
+	_, err = db.CreateFolder(context.Background(), "Tech", &news.ID)
+	r.NoError(err)
+	_, err = db.CreateFolder(context.Background(), "Blogs", nil)
+	r.NoError(err)
+
+	folders, err := db.ListFolders(context.Background())
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.Folder{
+			{ID: 3, Name: "Blogs"},
+			{ID: 1, Name: "news"},
+			{ID: 2, Name: "Tech", ParentID: pointer(ID(1))},
+		},
+		folders,
+	)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// ListRetentionPolicies returns all retention policies, with the global policy first and the
+// feed policies ordered by feed ID.
+func (db *SQLite) ListRetentionPolicies(ctx context.Context) ([]*entity.RetentionPolicy, error) {
+
+	var recs []*retentionPolicyRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		irecs, err := getRetentionPolicies(ctx, tx)
+		if err != nil {
+			return err
+		}
+		recs = irecs
+		return nil
+	}
+
+	fail := failF("SQLite.ListRetentionPolicies")
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	policies := make([]*entity.RetentionPolicy, len(recs))
+	for i, rec := range recs {
+		policies[i] = rec.policy()
+	}
+
+	return policies, nil
+}
+
+func getRetentionPolicies(ctx context.Context, tx *sql.Tx) ([]*retentionPolicyRecord, error) {
+
+	sql1 := `
+		SELECT
+			rp.feed_id AS feed_id
+			, rp.max_age_secs AS max_age_secs
+			, rp.max_entries AS max_entries
+			, rp.keep_bookmarked AS keep_bookmarked
+			, rp.keep_unread AS keep_unread
+		FROM
+			retention_policies rp
+		ORDER BY
+			rp.feed_id IS NOT NULL
+			, rp.feed_id
+`
+	scanRow := func(rows *sql.Rows) (*retentionPolicyRecord, error) {
+		var rec retentionPolicyRecord
+		if err := rows.Scan(
+			&rec.feedID,
+			&rec.maxAgeSecs,
+			&rec.maxEntries,
+			&rec.keepBookmarked,
+			&rec.keepUnread,
+		); err != nil {
+			return nil, err
+		}
+		return &rec, nil
+	}
+
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rows, err := stmt1.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make([]*retentionPolicyRecord, 0)
+	for rows.Next() {
+		rec, err := scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return recs, nil
+}
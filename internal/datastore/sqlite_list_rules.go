@@ -0,0 +1,118 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// ListRules returns all entry rules, in the order of their creation.
+func (db *SQLite) ListRules(ctx context.Context) ([]*entity.Rule, error) {
+
+	var recs []*ruleRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		irecs, err := getRules(ctx, tx, nil)
+		if err != nil {
+			return err
+		}
+		recs = irecs
+		return nil
+	}
+
+	fail := failF("SQLite.ListRules")
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	return ruleRecords(recs).rules(), nil
+}
+
+// getRules returns the rules applying to the entries of the given feed, or all rules if the feed
+// ID is nil.
+func getRules(ctx context.Context, tx *sql.Tx, feedID *ID) ([]*ruleRecord, error) {
+
+	sql1 := `
+		SELECT
+			r.id AS id
+			, r.feed_id AS feed_id
+			, r.field AS field
+			, r.match_type AS match_type
+			, r.pattern AS pattern
+			, r.action AS action
+			, r.tag AS tag
+			, r.create_time AS create_time
+		FROM
+			entry_rules r
+		WHERE
+			$1 IS NULL OR r.feed_id IS NULL OR r.feed_id = $1
+		ORDER BY
+			r.id
+`
+	scanRow := func(rows *sql.Rows) (*ruleRecord, error) {
+		var rec ruleRecord
+		if err := rows.Scan(
+			&rec.id,
+			&rec.feedID,
+			&rec.field,
+			&rec.matchType,
+			&rec.pattern,
+			&rec.action,
+			&rec.tag,
+			&rec.createTime,
+		); err != nil {
+			return nil, err
+		}
+		return &rec, nil
+	}
+
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rows, err := stmt1.QueryContext(ctx, feedID)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make([]*ruleRecord, 0)
+	for rows.Next() {
+		rec, err := scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return recs, nil
+}
+
+// getRuleMatchers returns the matchers of the rules applying to the entries of the given feed.
+func getRuleMatchers(ctx context.Context, tx *sql.Tx, feedID ID) ([]*entity.RuleMatcher, error) {
+	recs, err := getRules(ctx, tx, &feedID)
+	if err != nil {
+		return nil, err
+	}
+	matchers := make([]*entity.RuleMatcher, len(recs))
+	for i, rec := range recs {
+		m, err := entity.NewRuleMatcher(rec.rule())
+		if err != nil {
+			return nil, err
+		}
+		matchers[i] = m
+	}
+	return matchers, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestListRulesOkEmpty(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	rules, err := db.ListRules(context.Background())
+	r.NoError(err)
+	a.Empty(rules)
+}
+
+func TestListRulesOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	for _, pattern := range []string{"sponsored", "giveaway"} {
+		_, err := db.CreateRule(
+			context.Background(),
+			&entity.Rule{
+				Field:     entity.RuleFieldTitle,
+				MatchType: entity.RuleMatchSubstring,
+				Pattern:   pattern,
+				Action:    entity.RuleActionMarkRead,
+			},
+		)
+		r.NoError(err)
+	}
+
+	rules, err := db.ListRules(context.Background())
+	r.NoError(err)
+	r.Len(rules, 2)
+	a.Equal("sponsored", rules[0].Pattern)
+	a.Equal("giveaway", rules[1].Pattern)
+	a.Nil(rules[0].FeedID)
+	a.Equal(entity.RuleActionMarkRead, rules[0].Action)
+	a.Empty(rules[0].Tag)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// ListTokens returns all API tokens, ordered by name.
+func (db *SQLite) ListTokens(ctx context.Context) ([]*entity.Token, error) {
+
+	var recs []*tokenRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		irecs, err := getTokens(ctx, tx, nil)
+		if err != nil {
+			return err
+		}
+		recs = irecs
+		return nil
+	}
+
+	fail := failF("SQLite.ListTokens")
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	tokens := make([]*entity.Token, len(recs))
+	for i, rec := range recs {
+		tokens[i] = rec.token()
+	}
+
+	return tokens, nil
+}
+
+// getTokens returns the tokens with the given secret hash, or all tokens if the hash is nil.
+func getTokens(ctx context.Context, tx *sql.Tx, secretHash *string) ([]*tokenRecord, error) {
+
+	sql1 := `
+		SELECT
+			t.id AS id
+			, t.name AS name
+			, t.scope AS scope
+			, t.create_time AS create_time
+		FROM
+			api_tokens t
+		WHERE
+			$1 IS NULL OR t.secret_hash = $1
+		ORDER BY
+			t.name
+`
+	scanRow := func(rows *sql.Rows) (*tokenRecord, error) {
+		var rec tokenRecord
+		if err := rows.Scan(
+			&rec.id,
+			&rec.name,
+			&rec.scope,
+			&rec.createTime,
+		); err != nil {
+			return nil, err
+		}
+		return &rec, nil
+	}
+
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rows, err := stmt1.QueryContext(ctx, secretHash)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make([]*tokenRecord, 0)
+	for rows.Next() {
+		rec, err := scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return recs, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestListTokensOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	tokens, err := db.ListTokens(context.Background())
+	r.NoError(err)
+	a.Empty(tokens)
+
+	phone, _, err := db.CreateToken(context.Background(), "phone", entity.TokenScopeRead)
+	r.NoError(err)
+	laptop, _, err := db.CreateToken(context.Background(), "laptop", entity.TokenScopeAdmin)
+	r.NoError(err)
+
+	tokens, err = db.ListTokens(context.Background())
+	r.NoError(err)
+	r.Len(tokens, 2)
+
+	a.Equal(laptop.ID, tokens[0].ID)
+	a.Equal("laptop", tokens[0].Name)
+	a.Equal(entity.TokenScopeAdmin, tokens[0].Scope)
+	a.True(laptop.CreateTime.Equal(tokens[0].CreateTime))
+	a.Equal(phone.ID, tokens[1].ID)
+	a.Equal(entity.TokenScopeRead, tokens[1].Scope)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// MatchRule returns the existing entries matching the given rule, most recently updated first.
+// The rule is not created, so this shows which entries a rule would act upon.
+func (db *SQLite) MatchRule(ctx context.Context, rule *entity.Rule) ([]*entity.Entry, error) {
+
+	fail := failF("SQLite.MatchRule")
+
+	m, err := entity.NewRuleMatcher(rule)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	var recs []*entryRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		if rule.FeedID != nil {
+			if err := ensureFeedExists(ctx, tx, *rule.FeedID); err != nil {
+				return err
+			}
+		}
+		ids, err := getMatchingEntryIDs(ctx, tx, m)
+		if err != nil {
+			return err
+		}
+		irecs, err := getEntriesByIDs(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		recs = irecs
+		return nil
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	err = db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	entries := entryRecords(recs).entriesSlice()
+	if entries == nil {
+		entries = make([]*entity.Entry, 0)
+	}
+
+	return entries, nil
+}
+
+// getMatchingEntryIDs returns the IDs of the entries matching the rule of the given matcher.
+func getMatchingEntryIDs(
+	ctx context.Context,
+	tx *sql.Tx,
+	m *entity.RuleMatcher,
+) ([]ID, error) {
+
+	sql1 := `
+		SELECT
+			e.id AS id
+			, e.feed_id AS feed_id
+			, e.title AS title
+			, COALESCE(e.description, '') AS description
+			, COALESCE(e.content, '') AS content
+			, e.authors AS authors
+			, COALESCE(e.url, '') AS url
+		FROM
+			entries e
+		WHERE
+			$1 IS NULL OR e.feed_id = $1
+		ORDER BY
+			COALESCE(e.update_time, e.pub_time, '') DESC
+			, e.id DESC
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	rows, err := stmt1.QueryContext(ctx, m.Rule().FeedID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]ID, 0)
+	for rows.Next() {
+		var (
+			id      ID
+			target  entity.RuleTarget
+			authors jsonArrayString
+		)
+		if err := rows.Scan(
+			&id,
+			&target.FeedID,
+			&target.Title,
+			&target.Description,
+			&target.Content,
+			&authors,
+			&target.URL,
+		); err != nil {
+			return nil, err
+		}
+		target.Authors = authors
+		if m.Matches(&target) {
+			ids = append(ids, id)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestMatchRuleOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	keys := db.addFeeds([]*feedRecord{
+		{title: "Feed A", feedURL: "https://a.com/feed.xml"},
+		{title: "Feed B", feedURL: "https://b.com/feed.xml"},
+	})
+	db.upsertEntries(keys["Feed A"].ID, []*gofeed.Item{
+		{
+			GUID:    "a1",
+			Title:   "Weekly links",
+			Link:    "https://a.com/a1",
+			Authors: []*gofeed.Person{{Name: "Jane Doe"}},
+		},
+		{GUID: "a2", Title: "Release notes", Link: "https://a.com/a2"},
+	})
+	db.upsertEntries(keys["Feed B"].ID, []*gofeed.Item{
+		{
+			GUID:   "b1",
+			Title:  "Interview",
+			Link:   "https://b.com/b1",
+			Author: &gofeed.Person{Name: "Jane Roe"},
+		},
+	})
+
+	matchTitles := func(rule *entity.Rule) []string {
+		entries, err := db.MatchRule(context.Background(), rule)
+		r.NoError(err)
+		titles := make([]string, len(entries))
+		for i, entry := range entries {
+			titles[i] = entry.Title
+		}
+		return titles
+	}
+
+	rule := entity.Rule{
+		Field:     entity.RuleFieldAuthor,
+		MatchType: entity.RuleMatchRegex,
+		Pattern:   `^Jane`,
+		Action:    entity.RuleActionBookmark,
+	}
+	a.ElementsMatch([]string{"Weekly links", "Interview"}, matchTitles(&rule))
+
+	rule.FeedID = pointer(keys["Feed B"].ID)
+	a.Equal([]string{"Interview"}, matchTitles(&rule))
+
+	rule.Field = entity.RuleFieldTitle
+	a.Empty(matchTitles(&rule))
+
+	// Matching does not change entries or create rules.
+	a.Equal(0, db.countTableRows("entry_rules"))
+	a.Equal(3, db.countTableRows("entries"))
+}
+
+func TestMatchRuleErrFeedNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	entries, err := db.MatchRule(
+		context.Background(),
+		&entity.Rule{
+			FeedID:    pointer(ID(7)),
+			Field:     entity.RuleFieldTitle,
+			MatchType: entity.RuleMatchSubstring,
+			Pattern:   "x",
+			Action:    entity.RuleActionDrop,
+		},
+	)
+	a.Nil(entries)
+	a.EqualError(err, "SQLite.MatchRule: feed with ID=7 not found")
+}
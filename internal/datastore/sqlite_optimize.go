@@ -0,0 +1,27 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+)
+
+// Optimize reclaims unused space in the database file and refreshes the statistics used by the
+// query planner. It is useful after many entries have been removed.
+func (db *SQLite) Optimize(ctx context.Context) error {
+
+	fail := failF("SQLite.Optimize")
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// VACUUM can not run inside a transaction.
+	for _, stmt := range []string{"VACUUM", "ANALYZE"} {
+		if _, err := db.handle.ExecContext(ctx, stmt); err != nil {
+			return fail(err)
+		}
+	}
+
+	return nil
+}
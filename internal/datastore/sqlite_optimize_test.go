@@ -0,0 +1,24 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizeOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, _ := setupPruneFixture(t)
+
+	r.NoError(db.Optimize(context.Background()))
+	a.Equal(8, db.countTableRows("entries"))
+	a.True(db.rowExists(`SELECT 1 FROM sqlite_stat1 WHERE tbl = 'entries'`))
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/sliceutil"
+)
+
+// PruneEntries removes entries of the given feeds, or of all feeds if none are given, according
+// to their retention policies. Feeds without their own policies use the global policy, and
+// nothing is removed from feeds without any applicable policy. If dryRun is true, the results
+// report what would be removed without removing anything.
+func (db *SQLite) PruneEntries(
+	ctx context.Context,
+	feedIDs []entity.ID,
+	dryRun bool,
+) ([]*entity.PruneResult, error) {
+
+	var recs []*pruneRecord
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		irecs, err := pruneEntries(ctx, tx, sliceutil.Dedup(feedIDs), dryRun, time.Now())
+		if err != nil {
+			return err
+		}
+		recs = irecs
+		return nil
+	}
+
+	fail := failF("SQLite.PruneEntries")
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	results := make([]*entity.PruneResult, len(recs))
+	for i, rec := range recs {
+		results[i] = rec.pruneResult()
+	}
+
+	return results, nil
+}
+
+func pruneEntries(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedIDs []ID,
+	dryRun bool,
+	now time.Time,
+) ([]*pruneRecord, error) {
+
+	for _, id := range feedIDs {
+		if err := ensureFeedExists(ctx, tx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	policyRecs, err := getRetentionPolicies(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		global   *entity.RetentionPolicy
+		policies = make(map[ID]*entity.RetentionPolicy)
+	)
+	for _, rec := range policyRecs {
+		policy := rec.policy()
+		if policy.FeedID == nil {
+			global = policy
+		} else {
+			policies[*policy.FeedID] = policy
+		}
+	}
+
+	feedIDsJSON, err := jsonArrayOrNull(feedIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	sql1 := `
+		SELECT
+			f.id AS id
+			, f.title AS title
+			, COUNT(e.id) AS num_entries
+		FROM
+			feeds f
+			LEFT JOIN entries e ON e.feed_id = f.id
+		WHERE
+			COALESCE(f.id IN (SELECT value FROM json_each($1)), true)
+		GROUP BY
+			f.id
+		ORDER BY
+			f.id
+`
+	rows, err := tx.QueryContext(ctx, sql1, feedIDsJSON)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recs := make([]*pruneRecord, 0)
+	for rows.Next() {
+		var rec pruneRecord
+		if err := rows.Scan(&rec.feedID, &rec.feedTitle, &rec.numKept); err != nil {
+			return nil, err
+		}
+		recs = append(recs, &rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sql2 := `
+		WITH ranked AS (
+			SELECT
+				e.id AS id
+				, e.external_id AS external_id
+				, e.is_read AS is_read
+				, e.is_bookmarked AS is_bookmarked
+				, COALESCE(e.update_time, e.pub_time) AS sort_time
+				, row_number() OVER (
+					ORDER BY COALESCE(e.update_time, e.pub_time, '') DESC, e.id DESC
+				) AS rank
+			FROM
+				entries e
+			WHERE
+				e.feed_id = $1
+		)
+		SELECT
+			r.id
+			, r.external_id
+		FROM
+			ranked r
+		WHERE
+			(
+				($2 IS NOT NULL AND r.sort_time < $2)
+				OR ($3 IS NOT NULL AND r.rank > $3)
+			)
+			AND NOT ($4 AND r.is_bookmarked)
+			AND NOT ($5 AND NOT r.is_read)
+`
+	stmt2, err := tx.PrepareContext(ctx, sql2)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt2.Close()
+
+	sql3 := `INSERT OR IGNORE INTO entry_tombstones(feed_id, external_id) VALUES (?, ?)`
+	stmt3, err := tx.PrepareContext(ctx, sql3)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt3.Close()
+
+	sql4 := `DELETE FROM entries WHERE id = ?`
+	stmt4, err := tx.PrepareContext(ctx, sql4)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt4.Close()
+
+	type prunedEntry struct {
+		id    ID
+		extID string
+	}
+
+	pruneFunc := func(rec *pruneRecord, policy *entity.RetentionPolicy) error {
+		var cutoff *time.Time
+		if policy.MaxAge != nil {
+			cutoff = pointer(now.Add(-*policy.MaxAge).UTC())
+		}
+		rows, err := stmt2.QueryContext(
+			ctx,
+			rec.feedID,
+			cutoff,
+			policy.MaxEntries,
+			policy.KeepBookmarked,
+			policy.KeepUnread,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		pruned := make([]prunedEntry, 0)
+		for rows.Next() {
+			var entry prunedEntry
+			if err := rows.Scan(&entry.id, &entry.extID); err != nil {
+				return err
+			}
+			pruned = append(pruned, entry)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		rec.numPruned = uint32(len(pruned))
+		rec.numKept -= rec.numPruned
+		if dryRun {
+			return nil
+		}
+
+		for _, entry := range pruned {
+			if _, err := stmt3.ExecContext(ctx, rec.feedID, entry.extID); err != nil {
+				return err
+			}
+			if _, err := stmt4.ExecContext(ctx, entry.id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, rec := range recs {
+		policy, exists := policies[rec.feedID]
+		if !exists {
+			policy = global
+		}
+		if policy == nil {
+			continue
+		}
+		if err := pruneFunc(rec, policy); err != nil {
+			return nil, err
+		}
+	}
+
+	return recs, nil
+}
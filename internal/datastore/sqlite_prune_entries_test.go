@@ -0,0 +1,197 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestPruneEntriesOkNoPolicies(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupPruneFixture(t)
+
+	results, err := db.PruneEntries(context.Background(), nil, false)
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.PruneResult{
+			{FeedID: keys["Feed A"].ID, FeedTitle: "Feed A", NumPruned: 0, NumKept: 4},
+			{FeedID: keys["Feed B"].ID, FeedTitle: "Feed B", NumPruned: 0, NumKept: 4},
+		},
+		results,
+	)
+	a.Equal(8, db.countTableRows("entries"))
+}
+
+func TestPruneEntriesOkPolicies(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupPruneFixture(t)
+
+	r.NoError(db.SetRetentionPolicy(
+		context.Background(),
+		&entity.RetentionPolicy{
+			MaxAge:         pointer(30 * 24 * time.Hour),
+			KeepBookmarked: true,
+			KeepUnread:     true,
+		},
+	))
+	r.NoError(db.SetRetentionPolicy(
+		context.Background(),
+		&entity.RetentionPolicy{FeedID: pointer(keys["Feed B"].ID), MaxEntries: pointer(uint32(2))},
+	))
+
+	results, err := db.PruneEntries(context.Background(), nil, false)
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.PruneResult{
+			{FeedID: keys["Feed A"].ID, FeedTitle: "Feed A", NumPruned: 1, NumKept: 3},
+			{FeedID: keys["Feed B"].ID, FeedTitle: "Feed B", NumPruned: 2, NumKept: 2},
+		},
+		results,
+	)
+	a.Equal([]string{"A2", "A3", "A4"}, db.getEntryExtIDs(keys["Feed A"].ID))
+	a.Equal([]string{"B3", "B4"}, db.getEntryExtIDs(keys["Feed B"].ID))
+}
+
+func TestPruneEntriesOkSelectedDryRun(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupPruneFixture(t)
+
+	r.NoError(db.SetRetentionPolicy(
+		context.Background(),
+		&entity.RetentionPolicy{MaxAge: pointer(7 * 24 * time.Hour)},
+	))
+
+	results, err := db.PruneEntries(context.Background(), []ID{keys["Feed B"].ID}, true)
+	r.NoError(err)
+
+	a.Equal(
+		[]*entity.PruneResult{
+			{FeedID: keys["Feed B"].ID, FeedTitle: "Feed B", NumPruned: 1, NumKept: 3},
+		},
+		results,
+	)
+	a.Equal(8, db.countTableRows("entries"))
+	a.False(db.rowExists(`SELECT 1 FROM entry_tombstones`))
+}
+
+func TestPruneEntriesErrFeedNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db, _ := setupPruneFixture(t)
+
+	results, err := db.PruneEntries(context.Background(), []ID{404}, false)
+	a.Nil(results)
+	a.EqualError(err, "SQLite.PruneEntries: feed with ID=404 not found")
+}
+
+func TestPruneEntriesOkNotAddedAgain(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupPruneFixture(t)
+	feedID := keys["Feed B"].ID
+
+	r.NoError(db.SetRetentionPolicy(
+		context.Background(),
+		&entity.RetentionPolicy{FeedID: &feedID, MaxEntries: pointer(uint32(3))},
+	))
+	_, err := db.PruneEntries(context.Background(), nil, false)
+	r.NoError(err)
+	a.Equal([]string{"B2", "B3", "B4"}, db.getEntryExtIDs(feedID))
+
+	// A pull that still contains the pruned entry must not add it again.
+	db.upsertEntries(feedID, []*gofeed.Item{
+		{GUID: "B1", Title: "Entry B1", Link: "https://b.com/b1.html"},
+		{GUID: "B4", Title: "Entry B4", Link: "https://b.com/b4.html"},
+	})
+	a.Equal([]string{"B2", "B3", "B4"}, db.getEntryExtIDs(feedID))
+
+	// Once the feed no longer contains the entry, it may be added again.
+	db.upsertEntries(feedID, []*gofeed.Item{{GUID: "B4", Title: "Entry B4", Link: "https://b.com/b4.html"}})
+	a.False(db.rowExists(`SELECT 1 FROM entry_tombstones`))
+	db.upsertEntries(feedID, []*gofeed.Item{{GUID: "B1", Title: "Entry B1", Link: "https://b.com/b1.html"}})
+	a.Equal([]string{"B1", "B2", "B3", "B4"}, db.getEntryExtIDs(feedID))
+}
+
+func (db *testSQLiteDB) getEntryExtIDs(feedID ID) []string {
+	db.t.Helper()
+
+	rows, err := db.handle.Query(
+		`SELECT external_id FROM entries WHERE feed_id = ? ORDER BY external_id`,
+		feedID,
+	)
+	require.NoError(db.t, err)
+	defer rows.Close()
+
+	extIDs := make([]string, 0)
+	for rows.Next() {
+		var extID string
+		require.NoError(db.t, rows.Scan(&extID))
+		extIDs = append(extIDs, extID)
+	}
+	require.NoError(db.t, rows.Err())
+
+	return extIDs
+}
+
+func setupPruneFixture(t *testing.T) (testSQLiteDB, map[string]feedKey) {
+	t.Helper()
+
+	db := newTestSQLiteDB(t)
+	daysAgo := func(n int) sql.NullTime {
+		return toNullTime(time.Now().UTC().Add(-time.Duration(n) * 24 * time.Hour))
+	}
+	keys := db.addFeeds([]*feedRecord{
+		{
+			title:   "Feed A",
+			feedURL: "https://a.com/feed.xml",
+			entries: []*entryRecord{
+				{title: "Entry A1", extID: "A1", isRead: true, updated: daysAgo(40)},
+				{title: "Entry A2", extID: "A2", isRead: false, updated: daysAgo(35)},
+				{
+					title:        "Entry A3",
+					extID:        "A3",
+					isRead:       true,
+					isBookmarked: true,
+					updated:      daysAgo(32),
+				},
+				{title: "Entry A4", extID: "A4", isRead: true, updated: daysAgo(1)},
+			},
+		},
+		{
+			title:   "Feed B",
+			feedURL: "https://b.com/feed.xml",
+			entries: []*entryRecord{
+				{title: "Entry B1", extID: "B1", isRead: true, updated: daysAgo(10)},
+				{title: "Entry B2", extID: "B2", isRead: true, updated: daysAgo(5)},
+				{title: "Entry B3", extID: "B3", isRead: true, updated: daysAgo(2)},
+				{title: "Entry B4", extID: "B4", isRead: false, updated: daysAgo(1)},
+			},
+		},
+	})
+
+	return db, keys
+}
@@ -6,26 +6,47 @@ package datastore
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"net/url"
 	"sync"
 	"time"
 
+	"github.com/mmcdole/gofeed"
+
 	"github.com/bow/neon/internal/chanutil"
 	"github.com/bow/neon/internal/entity"
 	"github.com/bow/neon/internal/sliceutil"
 )
 
+const (
+	// pullBackoffBase is how long a feed is skipped after its first failed pull. The period
+	// doubles with each consecutive failure, up to pullBackoffMax.
+	pullBackoffBase = 5 * time.Minute
+
+	// pullBackoffMax is the longest period a failing feed is skipped.
+	pullBackoffMax = 24 * time.Hour
+)
+
+// PullFeeds pulls the feeds with the given IDs, or all feeds if no IDs are given. Feeds that are
+// backing off after failed pulls are skipped, unless force is true.
 func (db *SQLite) PullFeeds(
 	ctx context.Context,
 	ids []entity.ID,
 	entryReadStatus *bool,
 	maxEntriesPerFeed *uint32,
 	timeoutPerFeed *time.Duration,
+	force bool,
 ) <-chan entity.PullResult {
 
 	var (
 		fail = failF("SQLite.PullFeeds")
 		c    = make(chan entity.PullResult)
 		wg   sync.WaitGroup
+
+		extractor, canExtract = db.parser.(ContentExtractor)
+		// withContent are the results of the feeds whose entry content is fetched after the
+		// pull transaction is committed.
+		withContent []entity.PullResult
 	)
 
 	// nolint: unparam
@@ -50,8 +71,17 @@ func (db *SQLite) PullFeeds(
 			return nil
 		}
 
-		chs := make([]<-chan entity.PullResult, len(pks))
-		for i, pk := range pks {
+		var (
+			now      = time.Now().UTC()
+			chs      = make([]<-chan entity.PullResult, 0, len(pks))
+			pksByURL = make(map[string]pullKey, len(pks))
+		)
+		for _, pk := range pks {
+			if !force && pk.isBackingOff(now) {
+				c <- pk.skipped()
+				continue
+			}
+			pksByURL[pk.feedURL] = pk
 			var (
 				pctx   = ctx
 				cancel context.CancelFunc
@@ -60,20 +90,31 @@ func (db *SQLite) PullFeeds(
 				pctx, cancel = context.WithTimeout(ctx, *tpf)
 				defer cancel() // nolint: revive
 			}
-			chs[i] = pullFeedEntries(
+			chs = append(chs, pullFeedEntries(
 				pctx,
 				tx,
 				pk,
 				db.parser,
 				entryReadStatus,
 				maxEntriesPerFeed,
-			)
+			))
 		}
 
 		for pr := range chanutil.Merge(chs) {
 			pr := pr
 			if e := pr.Error(); e != nil {
+				// Failures are recorded with the parent context, since the context of the pull
+				// itself may have expired.
+				if pk, exists := pksByURL[pr.URL()]; exists && ctx.Err() == nil {
+					if rerr := setFeedPullFailure(ctx, tx, pk, e, now); rerr != nil {
+						e = errors.Join(e, rerr)
+					}
+				}
 				pr.SetError(fail(e))
+			} else if pk := pksByURL[pr.URL()]; canExtract && pk.fetchContent &&
+				len(pr.ChangedEntries()) > 0 {
+				withContent = append(withContent, pr)
+				continue
 			}
 			c <- pr
 		}
@@ -89,31 +130,69 @@ func (db *SQLite) PullFeeds(
 		wg.Add(1)
 
 		db.mu.Lock()
-		defer db.mu.Unlock()
-
 		err := db.withTx(ctx, dbFunc)
+		db.mu.Unlock()
 		if err != nil {
 			c <- entity.NewPullResultFromError(nil, fail(err))
 		}
+
+		for _, pr := range withContent {
+			if err == nil {
+				db.fetchPulledContent(ctx, extractor, &pr, timeoutPerFeed)
+			}
+			c <- pr
+		}
 	}()
 
 	return c
 }
 
 type pullKey struct {
-	feedID  ID
-	feedURL string
+	feedID       ID
+	feedURL      string
+	etag         sql.NullString
+	lastModified sql.NullString
+	fetchContent bool
+	pullFailures uint32
+	nextPull     sql.NullTime
+}
+
+// isBackingOff returns whether the feed is not to be pulled yet at the given time, because of
+// earlier failed pulls.
+func (pk pullKey) isBackingOff(now time.Time) bool {
+	return pk.nextPull.Valid && pk.nextPull.Time.After(now)
+}
+
+func (pk pullKey) httpCache() *HTTPCache {
+	return &HTTPCache{
+		ETag:         fromNullString(pk.etag),
+		LastModified: fromNullString(pk.lastModified),
+	}
 }
 
 func (pk pullKey) ok(feed *entity.Feed) entity.PullResult {
 	pr := entity.NewPullResultFromFeed(&pk.feedURL, feed)
 	pr.SetStatus(entity.PullSuccess)
+	pr.SetFeedID(pk.feedID)
+	return pr
+}
+
+func (pk pullKey) notModified() entity.PullResult {
+	pr := entity.NewPullResultNotModified(&pk.feedURL)
+	pr.SetFeedID(pk.feedID)
+	return pr
+}
+
+func (pk pullKey) skipped() entity.PullResult {
+	pr := entity.NewPullResultSkipped(&pk.feedURL)
+	pr.SetFeedID(pk.feedID)
 	return pr
 }
 
 func (pk pullKey) err(e error) entity.PullResult {
 	pr := entity.NewPullResultFromError(&pk.feedURL, e)
 	pr.SetStatus(entity.PullFail)
+	pr.SetFeedID(pk.feedID)
 	return pr
 }
 
@@ -122,10 +201,325 @@ var (
 	setFeedLastPullTime = tableFieldSetter[time.Time](feedsTable, "last_pull_time")
 )
 
+// pullBackoff returns how long a feed is skipped after the given number of consecutive failed
+// pulls.
+func pullBackoff(failures uint32) time.Duration {
+	backoff := pullBackoffBase
+	for i := uint32(1); i < failures && backoff < pullBackoffMax; i++ {
+		backoff *= 2
+	}
+	return min(backoff, pullBackoffMax)
+}
+
+// setFeedPullFailure records a failed pull of the given feed, and schedules its next pull
+// according to the number of consecutive failures.
+func setFeedPullFailure(
+	ctx context.Context,
+	tx *sql.Tx,
+	pk pullKey,
+	pullErr error,
+	now time.Time,
+) error {
+	sql1 := `
+		UPDATE
+			feeds
+		SET
+			pull_failures = $2
+			, last_pull_error = $3
+			, next_pull_time = $4
+		WHERE
+			id = $1
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return err
+	}
+	defer stmt1.Close()
+
+	failures := pk.pullFailures + 1
+	nextPull := now.Add(pullBackoff(failures))
+	_, err = stmt1.ExecContext(ctx, pk.feedID, failures, pullErr.Error(), nextPull)
+
+	return err
+}
+
+// resetFeedPullFailures clears the failed pull records of the given feed.
+func resetFeedPullFailures(ctx context.Context, tx *sql.Tx, feedID ID) error {
+	sql1 := `
+		UPDATE
+			feeds
+		SET
+			pull_failures = 0
+			, last_pull_error = NULL
+			, next_pull_time = NULL
+		WHERE
+			id = $1
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return err
+	}
+	defer stmt1.Close()
+
+	_, err = stmt1.ExecContext(ctx, feedID)
+
+	return err
+}
+
+// setFeedHTTPCache stores the HTTP caching metadata of the latest fetch of the given feed. On
+// failed fetches, only the status is updated so that validators of earlier fetches are kept.
+func setFeedHTTPCache(ctx context.Context, tx *sql.Tx, feedID ID, meta *HTTPCache) error {
+	sql1 := `
+		UPDATE
+			feeds
+		SET
+			etag = $2
+			, last_modified = $3
+			, last_http_status = $4
+		WHERE
+			id = $1
+`
+	sql2 := `UPDATE feeds SET last_http_status = $2 WHERE id = $1`
+
+	args := []any{feedID}
+	stmtSQL := sql2
+	if status := meta.Status; status != nil && *status >= 200 && *status < 400 {
+		stmtSQL = sql1
+		args = append(args, meta.ETag, meta.LastModified)
+	}
+	args = append(args, meta.Status)
+
+	stmt, err := tx.PrepareContext(ctx, stmtSQL)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, args...)
+
+	return err
+}
+
+// movedFeedURL returns the URL the feed fetched from the given URL was permanently redirected to.
+// It returns an empty string if the feed was not permanently redirected.
+func movedFeedURL(feedURL string, meta *HTTPCache) string {
+	if meta != nil && meta.MovedTo != nil && *meta.MovedTo != feedURL {
+		return *meta.MovedTo
+	}
+	return ""
+}
+
+// selfLinkURL returns the self link of the given feed if it differs from the URL the feed was
+// fetched from. It returns an empty string if the feed has no such self link.
+func selfLinkURL(feedURL string, feed *gofeed.Feed) string {
+	if feed == nil || feed.FeedLink == "" || feed.FeedLink == feedURL {
+		return ""
+	}
+	// Relative or otherwise malformed self links are ignored.
+	if u, err := url.Parse(feed.FeedLink); err != nil ||
+		(u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+	return feed.FeedLink
+}
+
+// servesFeed returns whether the given URL serves a feed without redirecting. Self links are
+// often left stale after a feed moves, so feeds are only moved to self links that pass this check.
+// Redirects can only be detected by a ConditionalParser; other parsers only need to parse the feed.
+func servesFeed(ctx context.Context, parser Parser, feedURL string) bool {
+	cp, ok := parser.(ConditionalParser)
+	if !ok {
+		_, err := parser.ParseURLWithContext(feedURL, ctx)
+		return err == nil
+	}
+	feed, meta, err := cp.ParseURLConditionallyWithContext(feedURL, nil, ctx)
+	return err == nil && feed != nil && meta != nil && !meta.Redirected
+}
+
+// selfLinkMove returns the self link of the given feed if the feed has moved there, or an empty
+// string otherwise. Self links that are former URLs of the feed are never moved to, so that a
+// feed whose old URL redirects back to the current one does not move back and forth.
+func selfLinkMove(
+	ctx context.Context,
+	tx *sql.Tx,
+	parser Parser,
+	feedID ID,
+	feedURL string,
+	feed *gofeed.Feed,
+) (string, error) {
+	link := selfLinkURL(feedURL, feed)
+	if link == "" {
+		return "", nil
+	}
+
+	var exists bool
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM feed_url_history WHERE feed_id = $1 AND url = $2)`,
+		feedID,
+		link,
+	).Scan(&exists)
+	if err != nil || exists {
+		return "", err
+	}
+
+	if !servesFeed(ctx, parser, link) {
+		return "", nil
+	}
+	return link, nil
+}
+
+// moveFeed changes the URL of the given feed to the given new URL, and records the old URL in the
+// URL history of the feed. If another feed already has the new URL, the given feed is merged into
+// it. The ID of the feed with the new URL is returned.
+func moveFeed(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	oldURL string,
+	newURL string,
+	moveTime time.Time,
+) (ID, error) {
+
+	var targetID ID
+	err := tx.QueryRowContext(ctx, `SELECT id FROM feeds WHERE feed_url = ?`, newURL).
+		Scan(&targetID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		targetID = feedID
+		if _, err = tx.ExecContext(
+			ctx,
+			`UPDATE feeds SET feed_url = $2 WHERE id = $1`,
+			feedID,
+			newURL,
+		); err != nil {
+			return 0, err
+		}
+	case err != nil:
+		return 0, err
+	case targetID != feedID:
+		if err = mergeFeeds(ctx, tx, feedID, targetID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = addFeedURLHistory(ctx, tx, targetID, oldURL, newURL, moveTime); err != nil {
+		return 0, err
+	}
+
+	pkgLogger.Info().
+		Uint32("feed_id", targetID).
+		Str("old_url", oldURL).
+		Str("new_url", newURL).
+		Msg("feed moved")
+
+	return targetID, nil
+}
+
+// addFeedURLHistory records that the given feed moved from the old URL to the new URL.
+func addFeedURLHistory(
+	ctx context.Context,
+	tx *sql.Tx,
+	feedID ID,
+	oldURL string,
+	newURL string,
+	moveTime time.Time,
+) error {
+	// The new URL may be a former URL of the feed, when the feed moves back.
+	sql1 := `DELETE FROM feed_url_history WHERE url = $1`
+	if _, err := tx.ExecContext(ctx, sql1, newURL); err != nil {
+		return err
+	}
+
+	sql2 := `
+		INSERT OR REPLACE INTO
+			feed_url_history(
+				url
+				, feed_id
+				, move_time
+			)
+			VALUES ($1, $2, $3)
+`
+	_, err := tx.ExecContext(ctx, sql2, oldURL, feedID, moveTime)
+
+	return err
+}
+
+// getMovedFeedURL returns the current URL of the feed that has moved from the given URL, or an
+// empty string if no feed has moved from it.
+func getMovedFeedURL(ctx context.Context, tx *sql.Tx, oldURL string) (string, error) {
+	sql1 := `
+		SELECT
+			f.feed_url
+		FROM
+			feed_url_history h
+			INNER JOIN feeds f ON h.feed_id = f.id
+		WHERE
+			h.url = $1
+`
+	var feedURL string
+	err := tx.QueryRowContext(ctx, sql1, oldURL).Scan(&feedURL)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return feedURL, err
+}
+
+// mergeFeeds moves the entries, tags, and settings of the source feed into the target feed, and
+// removes the source feed afterwards. Entries that the target feed already has are dropped.
+func mergeFeeds(ctx context.Context, tx *sql.Tx, sourceID, targetID ID) error {
+	stmts := []string{
+		`UPDATE OR IGNORE entries SET feed_id = $2 WHERE feed_id = $1`,
+		`UPDATE OR IGNORE entry_tombstones SET feed_id = $2 WHERE feed_id = $1`,
+		`UPDATE OR IGNORE retention_policies SET feed_id = $2 WHERE feed_id = $1`,
+		`UPDATE entry_rules SET feed_id = $2 WHERE feed_id = $1`,
+		`UPDATE feed_url_history SET feed_id = $2 WHERE feed_id = $1`,
+		`
+		INSERT OR IGNORE INTO
+			feeds_x_feed_tags(feed_id, feed_tag_id)
+			SELECT $2, feed_tag_id FROM feeds_x_feed_tags WHERE feed_id = $1
+`,
+		`
+		UPDATE
+			feeds
+		SET
+			is_starred = feeds.is_starred OR s.is_starred
+			, fetch_content = feeds.fetch_content OR s.fetch_content
+			, folder_id = COALESCE(feeds.folder_id, s.folder_id)
+		FROM
+			(SELECT is_starred, fetch_content, folder_id FROM feeds WHERE id = $1) AS s
+		WHERE
+			feeds.id = $2
+`,
+		`DELETE FROM feeds WHERE id = $1`,
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt, sourceID, targetID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getPullKeys(ctx context.Context, tx *sql.Tx, feedIDs []ID) ([]pullKey, error) {
 	// FIXME: Find a cleaner way to check for array membership using database/sql.
 	//        Until then, we just loop through all IDs.
-	stmt1, err := tx.PrepareContext(ctx, `SELECT feed_url FROM feeds WHERE id = ?`)
+	stmt1, err := tx.PrepareContext(
+		ctx,
+		`
+		SELECT
+			feed_url
+			, etag
+			, last_modified
+			, fetch_content
+			, pull_failures
+			, next_pull_time
+		FROM
+			feeds
+		WHERE
+			id = ?
+`,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +527,16 @@ func getPullKeys(ctx context.Context, tx *sql.Tx, feedIDs []ID) ([]pullKey, erro
 	pks := make([]pullKey, len(feedIDs))
 	for i, id := range feedIDs {
 		pk := pullKey{feedID: id}
-		if err := stmt1.QueryRowContext(ctx, pk.feedID).Scan(&pk.feedURL); err != nil {
+		err := stmt1.QueryRowContext(ctx, pk.feedID).
+			Scan(
+				&pk.feedURL,
+				&pk.etag,
+				&pk.lastModified,
+				&pk.fetchContent,
+				&pk.pullFailures,
+				&pk.nextPull,
+			)
+		if err != nil {
 			return nil, err
 		}
 		pks[i] = pk
@@ -144,11 +547,30 @@ func getPullKeys(ctx context.Context, tx *sql.Tx, feedIDs []ID) ([]pullKey, erro
 
 func getAllPullKeys(ctx context.Context, tx *sql.Tx) ([]pullKey, error) {
 
-	sql1 := `SELECT id, feed_url FROM feeds`
+	sql1 := `
+		SELECT
+			id
+			, feed_url
+			, etag
+			, last_modified
+			, fetch_content
+			, pull_failures
+			, next_pull_time
+		FROM
+			feeds
+`
 
 	scanRow := func(rows *sql.Rows) (pullKey, error) {
 		var pk pullKey
-		err := rows.Scan(&pk.feedID, &pk.feedURL)
+		err := rows.Scan(
+			&pk.feedID,
+			&pk.feedURL,
+			&pk.etag,
+			&pk.lastModified,
+			&pk.fetchContent,
+			&pk.pullFailures,
+			&pk.nextPull,
+		)
 		return pk, err
 	}
 
@@ -183,19 +605,70 @@ func pullFeedEntries(
 	maxEntriesPerFeed *uint32,
 ) chan entity.PullResult {
 
-	pullTime := time.Now().UTC()
+	var (
+		start    = time.Now()
+		pullTime = start.UTC()
+		movedTo  string
+	)
 	pullf := func() entity.PullResult {
 
-		gfeed, err := parser.ParseURLWithContext(pk.feedURL, ctx)
+		var (
+			feedID = pk.feedID
+			gfeed  *gofeed.Feed
+			meta   *HTTPCache
+			err    error
+		)
+		if cp, ok := parser.(ConditionalParser); ok {
+			gfeed, meta, err = cp.ParseURLConditionallyWithContext(
+				pk.feedURL,
+				pk.httpCache(),
+				ctx,
+			)
+			if meta != nil {
+				if merr := setFeedHTTPCache(ctx, tx, feedID, meta); merr != nil {
+					return pk.err(merr)
+				}
+			}
+		} else {
+			gfeed, err = parser.ParseURLWithContext(pk.feedURL, ctx)
+		}
 		if err != nil {
 			return pk.err(err)
 		}
 
+		newURL := movedFeedURL(pk.feedURL, meta)
+		if newURL == "" {
+			newURL, err = selfLinkMove(ctx, tx, parser, feedID, pk.feedURL, gfeed)
+			if err != nil {
+				return pk.err(err)
+			}
+		}
+		if newURL != "" {
+			feedID, err = moveFeed(ctx, tx, feedID, pk.feedURL, newURL, pullTime)
+			if err != nil {
+				return pk.err(err)
+			}
+			movedTo = newURL
+		}
+
+		if pk.pullFailures > 0 {
+			if err = resetFeedPullFailures(ctx, tx, feedID); err != nil {
+				return pk.err(err)
+			}
+		}
+
+		if meta.NotModified() {
+			if err = setFeedLastPullTime(ctx, tx, feedID, &pullTime); err != nil {
+				return pk.err(err)
+			}
+			return pk.notModified()
+		}
+
 		updateTime := resolveFeedUpdateTime(gfeed)
-		if err = setFeedUpdateTime(ctx, tx, pk.feedID, updateTime); err != nil {
+		if err = setFeedUpdateTime(ctx, tx, feedID, updateTime); err != nil {
 			return pk.err(err)
 		}
-		if err = setFeedLastPullTime(ctx, tx, pk.feedID, &pullTime); err != nil {
+		if err = setFeedLastPullTime(ctx, tx, feedID, &pullTime); err != nil {
 			return pk.err(err)
 		}
 
@@ -203,14 +676,19 @@ func pullFeedEntries(
 			return pk.ok(nil)
 		}
 
-		if err = upsertEntries(ctx, tx, pk.feedID, gfeed.Items); err != nil {
+		changedIDs, err := upsertEntries(ctx, tx, feedID, gfeed.Items)
+		if err != nil {
+			return pk.err(err)
+		}
+		changed, err := getEntriesByIDs(ctx, tx, changedIDs)
+		if err != nil {
 			return pk.err(err)
 		}
 
 		entries, err := getEntries(
 			ctx,
 			tx,
-			[]ID{pk.feedID},
+			[]ID{feedID},
 			maxEntriesPerFeed,
 			entryReadStatus,
 			nil,
@@ -218,35 +696,44 @@ func pullFeedEntries(
 		if err != nil {
 			return pk.err(err)
 		}
-		if len(entries) == 0 && maxEntriesPerFeed == nil {
+		if len(entries) == 0 && len(changed) == 0 && maxEntriesPerFeed == nil {
 			return pk.ok(nil)
 		}
 
-		rec, err := getFeed(ctx, tx, pk.feedID)
+		rec, err := getFeed(ctx, tx, feedID)
 		if err != nil {
 			return pk.err(err)
 		}
 
 		rec.entries = entries
 
-		return pk.ok(rec.feed())
+		pr := pk.ok(rec.feed())
+		pr.SetChangedEntries(entryRecords(changed).entriesSlice())
+
+		return pr
 	}
 
 	ic := make(chan entity.PullResult)
 	go func() {
 		defer close(ic)
-		ic <- pullf()
+		pr := pullf()
+		if movedTo != "" {
+			pr.SetNewURL(movedTo)
+		}
+		ic <- pr
 	}()
 
 	oc := make(chan entity.PullResult)
 	go func() {
 		defer close(oc)
+		var msg entity.PullResult
 		select {
 		case <-ctx.Done():
-			oc <- pk.err(ctx.Err())
-		case msg := <-ic:
-			oc <- msg
+			msg = pk.err(ctx.Err())
+		case msg = <-ic:
 		}
+		msg.SetDuration(time.Since(start))
+		oc <- msg
 	}()
 
 	return oc
@@ -5,6 +5,9 @@ package datastore
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -29,7 +32,7 @@ func TestPullFeedsAllOkEmptyDB(t *testing.T) {
 		ParseURLWithContext(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
-	c := db.PullFeeds(context.Background(), nil, nil, nil, nil)
+	c := db.PullFeeds(context.Background(), nil, nil, nil, nil, false)
 	a.Empty(c)
 }
 
@@ -55,7 +58,7 @@ func TestPullFeedsAllOkEmptyEntries(t *testing.T) {
 		},
 	}
 
-	db.addFeeds(dbFeeds)
+	keys := db.addFeeds(dbFeeds)
 	r.Equal(2, db.countFeeds())
 
 	db.parser.EXPECT().
@@ -68,7 +71,7 @@ func TestPullFeedsAllOkEmptyEntries(t *testing.T) {
 		MaxTimes(1).
 		Return(toGFeed(t, dbFeeds[1]), nil)
 
-	c := db.PullFeeds(context.Background(), nil, nil, nil, nil)
+	c := db.PullFeeds(context.Background(), nil, nil, nil, nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -76,16 +79,19 @@ func TestPullFeedsAllOkEmptyEntries(t *testing.T) {
 	}
 
 	want := []entity.PullResult{
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[dbFeeds[0].title].ID,
 			&dbFeeds[0].feedURL,
 			nil,
 		),
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[dbFeeds[1].title].ID,
 			&dbFeeds[1].feedURL,
 			nil,
 		),
 	}
 
+	clearDurations(got)
 	a.ElementsMatch(want, got)
 }
 
@@ -134,7 +140,7 @@ func TestPullFeedsAllOkNoNewEntries(t *testing.T) {
 		},
 	}
 
-	db.addFeeds(dbFeeds)
+	keys := db.addFeeds(dbFeeds)
 	r.Equal(2, db.countFeeds())
 
 	pulledFeeds := []*feedRecord{
@@ -182,7 +188,7 @@ func TestPullFeedsAllOkNoNewEntries(t *testing.T) {
 		MaxTimes(1).
 		Return(toGFeed(t, pulledFeeds[1]), nil)
 
-	c := db.PullFeeds(context.Background(), nil, pointer(false), nil, nil)
+	c := db.PullFeeds(context.Background(), nil, pointer(false), nil, nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -190,16 +196,19 @@ func TestPullFeedsAllOkNoNewEntries(t *testing.T) {
 	}
 
 	want := []entity.PullResult{
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[pulledFeeds[0].title].ID,
 			&pulledFeeds[0].feedURL,
 			nil,
 		),
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[pulledFeeds[1].title].ID,
 			&pulledFeeds[1].feedURL,
 			nil,
 		),
 	}
 
+	clearDurations(got)
 	a.ElementsMatch(want, got)
 }
 
@@ -208,7 +217,7 @@ func TestPullFeedsAllOkSomeNewEntriesAll(t *testing.T) {
 	a := assert.New(t)
 	db, dbFeeds, keys, pulledFeeds := setupComplexDBFixture(t)
 
-	c := db.PullFeeds(context.Background(), nil, nil, nil, nil)
+	c := db.PullFeeds(context.Background(), nil, nil, nil, nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -219,7 +228,8 @@ func TestPullFeedsAllOkSomeNewEntriesAll(t *testing.T) {
 	feedURL1 := pulledFeeds[1].feedURL
 
 	want := []entity.PullResult{
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[dbFeeds[0].title].ID,
 			&dbFeeds[0].feedURL,
 			&entity.Feed{
 				ID:         keys[pulledFeeds[0].title].ID,
@@ -237,6 +247,8 @@ func TestPullFeedsAllOkSomeNewEntriesAll(t *testing.T) {
 						Updated:   db.getEntryUpdateTime(feedURL0, pulledFeeds[0].entries[0].extID),
 						Published: db.getEntryPubTime(feedURL0, pulledFeeds[0].entries[0].extID),
 						URL:       fromNullString(pulledFeeds[0].entries[0].url),
+						Authors:   []string{},
+						Tags:      []string{},
 						IsRead:    true,
 					},
 					db.getEntryID(feedURL0, pulledFeeds[0].entries[1].extID): {
@@ -247,6 +259,8 @@ func TestPullFeedsAllOkSomeNewEntriesAll(t *testing.T) {
 						Updated:   db.getEntryUpdateTime(feedURL0, pulledFeeds[0].entries[1].extID),
 						Published: db.getEntryPubTime(feedURL0, pulledFeeds[0].entries[1].extID),
 						URL:       fromNullString(pulledFeeds[0].entries[1].url),
+						Authors:   []string{},
+						Tags:      []string{},
 						IsRead:    false,
 					},
 					db.getEntryID(feedURL0, pulledFeeds[0].entries[2].extID): {
@@ -257,12 +271,15 @@ func TestPullFeedsAllOkSomeNewEntriesAll(t *testing.T) {
 						Updated:   db.getEntryUpdateTime(feedURL0, pulledFeeds[0].entries[2].extID),
 						Published: db.getEntryPubTime(feedURL0, pulledFeeds[0].entries[2].extID),
 						URL:       fromNullString(pulledFeeds[0].entries[2].url),
+						Authors:   []string{},
+						Tags:      []string{},
 						IsRead:    false,
 					},
 				},
 			},
 		),
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[dbFeeds[1].title].ID,
 			&dbFeeds[1].feedURL,
 			&entity.Feed{
 				ID:         keys[pulledFeeds[1].title].ID,
@@ -280,6 +297,8 @@ func TestPullFeedsAllOkSomeNewEntriesAll(t *testing.T) {
 						Updated:   db.getEntryUpdateTime(feedURL1, pulledFeeds[1].entries[0].extID),
 						Published: db.getEntryPubTime(feedURL1, pulledFeeds[1].entries[0].extID),
 						URL:       fromNullString(pulledFeeds[1].entries[0].url),
+						Authors:   []string{},
+						Tags:      []string{},
 						IsRead:    true,
 					},
 					db.getEntryID(feedURL1, pulledFeeds[1].entries[1].extID): {
@@ -290,6 +309,8 @@ func TestPullFeedsAllOkSomeNewEntriesAll(t *testing.T) {
 						Updated:   db.getEntryUpdateTime(feedURL1, pulledFeeds[1].entries[1].extID),
 						Published: db.getEntryPubTime(feedURL1, pulledFeeds[1].entries[1].extID),
 						URL:       fromNullString(pulledFeeds[1].entries[1].url),
+						Authors:   []string{},
+						Tags:      []string{},
 						IsRead:    false,
 					},
 				},
@@ -302,6 +323,14 @@ func TestPullFeedsAllOkSomeNewEntriesAll(t *testing.T) {
 		item.Feed().LastPulled = time.Time{}
 	}
 
+	a.Equal(
+		map[string][]string{
+			feedURL0: {pulledFeeds[0].entries[2].extID},
+			feedURL1: {pulledFeeds[1].entries[1].extID},
+		},
+		takeChangedEntries(got),
+	)
+	clearDurations(got)
 	a.ElementsMatch(want, got)
 }
 
@@ -310,7 +339,7 @@ func TestPullFeedsAllOkSomeNewEntriesNoneReturned(t *testing.T) {
 	a := assert.New(t)
 	db, dbFeeds, keys, pulledFeeds := setupComplexDBFixture(t)
 
-	c := db.PullFeeds(context.Background(), nil, nil, pointer(uint32(0)), nil)
+	c := db.PullFeeds(context.Background(), nil, nil, pointer(uint32(0)), nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -321,7 +350,8 @@ func TestPullFeedsAllOkSomeNewEntriesNoneReturned(t *testing.T) {
 	feedURL1 := pulledFeeds[1].feedURL
 
 	want := []entity.PullResult{
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[dbFeeds[0].title].ID,
 			&dbFeeds[0].feedURL,
 			&entity.Feed{
 				ID:         keys[pulledFeeds[0].title].ID,
@@ -333,7 +363,8 @@ func TestPullFeedsAllOkSomeNewEntriesNoneReturned(t *testing.T) {
 				Entries:    nil,
 			},
 		),
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[dbFeeds[1].title].ID,
 			&dbFeeds[1].feedURL,
 			&entity.Feed{
 				ID:         keys[pulledFeeds[1].title].ID,
@@ -352,6 +383,14 @@ func TestPullFeedsAllOkSomeNewEntriesNoneReturned(t *testing.T) {
 		item.Feed().LastPulled = time.Time{}
 	}
 
+	a.Equal(
+		map[string][]string{
+			feedURL0: {pulledFeeds[0].entries[2].extID},
+			feedURL1: {pulledFeeds[1].entries[1].extID},
+		},
+		takeChangedEntries(got),
+	)
+	clearDurations(got)
 	a.ElementsMatch(want, got)
 }
 
@@ -360,7 +399,7 @@ func TestPullFeedsAllOkSomeNewEntriesOnlyUnread(t *testing.T) {
 	a := assert.New(t)
 	db, dbFeeds, keys, pulledFeeds := setupComplexDBFixture(t)
 
-	c := db.PullFeeds(context.Background(), nil, pointer(false), nil, nil)
+	c := db.PullFeeds(context.Background(), nil, pointer(false), nil, nil, false)
 
 	got := make([]entity.PullResult, 0)
 	for res := range c {
@@ -371,7 +410,8 @@ func TestPullFeedsAllOkSomeNewEntriesOnlyUnread(t *testing.T) {
 	feedURL1 := pulledFeeds[1].feedURL
 
 	want := []entity.PullResult{
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[dbFeeds[0].title].ID,
 			&dbFeeds[0].feedURL,
 			&entity.Feed{
 				ID:         keys[pulledFeeds[0].title].ID,
@@ -389,6 +429,8 @@ func TestPullFeedsAllOkSomeNewEntriesOnlyUnread(t *testing.T) {
 						Updated:   db.getEntryUpdateTime(feedURL0, pulledFeeds[0].entries[1].extID),
 						Published: db.getEntryPubTime(feedURL0, pulledFeeds[0].entries[1].extID),
 						URL:       fromNullString(pulledFeeds[0].entries[1].url),
+						Authors:   []string{},
+						Tags:      []string{},
 						IsRead:    false,
 					},
 					db.getEntryID(feedURL0, pulledFeeds[0].entries[2].extID): {
@@ -399,12 +441,15 @@ func TestPullFeedsAllOkSomeNewEntriesOnlyUnread(t *testing.T) {
 						Updated:   db.getEntryUpdateTime(feedURL0, pulledFeeds[0].entries[2].extID),
 						Published: db.getEntryPubTime(feedURL0, pulledFeeds[0].entries[2].extID),
 						URL:       fromNullString(pulledFeeds[0].entries[2].url),
+						Authors:   []string{},
+						Tags:      []string{},
 						IsRead:    false,
 					},
 				},
 			},
 		),
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[dbFeeds[1].title].ID,
 			&dbFeeds[1].feedURL,
 			&entity.Feed{
 				ID:         keys[pulledFeeds[1].title].ID,
@@ -422,6 +467,8 @@ func TestPullFeedsAllOkSomeNewEntriesOnlyUnread(t *testing.T) {
 						Updated:   db.getEntryUpdateTime(feedURL1, pulledFeeds[1].entries[1].extID),
 						Published: db.getEntryPubTime(feedURL1, pulledFeeds[1].entries[1].extID),
 						URL:       fromNullString(pulledFeeds[1].entries[1].url),
+						Authors:   []string{},
+						Tags:      []string{},
 						IsRead:    false,
 					},
 				},
@@ -434,6 +481,8 @@ func TestPullFeedsAllOkSomeNewEntriesOnlyUnread(t *testing.T) {
 		item.Feed().LastPulled = time.Time{}
 	}
 
+	takeChangedEntries(got)
+	clearDurations(got)
 	a.ElementsMatch(want, got)
 }
 
@@ -530,6 +579,7 @@ func TestPullFeedsSelectedOkSomeNewEntries(t *testing.T) {
 		pointer(false),
 		nil,
 		nil,
+		false,
 	)
 
 	got := make([]entity.PullResult, 0)
@@ -538,7 +588,8 @@ func TestPullFeedsSelectedOkSomeNewEntries(t *testing.T) {
 	}
 
 	want := []entity.PullResult{
-		entity.NewPullResultFromFeed(
+		newOkPullResult(
+			keys[dbFeeds[1].title].ID,
 			&dbFeeds[1].feedURL,
 			&entity.Feed{
 				ID:         keys[pulledFeed.title].ID,
@@ -556,6 +607,8 @@ func TestPullFeedsSelectedOkSomeNewEntries(t *testing.T) {
 						Updated:   db.getEntryUpdateTime(pulledFeed.feedURL, pulledFeed.entries[1].extID),
 						Published: db.getEntryPubTime(pulledFeed.feedURL, pulledFeed.entries[1].extID),
 						URL:       fromNullString(pulledFeed.entries[1].url),
+						Authors:   []string{},
+						Tags:      []string{},
 						IsRead:    false,
 					},
 				},
@@ -568,6 +621,8 @@ func TestPullFeedsSelectedOkSomeNewEntries(t *testing.T) {
 		item.Feed().LastPulled = time.Time{}
 	}
 
+	takeChangedEntries(got)
+	clearDurations(got)
 	a.ElementsMatch(want, got)
 }
 
@@ -602,6 +657,35 @@ func toGFeed(t *testing.T, feed *feedRecord) *gofeed.Feed {
 	return &gfeed
 }
 
+// takeChangedEntries clears the changed entries of the given pull results, so that the results
+// can be compared with expected results. It returns the external IDs of the cleared entries,
+// keyed by feed URL.
+func takeChangedEntries(prs []entity.PullResult) map[string][]string {
+	changed := make(map[string][]string)
+	for i := range prs {
+		for _, entry := range prs[i].ChangedEntries() {
+			changed[prs[i].URL()] = append(changed[prs[i].URL()], entry.ExtID)
+		}
+		prs[i].SetChangedEntries(nil)
+	}
+	return changed
+}
+
+// newOkPullResult creates the result of a successful pull of the feed with the given ID.
+func newOkPullResult(feedID ID, url *string, feed *entity.Feed) entity.PullResult {
+	pr := entity.NewPullResultFromFeed(url, feed)
+	pr.SetFeedID(feedID)
+	return pr
+}
+
+// clearDurations sets the durations of the given pull results to zero, since they differ between
+// runs.
+func clearDurations(prs []entity.PullResult) {
+	for i := range prs {
+		prs[i].SetDuration(0)
+	}
+}
+
 func setupComplexDBFixture(t *testing.T) (
 	testSQLiteDB,
 	[]*feedRecord,
@@ -728,3 +812,595 @@ func setupComplexDBFixture(t *testing.T) (
 
 	return db, dbFeeds, keys, pulledFeeds
 }
+
+func TestPullFeedsAllOkNotModified(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	const etag = `"v1"`
+	var nfetches, nfull int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		nfetches++
+		if req.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		nfull++
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", "Sat, 19 Oct 2024 09:30:00 GMT")
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer srv.Close()
+
+	db.addFeedWithURL(srv.URL)
+	r.Equal(0, db.countEntries(srv.URL))
+
+	pull := func() []entity.PullResult {
+		got := make([]entity.PullResult, 0)
+		for pr := range db.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+			got = append(got, pr)
+		}
+		return got
+	}
+
+	got := pull()
+	r.Len(got, 1)
+	r.NoError(got[0].Error())
+	a.Equal(entity.PullSuccess, got[0].Status())
+	a.Equal(2, db.countEntries(srv.URL))
+	a.Equal(
+		&HTTPCache{
+			ETag:         pointer(etag),
+			LastModified: pointer("Sat, 19 Oct 2024 09:30:00 GMT"),
+			Status:       pointer(http.StatusOK),
+		},
+		db.getFeedHTTPCache(srv.URL),
+	)
+
+	got = pull()
+	r.Len(got, 1)
+	r.NoError(got[0].Error())
+	a.Equal(entity.PullNotModified, got[0].Status())
+	a.Nil(got[0].Feed())
+	a.Equal(srv.URL, got[0].URL())
+	a.Equal(2, db.countEntries(srv.URL))
+	a.Equal(
+		&HTTPCache{
+			ETag:         pointer(etag),
+			LastModified: pointer("Sat, 19 Oct 2024 09:30:00 GMT"),
+			Status:       pointer(http.StatusNotModified),
+		},
+		db.getFeedHTTPCache(srv.URL),
+	)
+
+	a.Equal(2, nfetches)
+	a.Equal(1, nfull)
+}
+
+func TestPullFeedsAllOkHTTPError(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	db.addFeedWithURL(srv.URL)
+
+	got := make([]entity.PullResult, 0)
+	for pr := range db.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+		got = append(got, pr)
+	}
+	r.Len(got, 1)
+	a.Equal(entity.PullFail, got[0].Status())
+	a.ErrorContains(got[0].Error(), "503")
+	a.Equal(
+		&HTTPCache{Status: pointer(http.StatusServiceUnavailable)},
+		db.getFeedHTTPCache(srv.URL),
+	)
+}
+
+func TestPullFeedsAllOkBackoff(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	var (
+		nfetches int
+		healthy  bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		nfetches++
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer srv.Close()
+
+	db.addFeedWithURL(srv.URL)
+
+	pull := func(force bool) entity.PullResult {
+		got := make([]entity.PullResult, 0)
+		for pr := range db.PullFeeds(context.Background(), nil, nil, nil, nil, force) {
+			got = append(got, pr)
+		}
+		r.Len(got, 1)
+		return got[0]
+	}
+
+	// First failure.
+	start := time.Now().UTC()
+	pr := pull(false)
+	a.Equal(entity.PullFail, pr.Status())
+	a.Equal(ID(1), pr.FeedID())
+	a.Positive(pr.Duration())
+	failures, pullErr, nextPull := db.getFeedPullHealth(srv.URL)
+	a.Equal(uint32(1), failures)
+	r.NotNil(pullErr)
+	a.Contains(*pullErr, "503")
+	r.NotNil(nextPull)
+	a.WithinDuration(start.Add(pullBackoffBase), *nextPull, 5*time.Second)
+
+	// Feed is backing off, so it is not pulled.
+	pr = pull(false)
+	a.Equal(entity.PullSkipped, pr.Status())
+	a.Equal(srv.URL, pr.URL())
+	a.Equal(ID(1), pr.FeedID())
+	a.Zero(pr.Duration())
+	a.NoError(pr.Error())
+	a.Equal(1, nfetches)
+
+	// Forced pulls ignore backoff, and extend it on failure.
+	pr = pull(true)
+	a.Equal(entity.PullFail, pr.Status())
+	a.Equal(2, nfetches)
+	failures, _, nextPull = db.getFeedPullHealth(srv.URL)
+	a.Equal(uint32(2), failures)
+	r.NotNil(nextPull)
+	a.WithinDuration(start.Add(2*pullBackoffBase), *nextPull, 5*time.Second)
+
+	// Successful pulls clear the failure records.
+	healthy = true
+	pr = pull(true)
+	a.Equal(entity.PullSuccess, pr.Status())
+	r.NoError(pr.Error())
+	r.NotNil(pr.Feed())
+	a.Equal(pr.Feed().ID, pr.FeedID())
+	a.False(pr.Feed().IsFailing())
+	failures, pullErr, nextPull = db.getFeedPullHealth(srv.URL)
+	a.Equal(uint32(0), failures)
+	a.Nil(pullErr)
+	a.Nil(nextPull)
+}
+
+func TestPullFeedsAllOkMovedPermanently(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	db, srvURL, pr := pullTestMovedFeed(t, func(_ string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path == "/old.xml" {
+				http.Redirect(w, req, "/new.xml", http.StatusMovedPermanently)
+				return
+			}
+			_, _ = w.Write([]byte(testRSS))
+		}
+	})
+	oldURL, newURL := srvURL+"/old.xml", srvURL+"/new.xml"
+
+	a.Equal(oldURL, pr.URL())
+	a.Equal(newURL, pr.NewURL())
+	r.NotNil(pr.Feed())
+	a.Equal(newURL, pr.Feed().FeedURL)
+	a.Equal(1, db.countFeeds())
+	a.Equal(2, db.countEntries(newURL))
+	a.Equal([]string{oldURL}, db.getFeedURLHistory(newURL))
+}
+
+func TestPullFeedsAllOkMovedTemporarily(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	db, srvURL, pr := pullTestMovedFeed(t, func(_ string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/old.xml":
+				http.Redirect(w, req, "/tmp.xml", http.StatusFound)
+			case "/tmp.xml":
+				// Permanent redirects after temporary ones do not move the feed.
+				http.Redirect(w, req, "/new.xml", http.StatusPermanentRedirect)
+			default:
+				_, _ = w.Write([]byte(testRSS))
+			}
+		}
+	})
+	oldURL := srvURL + "/old.xml"
+
+	a.Empty(pr.NewURL())
+	a.Equal(1, db.countFeeds())
+	a.Equal(2, db.countEntries(oldURL))
+	a.Empty(db.getFeedURLHistory(oldURL))
+}
+
+func TestPullFeedsAllOkMovedSelfLink(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	db, srvURL, pr := pullTestMovedFeed(t, func(srvURL string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprintf(w, testSelfLinkRSS, srvURL+"/new.xml")
+		}
+	})
+	oldURL, newURL := srvURL+"/old.xml", srvURL+"/new.xml"
+
+	a.Equal(newURL, pr.NewURL())
+	r.NotNil(pr.Feed())
+	a.Equal(newURL, pr.Feed().FeedURL)
+	a.Equal([]string{oldURL}, db.getFeedURLHistory(newURL))
+}
+
+func TestPullFeedsAllOkMovedSelfLinkRelative(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	db, srvURL, pr := pullTestMovedFeed(t, func(_ string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprintf(w, testSelfLinkRSS, "/new.xml")
+		}
+	})
+	oldURL := srvURL + "/old.xml"
+
+	a.Empty(pr.NewURL())
+	a.Equal(1, db.countEntries(oldURL))
+	a.Empty(db.getFeedURLHistory(oldURL))
+}
+
+func TestPullFeedsAllOkSelfLinkStale(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	// The feed is served over HTTPS, while its self link is an HTTP URL that redirects back.
+	var plainURL string
+	tlsSrv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, testSelfLinkRSS, plainURL+"/feed.xml")
+	}))
+	defer tlsSrv.Close()
+	plainSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Redirect(w, req, tlsSrv.URL+req.URL.Path, http.StatusMovedPermanently)
+	}))
+	defer plainSrv.Close()
+	plainURL = plainSrv.URL
+
+	db := newTestSQLiteDBWithClient(t, tlsSrv.Client())
+	feedURL := tlsSrv.URL + "/feed.xml"
+	db.addFeedWithURL(feedURL)
+
+	for i := 0; i < 2; i++ {
+		got := make([]entity.PullResult, 0)
+		for pr := range db.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+			got = append(got, pr)
+		}
+		r.Len(got, 1)
+		r.NoError(got[0].Error())
+		a.Empty(got[0].NewURL(), "pull %d", i)
+	}
+	a.Equal(1, db.countFeeds())
+	a.Equal(1, db.countEntries(feedURL))
+	a.Empty(db.getFeedURLHistory(feedURL))
+}
+
+func TestPullFeedsAllOkSelfLinkFormerURL(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	// The former URL still serves the feed, and the feed still links to it.
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, testSelfLinkRSS, srvURL+"/old.xml")
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+	oldURL, newURL := srvURL+"/old.xml", srvURL+"/new.xml"
+
+	keys := db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: newURL}})
+	tx := db.tx()
+	r.NoError(addFeedURLHistory(context.Background(), tx, keys["Feed A"].ID, oldURL, newURL, time.Now()))
+	r.NoError(tx.Commit())
+
+	got := make([]entity.PullResult, 0)
+	for pr := range db.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+		got = append(got, pr)
+	}
+	r.Len(got, 1)
+	r.NoError(got[0].Error())
+	a.Empty(got[0].NewURL())
+	a.Equal([]string{oldURL}, db.getFeedURLHistory(newURL))
+}
+
+func TestPullFeedsSelectedOkMovedMerged(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/old.xml" {
+			http.Redirect(w, req, "/new.xml", http.StatusPermanentRedirect)
+			return
+		}
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer srv.Close()
+
+	oldURL, newURL := srv.URL+"/old.xml", srv.URL+"/new.xml"
+	keys := db.addFeeds([]*feedRecord{
+		{
+			title:     "Old",
+			feedURL:   oldURL,
+			isStarred: true,
+			entries: []*entryRecord{
+				{title: "Entry A1", extID: "https://a.com/1"},
+				{title: "Entry O1", extID: "o1"},
+			},
+		},
+		{
+			title:   "New",
+			feedURL: newURL,
+			entries: []*entryRecord{
+				{title: "Entry A2", extID: "https://a.com/2"},
+			},
+		},
+	})
+	oldID, newID := keys["Old"].ID, keys["New"].ID
+
+	got := make([]entity.PullResult, 0)
+	for pr := range db.PullFeeds(context.Background(), []ID{oldID}, nil, nil, nil, false) {
+		got = append(got, pr)
+	}
+	r.Len(got, 1)
+	r.NoError(got[0].Error())
+	a.Equal(newURL, got[0].NewURL())
+	r.NotNil(got[0].Feed())
+	a.Equal(newID, got[0].Feed().ID)
+	a.True(got[0].Feed().IsStarred)
+
+	a.Equal(1, db.countFeeds())
+	// Entries of both feeds are kept, with those of the feed already at the URL taking
+	// precedence.
+	a.Equal(3, db.countEntries(newURL))
+	a.Equal([]string{oldURL}, db.getFeedURLHistory(newURL))
+}
+
+// pullTestMovedFeed pulls a feed subscribed at the /old.xml path of a test server using the
+// handler created by the given function, and returns the only pull result.
+func pullTestMovedFeed(
+	t *testing.T,
+	handler func(srvURL string) http.HandlerFunc,
+) (testSQLiteDB, string, entity.PullResult) {
+	t.Helper()
+
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		handler(srvURL)(w, req)
+	}))
+	t.Cleanup(srv.Close)
+	srvURL = srv.URL
+
+	db.addFeedWithURL(srvURL + "/old.xml")
+
+	got := make([]entity.PullResult, 0)
+	for pr := range db.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+		got = append(got, pr)
+	}
+	require.Len(t, got, 1)
+	require.NoError(t, got[0].Error())
+
+	return db, srvURL, got[0]
+}
+
+func TestPullBackoff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		failures uint32
+		want     time.Duration
+	}{
+		{0, 5 * time.Minute},
+		{1, 5 * time.Minute},
+		{2, 10 * time.Minute},
+		{4, 40 * time.Minute},
+		{9, 21*time.Hour + 20*time.Minute},
+		{10, 24 * time.Hour},
+		{1000, 24 * time.Hour},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, pullBackoff(test.failures), "failures=%d", test.failures)
+	}
+}
+
+func TestPullFeedsAllOkFetchContent(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/feed.xml":
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Feed A</title>
+    <item>
+      <title>Entry A1</title>
+      <link>` + srvURL + `/posts/1</link>
+      <guid>a1</guid>
+      <description>Summary of A1</description>
+    </item>
+    <item>
+      <title>Entry A2</title>
+      <link>` + srvURL + `/posts/2</link>
+      <guid>a2</guid>
+      <description>Summary of A2</description>
+    </item>
+  </channel>
+</rss>`))
+		case "/posts/1":
+			_, _ = w.Write([]byte(testArticlePage))
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	db.addFeeds([]*feedRecord{
+		{title: "Feed A", feedURL: srv.URL + "/feed.xml", fetchContent: true},
+	})
+
+	got := make([]entity.PullResult, 0)
+	c := db.PullFeeds(context.Background(), nil, nil, nil, pointer(5*time.Second), false)
+	for pr := range c {
+		got = append(got, pr)
+	}
+	r.Len(got, 1)
+	r.NoError(got[0].Error())
+
+	entries := got[0].ChangedEntries()
+	r.Len(entries, 2)
+	contents := make(map[string]*string)
+	for _, entry := range entries {
+		contents[entry.Title] = entry.Content
+	}
+
+	r.NotNil(contents["Entry A1"])
+	a.Contains(*contents["Entry A1"], "First paragraph of the article")
+	// Entries whose article can not be fetched keep the content of the feed.
+	a.Nil(contents["Entry A2"])
+}
+
+func TestPullFeedsAllOkFetchContentSlow(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDBWithFeedParser(t)
+
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/feed.xml":
+			items := ""
+			for _, n := range []string{"1", "2", "3", "4"} {
+				items += `<item><title>Entry A` + n + `</title><link>` + srvURL +
+					`/posts/` + n + `</link><guid>a` + n + `</guid></item>`
+			}
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0"><channel><title>Feed A</title>` + items + `</channel></rss>`))
+		case "/posts/4":
+			select {
+			case <-req.Context().Done():
+			case <-time.After(2 * time.Second):
+			}
+		default:
+			time.Sleep(100 * time.Millisecond)
+			_, _ = w.Write([]byte(testArticlePage))
+		}
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	feedURL := srv.URL + "/feed.xml"
+	db.addFeeds([]*feedRecord{{title: "Feed A", feedURL: feedURL, fetchContent: true}})
+
+	// The articles together take longer than the pull timeout, which applies to each of them.
+	got := make([]entity.PullResult, 0)
+	c := db.PullFeeds(context.Background(), nil, nil, nil, pointer(250*time.Millisecond), false)
+	for pr := range c {
+		got = append(got, pr)
+	}
+	r.Len(got, 1)
+	r.NoError(got[0].Error())
+	a.Equal(entity.PullSuccess, got[0].Status())
+
+	contents := make(map[string]*string)
+	for _, entry := range got[0].ChangedEntries() {
+		contents[entry.Title] = entry.Content
+	}
+	r.Len(contents, 4)
+	for _, title := range []string{"Entry A1", "Entry A2", "Entry A3"} {
+		r.NotNil(contents[title], title)
+		a.Contains(*contents[title], "First paragraph of the article", title)
+	}
+	a.Nil(contents["Entry A4"])
+
+	failures, pullErr, nextPull := db.getFeedPullHealth(feedURL)
+	a.Zero(failures)
+	a.Nil(pullErr)
+	a.Nil(nextPull)
+}
+
+const testRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>Feed A</title>
+    <link>https://a.com</link>
+    <description>Feed A description</description>
+    <item>
+      <title>Entry A1</title>
+      <link>https://a.com/1</link>
+      <guid>https://a.com/1</guid>
+      <pubDate>Fri, 18 Oct 2024 09:00:00 GMT</pubDate>
+    </item>
+    <item>
+      <title>Entry A2</title>
+      <link>https://a.com/2</link>
+      <guid>https://a.com/2</guid>
+      <pubDate>Sat, 19 Oct 2024 09:00:00 GMT</pubDate>
+    </item>
+  </channel>
+</rss>
+`
+
+const testSelfLinkRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
+  <channel>
+    <title>Feed A</title>
+    <atom:link href="%s" rel="self" type="application/rss+xml"/>
+    <item>
+      <title>Entry A1</title>
+      <link>https://a.com/1</link>
+      <guid>a1</guid>
+    </item>
+  </channel>
+</rss>
+`
@@ -0,0 +1,43 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// RevokeToken removes the API token with the given name, after which it can no longer be used
+// for authentication.
+func (db *SQLite) RevokeToken(ctx context.Context, name string) error {
+
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, `DELETE FROM api_tokens WHERE name = ?`, name)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return entity.TokenNotFoundError{Name: name}
+		}
+		return nil
+	}
+
+	fail := failF("SQLite.RevokeToken")
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return fail(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestRevokeTokenOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	_, secret, err := db.CreateToken(context.Background(), "laptop", entity.TokenScopeWrite)
+	r.NoError(err)
+	_, _, err = db.CreateToken(context.Background(), "phone", entity.TokenScopeRead)
+	r.NoError(err)
+
+	err = db.RevokeToken(context.Background(), "laptop")
+	r.NoError(err)
+
+	a.Equal(1, db.countTableRows("api_tokens"))
+	_, err = db.AuthenticateToken(context.Background(), secret)
+	a.ErrorIs(err, entity.InvalidTokenError{})
+}
+
+func TestRevokeTokenErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	err := db.RevokeToken(context.Background(), "laptop")
+	a.EqualError(err, `SQLite.RevokeToken: token "laptop" not found`)
+}
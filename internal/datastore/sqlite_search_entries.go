@@ -0,0 +1,405 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// snippetNumTokens is the maximum number of tokens in a search result snippet.
+const snippetNumTokens = 24
+
+func (db *SQLite) SearchEntries(
+	ctx context.Context,
+	query string,
+	feedIDs []entity.ID,
+	maxResults *uint32,
+) ([]*entity.SearchResult, error) {
+
+	fail := failF("SQLite.SearchEntries")
+
+	ftsq := toFTSQuery(query)
+	if ftsq == "" {
+		return nil, fail(entity.InvalidArgumentError{Reason: "search query is empty"})
+	}
+
+	recs := make([]*searchResultRecord, 0)
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		irecs, err := searchEntries(ctx, tx, ftsq, feedIDs, maxResults)
+		if err != nil {
+			return err
+		}
+		recs = irecs
+		return nil
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	results := make([]*entity.SearchResult, len(recs))
+	for i, rec := range recs {
+		results[i] = rec.searchResult()
+	}
+
+	return results, nil
+}
+
+func searchEntries(
+	ctx context.Context,
+	tx *sql.Tx,
+	ftsQuery string,
+	feedIDs []ID,
+	maxResults *uint32,
+) ([]*searchResultRecord, error) {
+
+	sql1 := `
+		SELECT
+			e.id AS id
+			, e.feed_id AS feed_id
+			, e.title AS title
+			, e.is_read AS is_read
+			, e.is_bookmarked AS is_bookmarked
+			, e.external_id AS ext_id
+			, e.description AS description
+			, e.content AS content
+			, e.url AS url
+			, e.authors AS authors
+			, e.tags AS tags
+			, e.enclosures AS enclosures
+			, e.update_time AS update_time
+			, e.pub_time AS pub_time
+			, highlight(entries_fts, 0, char(2), char(3)) AS title_highlight
+			, snippet(entries_fts, -1, char(2), char(3), '…', $4) AS snippet
+			, -bm25(entries_fts, 10.0, 2.0, 1.0) AS score
+		FROM
+			entries_fts
+			INNER JOIN entries e ON e.id = entries_fts.rowid
+		WHERE
+			entries_fts MATCH $1
+			AND COALESCE(e.feed_id IN (SELECT value FROM json_each($2)), true)
+		ORDER BY
+			score DESC
+			, COALESCE(e.update_time, e.pub_time) DESC
+		LIMIT
+			$3
+`
+
+	scanRow := func(rows *sql.Rows) (*searchResultRecord, error) {
+		var rec searchResultRecord
+		if err := rows.Scan(
+			&rec.entry.id,
+			&rec.entry.feedID,
+			&rec.entry.title,
+			&rec.entry.isRead,
+			&rec.entry.isBookmarked,
+			&rec.entry.extID,
+			&rec.entry.description,
+			&rec.entry.content,
+			&rec.entry.url,
+			&rec.entry.authors,
+			&rec.entry.tags,
+			&rec.entry.enclosures,
+			&rec.entry.updated,
+			&rec.entry.published,
+			&rec.titleHighlight,
+			&rec.snippet,
+			&rec.score,
+		); err != nil {
+			return nil, err
+		}
+		return &rec, nil
+	}
+
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt1.Close()
+
+	feedIDsJSON := "null"
+	if len(feedIDs) > 0 {
+		s, merr := json.Marshal(feedIDs)
+		if merr != nil {
+			return nil, merr
+		}
+		feedIDsJSON = string(s)
+	}
+
+	// Negative limits mean no limits in SQLite.
+	limit := int64(-1)
+	if maxResults != nil {
+		limit = int64(*maxResults)
+	}
+
+	rows, err := stmt1.QueryContext(ctx, ftsQuery, feedIDsJSON, limit, snippetNumTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make([]*searchResultRecord, 0)
+	for rows.Next() {
+		rec, err := scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+
+	return recs, rows.Err()
+}
+
+type searchResultRecord struct {
+	entry          entryRecord
+	titleHighlight string
+	snippet        sql.NullString
+	score          float64
+}
+
+func (rec *searchResultRecord) searchResult() *entity.SearchResult {
+	return &entity.SearchResult{
+		Entry:          rec.entry.entry(),
+		TitleHighlight: rec.titleHighlight,
+		Snippet:        cleanSnippet(rec.snippet.String),
+		Score:          rec.score,
+	}
+}
+
+// toFTSQuery converts a user-supplied query into an FTS5 query. Each whitespace-separated term
+// is quoted so that FTS5 operators in the input are matched literally, and all terms must
+// match. A trailing '*' on a term is kept as a prefix search.
+func toFTSQuery(query string) string {
+	terms := strings.Fields(query)
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		prefix := strings.HasSuffix(term, "*")
+		term = strings.TrimRight(term, "*")
+		if term == "" {
+			continue
+		}
+		item := fmt.Sprintf(`"%s"`, strings.ReplaceAll(term, `"`, `""`))
+		if prefix {
+			item += "*"
+		}
+		quoted = append(quoted, item)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// cleanSnippet removes complete or truncated markup tags from the given snippet. This is only
+// required for entries indexed before markup was stripped at indexing time.
+func cleanSnippet(snippet string) string {
+	if !markupPattern.MatchString(snippet) {
+		return snippet
+	}
+	// Drop the tail of a tag cut off at the start of the snippet.
+	i, j := strings.Index(snippet, ">"), strings.Index(snippet, "<")
+	if i >= 0 && (j < 0 || i < j) {
+		snippet = snippet[i+1:]
+	}
+	// Drop the head of a tag cut off at the end of the snippet.
+	if i = strings.LastIndex(snippet, "<"); i >= 0 && !strings.Contains(snippet[i:], ">") {
+		snippet = snippet[:i]
+	}
+	return strings.TrimSpace(plainText(snippet))
+}
+
+var markupPattern = regexp.MustCompile(`</?[a-zA-Z][^<>]*>|^[^<]*>|<[a-zA-Z/][^>]*$`)
+
+// indexMissingEntries adds entries missing from the full-text search index, such as entries
+// stored before the index was created, to the index. Entries are indexed in batches of the given
+// size.
+func (db *SQLite) indexMissingEntries(ctx context.Context, batchSize int) error {
+
+	sql1 := `
+		SELECT
+			e.id
+			, e.title
+			, e.description
+			, e.content
+		FROM
+			entries e
+		WHERE
+			e.id > $1
+			AND e.id NOT IN (SELECT rowid FROM entries_fts)
+		ORDER BY
+			e.id
+		LIMIT $2
+`
+	type entryRecord struct {
+		id      ID
+		title   string
+		desc    sql.NullString
+		content sql.NullString
+	}
+
+	indexBatch := func(ctx context.Context, tx *sql.Tx, afterID ID) (ID, int, error) {
+		rows, err := tx.QueryContext(ctx, sql1, afterID, batchSize)
+		if err != nil {
+			return 0, 0, err
+		}
+		recs := make([]*entryRecord, 0)
+		for rows.Next() {
+			var rec entryRecord
+			if err = rows.Scan(&rec.id, &rec.title, &rec.desc, &rec.content); err != nil {
+				rows.Close()
+				return 0, 0, err
+			}
+			recs = append(recs, &rec)
+		}
+		if err = rows.Close(); err != nil {
+			return 0, 0, err
+		}
+		if err = rows.Err(); err != nil {
+			return 0, 0, err
+		}
+		if len(recs) == 0 {
+			return afterID, 0, nil
+		}
+
+		idx, err := newEntryIndexer(ctx, tx)
+		if err != nil {
+			return 0, 0, err
+		}
+		defer idx.close()
+
+		for _, rec := range recs {
+			err = idx.index(
+				ctx,
+				rec.id,
+				rec.title,
+				fromNullString(rec.desc),
+				fromNullString(rec.content),
+			)
+			if err != nil {
+				return 0, 0, err
+			}
+		}
+		return recs[len(recs)-1].id, len(recs), nil
+	}
+
+	var afterID ID
+	for {
+		var n int
+		dbFunc := func(ctx context.Context, tx *sql.Tx) (err error) {
+			afterID, n, err = indexBatch(ctx, tx, afterID)
+			return err
+		}
+		if err := db.withTx(ctx, dbFunc); err != nil {
+			return err
+		}
+		if n < batchSize {
+			return nil
+		}
+	}
+}
+
+// entryIndexer adds entries to the full-text search index.
+type entryIndexer struct {
+	deleteStmt *sql.Stmt
+	insertStmt *sql.Stmt
+}
+
+func newEntryIndexer(ctx context.Context, tx *sql.Tx) (*entryIndexer, error) {
+	sql1 := `DELETE FROM entries_fts WHERE rowid = $1`
+	sql2 := `INSERT INTO entries_fts(rowid, title, description, content) VALUES ($1, $2, $3, $4)`
+
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return nil, err
+	}
+	stmt2, err := tx.PrepareContext(ctx, sql2)
+	if err != nil {
+		stmt1.Close()
+		return nil, err
+	}
+
+	return &entryIndexer{deleteStmt: stmt1, insertStmt: stmt2}, nil
+}
+
+// index replaces the indexed values of the given entry.
+func (idx *entryIndexer) index(
+	ctx context.Context,
+	id ID,
+	title string,
+	desc, content *string,
+) error {
+	if _, err := idx.deleteStmt.ExecContext(ctx, id); err != nil {
+		return err
+	}
+	_, err := idx.insertStmt.ExecContext(
+		ctx,
+		id,
+		title,
+		plainTextOrNil(desc),
+		plainTextOrNil(content),
+	)
+	return err
+}
+
+func (idx *entryIndexer) close() {
+	idx.deleteStmt.Close()
+	idx.insertStmt.Close()
+}
+
+func plainTextOrNil(v *string) *string {
+	if v == nil {
+		return nil
+	}
+	return pointerOrNil(plainText(*v))
+}
+
+// plainText returns the text content of the given HTML fragment, with consecutive whitespace
+// collapsed.
+func plainText(fragment string) string {
+	var (
+		sb   strings.Builder
+		tkz  = html.NewTokenizer(strings.NewReader(fragment))
+		skip = 0
+	)
+	for {
+		switch tkz.Next() {
+		case html.ErrorToken:
+			return strings.Join(strings.Fields(sb.String()), " ")
+		case html.StartTagToken:
+			if name, _ := tkz.TagName(); isInvisibleTag(name) {
+				skip++
+			}
+			sb.WriteString(" ")
+		case html.EndTagToken:
+			if name, _ := tkz.TagName(); isInvisibleTag(name) && skip > 0 {
+				skip--
+			}
+			sb.WriteString(" ")
+		case html.TextToken:
+			if skip == 0 {
+				sb.Write(tkz.Text())
+			}
+		case html.SelfClosingTagToken, html.CommentToken, html.DoctypeToken:
+			sb.WriteString(" ")
+		}
+	}
+}
+
+func isInvisibleTag(name []byte) bool {
+	switch string(name) {
+	case "script", "style", "noscript", "template":
+		return true
+	default:
+		return false
+	}
+}
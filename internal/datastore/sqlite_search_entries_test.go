@@ -0,0 +1,229 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func (db *testSQLiteDB) upsertEntries(feedID ID, items []*gofeed.Item) {
+	db.t.Helper()
+
+	tx := db.tx()
+	_, err := upsertEntries(context.Background(), tx, feedID, items)
+	require.NoError(db.t, err)
+	require.NoError(db.t, tx.Commit())
+}
+
+func setupSearchFixture(t *testing.T) (testSQLiteDB, map[string]feedKey) {
+	t.Helper()
+
+	db := newTestSQLiteDB(t)
+	keys := db.addFeeds([]*feedRecord{
+		{title: "Feed A", feedURL: "https://a.com/feed.xml"},
+		{title: "Feed B", feedURL: "https://b.com/feed.xml"},
+	})
+
+	db.upsertEntries(keys["Feed A"].ID, []*gofeed.Item{
+		{
+			GUID:        "a1",
+			Title:       "Gardening for beginners",
+			Link:        "https://a.com/a1",
+			Description: "<p>How to grow <b>tomatoes</b> on a balcony.</p>",
+		},
+		{
+			GUID:    "a2",
+			Title:   "Kitchen notes",
+			Link:    "https://a.com/a2",
+			Content: "<script>var tomatoes = 1;</script><p>A recipe for soup.</p>",
+		},
+	})
+	db.upsertEntries(keys["Feed B"].ID, []*gofeed.Item{
+		{
+			GUID:    "b1",
+			Title:   "Tomatoes everywhere",
+			Link:    "https://b.com/b1",
+			Content: "<div>Tomatoes, tomatoes and more tomatoes.</div>",
+		},
+	})
+
+	return db, keys
+}
+
+func TestSearchEntriesOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, _ := setupSearchFixture(t)
+
+	results, err := db.SearchEntries(context.Background(), "tomato", nil, nil)
+	r.NoError(err)
+	r.Len(results, 2)
+
+	// Title matches are ranked first.
+	a.Equal("Tomatoes everywhere", results[0].Entry.Title)
+	a.Equal("\x02Tomatoes\x03 everywhere", results[0].TitleHighlight)
+	a.Equal("Gardening for beginners", results[1].Entry.Title)
+	a.Equal("Gardening for beginners", results[1].TitleHighlight)
+	a.Equal("How to grow \x02tomatoes\x03 on a balcony.", results[1].Snippet)
+	a.Greater(results[0].Score, results[1].Score)
+}
+
+func TestSearchEntriesOkFeedIDs(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupSearchFixture(t)
+
+	results, err := db.SearchEntries(
+		context.Background(),
+		"tomatoes",
+		[]entity.ID{keys["Feed A"].ID},
+		nil,
+	)
+	r.NoError(err)
+	r.Len(results, 1)
+	a.Equal("Gardening for beginners", results[0].Entry.Title)
+}
+
+func TestSearchEntriesOkMaxResults(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	db, _ := setupSearchFixture(t)
+
+	results, err := db.SearchEntries(context.Background(), "tomatoes", nil, pointer(uint32(1)))
+	r.NoError(err)
+	r.Len(results, 1)
+}
+
+func TestSearchEntriesOkPrefixAndOperators(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, _ := setupSearchFixture(t)
+
+	results, err := db.SearchEntries(context.Background(), "balc*", nil, nil)
+	r.NoError(err)
+	r.Len(results, 1)
+	a.Equal("Gardening for beginners", results[0].Entry.Title)
+
+	// FTS5 syntax is matched literally.
+	results, err = db.SearchEntries(context.Background(), `soup OR "balcony`, nil, nil)
+	r.NoError(err)
+	a.Empty(results)
+}
+
+func TestSearchEntriesOkUpdatedEntry(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupSearchFixture(t)
+
+	updateTime := mustTime(t, "2024-10-19T10:00:00Z")
+	db.upsertEntries(keys["Feed A"].ID, []*gofeed.Item{
+		{
+			GUID:          "a2",
+			Title:         "Kitchen notes",
+			Link:          "https://a.com/a2",
+			Content:       "<p>A recipe for gazpacho.</p>",
+			UpdatedParsed: &updateTime,
+		},
+	})
+
+	results, err := db.SearchEntries(context.Background(), "soup", nil, nil)
+	r.NoError(err)
+	a.Empty(results)
+
+	results, err = db.SearchEntries(context.Background(), "gazpacho", nil, nil)
+	r.NoError(err)
+	r.Len(results, 1)
+	a.Equal("A recipe for \x02gazpacho\x03.", results[0].Snippet)
+}
+
+func TestSearchEntriesOkDeletedFeed(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, keys := setupSearchFixture(t)
+
+	r.NoError(db.DeleteFeeds(context.Background(), []entity.ID{keys["Feed B"].ID}))
+	a.False(db.rowExists(`SELECT 1 FROM entries_fts WHERE title = ?`, "Tomatoes everywhere"))
+
+	results, err := db.SearchEntries(context.Background(), "tomatoes", nil, nil)
+	r.NoError(err)
+	r.Len(results, 1)
+	a.Equal("Gardening for beginners", results[0].Entry.Title)
+}
+
+func TestIndexMissingEntries(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db, _ := setupSearchFixture(t)
+
+	// Entries stored before the index was created are not in the index.
+	_, err := db.handle.Exec(`DELETE FROM entries_fts WHERE rowid IN (SELECT id FROM entries)`)
+	r.NoError(err)
+	results, err := db.SearchEntries(context.Background(), "tomato", nil, nil)
+	r.NoError(err)
+	r.Empty(results)
+
+	err = db.indexMissingEntries(context.Background(), 2)
+	r.NoError(err)
+
+	results, err = db.SearchEntries(context.Background(), "tomato", nil, nil)
+	r.NoError(err)
+	r.Len(results, 2)
+	a.Equal("How to grow \x02tomatoes\x03 on a balcony.", results[1].Snippet)
+
+	// Markup is stripped from entries indexed this way.
+	results, err = db.SearchEntries(context.Background(), "var", nil, nil)
+	r.NoError(err)
+	a.Empty(results)
+
+	var n int
+	r.NoError(db.handle.QueryRow(`SELECT count(*) FROM entries_fts`).Scan(&n))
+	a.Equal(3, n)
+}
+
+func TestSearchEntriesErrEmptyQuery(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	results, err := db.SearchEntries(context.Background(), "  * ", nil, nil)
+	a.Nil(results)
+	a.ErrorAs(err, &entity.InvalidArgumentError{})
+}
+
+func TestCleanSnippet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in, want string
+	}{
+		{"plain \x02text\x03…", "plain \x02text\x03…"},
+		{"1 < 2 and \x02three\x03", "1 < 2 and \x02three\x03"},
+		{"ass=\"x\">Hello <b>\x02world\x03</b> <a hr", "Hello \x02world\x03"},
+		{"<p>Fish &amp; \x02chips\x03</p>", "Fish & \x02chips\x03"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, cleanSnippet(test.in))
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// SetRetentionPolicy creates or replaces the retention policy of the feed given in the policy, or
+// the global policy if the policy has no feed ID.
+func (db *SQLite) SetRetentionPolicy(ctx context.Context, policy *entity.RetentionPolicy) error {
+
+	dbFunc := func(ctx context.Context, tx *sql.Tx) error {
+		if policy.FeedID != nil {
+			if err := ensureFeedExists(ctx, tx, *policy.FeedID); err != nil {
+				return err
+			}
+		}
+		if _, err := deleteRetentionPolicy(ctx, tx, policy.FeedID); err != nil {
+			return err
+		}
+		return insertRetentionPolicy(ctx, tx, policy)
+	}
+
+	fail := failF("SQLite.SetRetentionPolicy")
+
+	if policy == nil {
+		return fail(entity.InvalidArgumentError{Reason: "retention policy not specified"})
+	}
+	if policy.MaxAge != nil && *policy.MaxAge < time.Second {
+		return fail(entity.InvalidArgumentError{Reason: "retention max age must be at least 1s"})
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	err := db.withTx(ctx, dbFunc)
+	if err != nil {
+		return fail(err)
+	}
+
+	return nil
+}
+
+func insertRetentionPolicy(
+	ctx context.Context,
+	tx *sql.Tx,
+	policy *entity.RetentionPolicy,
+) error {
+
+	sql1 := `
+		INSERT INTO
+			retention_policies(
+				feed_id
+				, max_age_secs
+				, max_entries
+				, keep_bookmarked
+				, keep_unread
+			)
+			VALUES(?, ?, ?, ?, ?)
+`
+	stmt1, err := tx.PrepareContext(ctx, sql1)
+	if err != nil {
+		return err
+	}
+	defer stmt1.Close()
+
+	var maxAgeSecs *int64
+	if policy.MaxAge != nil {
+		maxAgeSecs = pointer(int64(policy.MaxAge.Seconds()))
+	}
+
+	_, err = stmt1.ExecContext(
+		ctx,
+		policy.FeedID,
+		maxAgeSecs,
+		policy.MaxEntries,
+		policy.KeepBookmarked,
+		policy.KeepUnread,
+	)
+
+	return err
+}
+
+func ensureFeedExists(ctx context.Context, tx *sql.Tx, feedID ID) error {
+	var exists bool
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM feeds WHERE id = ?)`,
+		feedID,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return entity.FeedNotFoundError{ID: feedID}
+	}
+	return nil
+}
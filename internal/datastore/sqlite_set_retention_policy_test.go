@@ -0,0 +1,61 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package datastore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestSetRetentionPolicyOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+	keys := db.addFeeds([]*feedRecord{
+		{title: "Feed A", feedURL: "https://a.com/feed.xml"},
+		{title: "Feed B", feedURL: "https://b.com/feed.xml"},
+	})
+	feedID := keys["Feed B"].ID
+
+	policies := []*entity.RetentionPolicy{
+		{FeedID: &feedID, MaxEntries: pointer(uint32(10)), KeepUnread: true},
+		{MaxAge: pointer(48 * time.Hour), KeepBookmarked: true},
+		// Replaces the first global policy.
+		{MaxAge: pointer(24 * time.Hour), KeepBookmarked: true},
+	}
+	for _, policy := range policies {
+		r.NoError(db.SetRetentionPolicy(context.Background(), policy))
+	}
+
+	got, err := db.ListRetentionPolicies(context.Background())
+	r.NoError(err)
+	a.Equal([]*entity.RetentionPolicy{policies[2], policies[0]}, got)
+}
+
+func TestSetRetentionPolicyErr(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	db := newTestSQLiteDB(t)
+
+	err := db.SetRetentionPolicy(
+		context.Background(),
+		&entity.RetentionPolicy{FeedID: pointer(ID(7)), MaxEntries: pointer(uint32(1))},
+	)
+	a.EqualError(err, "SQLite.SetRetentionPolicy: feed with ID=7 not found")
+
+	err = db.SetRetentionPolicy(
+		context.Background(),
+		&entity.RetentionPolicy{MaxAge: pointer(time.Millisecond)},
+	)
+	a.EqualError(err, "SQLite.SetRetentionPolicy: invalid argument: retention max age must be at least 1s")
+}
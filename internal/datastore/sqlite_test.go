@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/http"
 	"path/filepath"
 	"testing"
 	"time"
@@ -32,6 +33,31 @@ func newTestSQLiteDB(t *testing.T) testSQLiteDB {
 	return testSQLiteDB{s, t, prs}
 }
 
+// newTestSQLiteDBWithFeedParser creates a test database that fetches feeds over HTTP.
+func newTestSQLiteDBWithFeedParser(t *testing.T) testSQLiteDB {
+	t.Helper()
+
+	dbPath := filepath.Join(t.TempDir(), t.Name()+".db")
+	s, err := newSQLiteWithParser(dbPath, newFeedParser())
+	require.NoError(t, err)
+
+	return testSQLiteDB{s, t, nil}
+}
+
+// newTestSQLiteDBWithClient creates a test database that fetches feeds over HTTP using the given
+// client.
+func newTestSQLiteDBWithClient(t *testing.T, client *http.Client) testSQLiteDB {
+	t.Helper()
+
+	dbPath := filepath.Join(t.TempDir(), t.Name()+".db")
+	prs := newFeedParser()
+	prs.client = client
+	s, err := newSQLiteWithParser(dbPath, prs)
+	require.NoError(t, err)
+
+	return testSQLiteDB{s, t, nil}
+}
+
 func (db *testSQLiteDB) tx() *sql.Tx {
 	db.t.Helper()
 
@@ -224,8 +250,9 @@ func (db *testSQLiteDB) addFeeds(feeds []*feedRecord) map[string]feedKey {
 				, sub_time
 				, last_pull_time
 				, update_time
+				, fetch_content
 			)
-			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 		RETURNING
 			id
 	`)
@@ -239,8 +266,9 @@ func (db *testSQLiteDB) addFeeds(feeds []*feedRecord) map[string]feedKey {
 			, is_read
 			, is_bookmarked
 			, update_time
+			, pub_time
 		)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 		RETURNING id
 	`)
 	require.NoError(db.t, err)
@@ -261,6 +289,7 @@ func (db *testSQLiteDB) addFeeds(feeds []*feedRecord) map[string]feedKey {
 			subTime,
 			subTime, // last_pull_time defaults to sub_time
 			feed.updated,
+			feed.fetchContent,
 		).Scan(&feedID)
 		require.NoError(db.t, err)
 
@@ -287,6 +316,7 @@ func (db *testSQLiteDB) addFeeds(feeds []*feedRecord) map[string]feedKey {
 				entry.isRead,
 				entry.isBookmarked,
 				updateTime,
+				entry.published,
 			).Scan(&entryID)
 			require.NoError(db.t, err)
 			entries[entry.title] = entryID
@@ -303,6 +333,84 @@ func (db *testSQLiteDB) addFeeds(feeds []*feedRecord) map[string]feedKey {
 	return keys
 }
 
+func (db *testSQLiteDB) getFeedHTTPCache(feedURL string) *HTTPCache {
+	db.t.Helper()
+
+	tx := db.tx()
+	stmt1, err := tx.Prepare(
+		`SELECT etag, last_modified, last_http_status FROM feeds WHERE feed_url = ?`,
+	)
+	require.NoError(db.t, err)
+
+	var (
+		etag, lastModified sql.NullString
+		status             sql.NullInt64
+	)
+	err = stmt1.QueryRow(feedURL).Scan(&etag, &lastModified, &status)
+	require.NoError(db.t, err)
+	require.NoError(db.t, tx.Rollback())
+
+	cache := HTTPCache{ETag: fromNullString(etag), LastModified: fromNullString(lastModified)}
+	if status.Valid {
+		cache.Status = pointer(int(status.Int64))
+	}
+
+	return &cache
+}
+
+func (db *testSQLiteDB) getFeedPullHealth(feedURL string) (uint32, *string, *time.Time) {
+	db.t.Helper()
+
+	tx := db.tx()
+	stmt1, err := tx.Prepare(
+		`SELECT pull_failures, last_pull_error, next_pull_time FROM feeds WHERE feed_url = ?`,
+	)
+	require.NoError(db.t, err)
+
+	var (
+		failures uint32
+		pullErr  sql.NullString
+		nextPull sql.NullTime
+	)
+	err = stmt1.QueryRow(feedURL).Scan(&failures, &pullErr, &nextPull)
+	require.NoError(db.t, err)
+	require.NoError(db.t, tx.Rollback())
+
+	return failures, fromNullString(pullErr), fromNullTime(nextPull)
+}
+
+func (db *testSQLiteDB) getFeedURLHistory(feedURL string) []string {
+	db.t.Helper()
+
+	tx := db.tx()
+	stmt1, err := tx.Prepare(`
+		SELECT
+			h.url
+		FROM
+			feed_url_history h
+			INNER JOIN feeds f ON h.feed_id = f.id
+		WHERE
+			f.feed_url = ?
+		ORDER BY
+			h.url
+	`)
+	require.NoError(db.t, err)
+
+	rows, err := stmt1.Query(feedURL)
+	require.NoError(db.t, err)
+
+	urls := make([]string, 0)
+	for rows.Next() {
+		var u string
+		require.NoError(db.t, rows.Scan(&u))
+		urls = append(urls, u)
+	}
+	require.NoError(db.t, rows.Err())
+	require.NoError(db.t, tx.Rollback())
+
+	return urls
+}
+
 func (db *testSQLiteDB) addFeedWithURL(url string) {
 	db.t.Helper()
 
@@ -314,3 +422,25 @@ func (db *testSQLiteDB) addFeedWithURL(url string) {
 	require.NoError(db.t, err)
 	require.NoError(db.t, tx.Commit())
 }
+
+func TestObserveTx(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	db := newTestSQLiteDB(t)
+
+	var errs []error
+	db.ObserveTx(func(duration time.Duration, err error) {
+		r.Positive(duration)
+		errs = append(errs, err)
+	})
+
+	_, err := db.CreateFolder(context.Background(), "News", nil)
+	r.NoError(err)
+	_, err = db.CreateFolder(context.Background(), "News", nil)
+	r.Error(err)
+
+	r.Len(errs, 2)
+	r.NoError(errs[0])
+	r.Error(errs[1])
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import "github.com/bow/neon/api"
+
+// FeedCandidate is a feed found by discovery from a website URL.
+type FeedCandidate struct {
+	URL string
+	// Title is the title advertised for the feed, which may be empty.
+	Title string
+}
+
+func FromFeedCandidatePbs(pbs []*api.DiscoverFeedsResponse_Candidate) []*FeedCandidate {
+	candidates := make([]*FeedCandidate, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb == nil {
+			continue
+		}
+		candidates = append(candidates, &FeedCandidate{URL: pb.GetUrl(), Title: pb.GetTitle()})
+	}
+	return candidates
+}
@@ -34,22 +34,87 @@ func ToFeedIDs(raw []string) ([]ID, error) {
 	return ids, nil
 }
 
+func ToEntryID(raw string) (ID, error) {
+	id, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return 0, EntryNotFoundError{ID: raw}
+	}
+	return ID(id), nil // #nosec: G115
+}
+
+func ToEntryIDs(raw []string) ([]ID, error) {
+	ids := make([]ID, 0)
+	for _, item := range raw {
+		id, err := ToEntryID(item)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func ToFolderID(raw string) (ID, error) {
+	id, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return 0, FolderNotFoundError{ID: raw}
+	}
+	return ID(id), nil // #nosec: G115
+}
+
+func ToFolderIDs(raw []string) ([]ID, error) {
+	ids := make([]ID, 0)
+	for _, item := range raw {
+		id, err := ToFolderID(item)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func ToRuleID(raw string) (ID, error) {
+	id, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return 0, RuleNotFoundError{ID: raw}
+	}
+	return ID(id), nil // #nosec: G115
+}
+
+func ToRuleIDs(raw []string) ([]ID, error) {
+	ids := make([]ID, 0)
+	for _, item := range raw {
+		id, err := ToRuleID(item)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
 func FromFeedPb(pb *api.Feed) *Feed {
 	if pb == nil {
 		return nil
 	}
 	return &Feed{
-		ID:          pb.GetId(),
-		Title:       pb.GetTitle(),
-		Description: pb.Description,
-		FeedURL:     pb.GetFeedUrl(),
-		SiteURL:     pb.SiteUrl,
-		Subscribed:  *FromTimestampPb(pb.GetSubTime()),
-		LastPulled:  *FromTimestampPb(pb.GetLastPullTime()),
-		Updated:     FromTimestampPb(pb.GetUpdateTime()),
-		IsStarred:   pb.GetIsStarred(),
-		Tags:        pb.GetTags(),
-		Entries:     fromEntryPbs(pb.GetEntries()),
+		ID:            pb.GetId(),
+		Title:         pb.GetTitle(),
+		Description:   pb.Description,
+		FeedURL:       pb.GetFeedUrl(),
+		SiteURL:       pb.SiteUrl,
+		Subscribed:    *FromTimestampPb(pb.GetSubTime()),
+		LastPulled:    *FromTimestampPb(pb.GetLastPullTime()),
+		Updated:       FromTimestampPb(pb.GetUpdateTime()),
+		IsStarred:     pb.GetIsStarred(),
+		Tags:          pb.GetTags(),
+		FolderID:      pb.FolderId,
+		FetchContent:  pb.GetFetchContent(),
+		PullFailures:  pb.GetPullFailures(),
+		LastPullError: pb.LastPullError,
+		NextPullTime:  FromTimestampPb(pb.GetNextPullTime()),
+		Entries:       fromEntryPbs(pb.GetEntries()),
 	}
 }
 
@@ -79,9 +144,23 @@ func FromEntryPb(pb *api.Entry) *Entry {
 		Description:  pb.Description,
 		Content:      pb.Content,
 		URL:          pb.Url,
+		Authors:      pb.GetAuthors(),
+		Tags:         pb.GetTags(),
+		Enclosures:   fromEnclosurePbs(pb.GetEnclosures()),
 	}
 }
 
+func fromEnclosurePbs(pbs []*api.Entry_Enclosure) []*Enclosure {
+	if len(pbs) == 0 {
+		return nil
+	}
+	enclosures := make([]*Enclosure, len(pbs))
+	for i, pb := range pbs {
+		enclosures[i] = &Enclosure{URL: pb.GetUrl(), Type: pb.GetType(), Length: pb.GetLength()}
+	}
+	return enclosures
+}
+
 func fromEntryPbs(pbs []*api.Entry) map[ID]*Entry {
 	entries := make(map[ID]*Entry)
 	for _, pb := range pbs {
@@ -112,4 +191,75 @@ func FromTimestampPb(pb *timestamppb.Timestamp) *time.Time {
 	return &v
 }
 
+func ToFeedPb(feed *Feed) *api.Feed {
+	return &api.Feed{
+		Id:            feed.ID,
+		Title:         feed.Title,
+		FeedUrl:       feed.FeedURL,
+		SiteUrl:       feed.SiteURL,
+		Tags:          feed.Tags,
+		Description:   feed.Description,
+		IsStarred:     feed.IsStarred,
+		SubTime:       timestamppb.New(feed.Subscribed),
+		LastPullTime:  timestamppb.New(feed.LastPulled),
+		UpdateTime:    ToTimestampPb(feed.Updated),
+		Entries:       ToEntryPbs(feed.EntriesSlice()),
+		FolderId:      feed.FolderID,
+		FetchContent:  feed.FetchContent,
+		PullFailures:  feed.PullFailures,
+		LastPullError: feed.LastPullError,
+		NextPullTime:  ToTimestampPb(feed.NextPullTime),
+	}
+}
+
+func ToFeedPbs(feeds []*Feed) []*api.Feed {
+	pbs := make([]*api.Feed, len(feeds))
+	for i, feed := range feeds {
+		pbs[i] = ToFeedPb(feed)
+	}
+	return pbs
+}
+
+func ToEntryPb(entry *Entry) *api.Entry {
+	return &api.Entry{
+		Id:           entry.ID,
+		FeedId:       entry.FeedID,
+		Title:        entry.Title,
+		IsRead:       entry.IsRead,
+		IsBookmarked: entry.IsBookmarked,
+		ExtId:        entry.ExtID,
+		Description:  entry.Description,
+		Content:      entry.Content,
+		Url:          entry.URL,
+		PubTime:      ToTimestampPb(entry.Published),
+		UpdateTime:   ToTimestampPb(entry.Updated),
+		Authors:      entry.Authors,
+		Tags:         entry.Tags,
+		Enclosures:   toEnclosurePbs(entry.Enclosures),
+	}
+}
+
+func toEnclosurePbs(enclosures []*Enclosure) []*api.Entry_Enclosure {
+	pbs := make([]*api.Entry_Enclosure, len(enclosures))
+	for i, enc := range enclosures {
+		pbs[i] = &api.Entry_Enclosure{Url: enc.URL, Type: enc.Type, Length: enc.Length}
+	}
+	return pbs
+}
+
+func ToEntryPbs(entries []*Entry) []*api.Entry {
+	pbs := make([]*api.Entry, len(entries))
+	for i, entry := range entries {
+		pbs[i] = ToEntryPb(entry)
+	}
+	return pbs
+}
+
+func ToTimestampPb(v *time.Time) *timestamppb.Timestamp {
+	if v == nil {
+		return nil
+	}
+	return timestamppb.New(*v)
+}
+
 const defaultExportTitle = "neon export"
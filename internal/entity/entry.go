@@ -17,6 +17,20 @@ type Entry struct {
 	Description  *string
 	Content      *string
 	URL          *string
+	// Authors are the names of the entry authors.
+	Authors []string
+	// Tags are the categories of the entry, together with the tags added by rules.
+	Tags       []string
+	Enclosures []*Enclosure
+}
+
+// Enclosure is a file attached to an entry, such as a podcast episode.
+type Enclosure struct {
+	URL string
+	// Type is the media type of the file, or empty if unknown.
+	Type string
+	// Length is the size of the file in bytes, or zero if unknown.
+	Length uint64
 }
 
 type EntryEditOp struct {
@@ -16,3 +16,44 @@ type EntryNotFoundError struct{ ID any }
 func (e EntryNotFoundError) Error() string {
 	return fmt.Sprintf("entry with ID=%v not found", e.ID)
 }
+
+type FolderNotFoundError struct{ ID any }
+
+func (e FolderNotFoundError) Error() string {
+	return fmt.Sprintf("folder with ID=%v not found", e.ID)
+}
+
+type InvalidArgumentError struct{ Reason string }
+
+func (e InvalidArgumentError) Error() string {
+	return fmt.Sprintf("invalid argument: %s", e.Reason)
+}
+
+// AmbiguousFeedURLError is returned when a URL points to a website publishing more than one
+// feed, so that the feed to add can not be determined.
+type AmbiguousFeedURLError struct {
+	URL        string
+	Candidates []*FeedCandidate
+}
+
+func (e AmbiguousFeedURLError) Error() string {
+	return fmt.Sprintf("found %d feeds at %s", len(e.Candidates), e.URL)
+}
+
+type TokenNotFoundError struct{ Name string }
+
+func (e TokenNotFoundError) Error() string {
+	return fmt.Sprintf("token %q not found", e.Name)
+}
+
+type InvalidTokenError struct{}
+
+func (e InvalidTokenError) Error() string {
+	return "invalid token"
+}
+
+type RuleNotFoundError struct{ ID any }
+
+func (e RuleNotFoundError) Error() string {
+	return fmt.Sprintf("rule with ID=%v not found", e.ID)
+}
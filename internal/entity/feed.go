@@ -21,7 +21,24 @@ type Feed struct {
 	Updated     *time.Time
 	IsStarred   bool
 	Tags        []string
-	Entries     map[ID]*Entry
+	// FolderID is the ID of the folder containing the feed, or nil if the feed is in no folder.
+	FolderID *ID
+	// FetchContent is whether the full content of new entries is fetched from their URL when the
+	// feed is pulled.
+	FetchContent bool
+	// PullFailures is the number of consecutive failed pulls of the feed.
+	PullFailures uint32
+	// LastPullError is the error message of the latest failed pull, if the feed is failing.
+	LastPullError *string
+	// NextPullTime is the earliest time the feed is pulled again, if the feed is backing off
+	// after failed pulls.
+	NextPullTime *time.Time
+	Entries      map[ID]*Entry
+}
+
+// IsFailing returns whether the latest pull of the feed failed.
+func (f *Feed) IsFailing() bool {
+	return f.PullFailures > 0
 }
 
 func (f *Feed) NumEntriesTotal() int {
@@ -129,4 +146,8 @@ type FeedEditOp struct {
 	Description *string
 	Tags        *[]string
 	IsStarred   *bool
+	// FolderID is the ID of the folder to move the feed into. Zero moves the feed out of its
+	// folder.
+	FolderID     *ID
+	FetchContent *bool
 }
@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import "github.com/bow/neon/api"
+
+// Folder groups feeds. Folders may contain other folders.
+type Folder struct {
+	ID   ID
+	Name string
+	// ParentID is the ID of the folder containing the folder, or nil for top-level folders.
+	ParentID *ID
+}
+
+// FolderEditOp describes changes to a folder. Nil fields are not changed.
+type FolderEditOp struct {
+	ID   ID
+	Name *string
+	// ParentID is the ID of the folder to move the folder into. Zero moves the folder to the
+	// top level.
+	ParentID *ID
+}
+
+func FromFolderPb(pb *api.Folder) *Folder {
+	if pb == nil {
+		return nil
+	}
+	return &Folder{
+		ID:       pb.GetId(),
+		Name:     pb.GetName(),
+		ParentID: pb.ParentId,
+	}
+}
+
+func FromFolderPbs(pbs []*api.Folder) []*Folder {
+	folders := make([]*Folder, 0, len(pbs))
+	for _, pb := range pbs {
+		if folder := FromFolderPb(pb); folder != nil {
+			folders = append(folders, folder)
+		}
+	}
+	return folders
+}
+
+func ToFolderPb(folder *Folder) *api.Folder {
+	return &api.Folder{
+		Id:       folder.ID,
+		Name:     folder.Name,
+		ParentId: folder.ParentID,
+	}
+}
+
+func ToFolderPbs(folders []*Folder) []*api.Folder {
+	pbs := make([]*api.Folder, len(folders))
+	for i, folder := range folders {
+		pbs[i] = ToFolderPb(folder)
+	}
+	return pbs
+}
+
+// FolderChildren groups the given folders by the IDs of their parents, keeping their order.
+// Top-level folders are grouped under zero.
+func FolderChildren(folders []*Folder) map[ID][]*Folder {
+	children := make(map[ID][]*Folder)
+	for _, folder := range folders {
+		var parentID ID
+		if folder.ParentID != nil {
+			parentID = *folder.ParentID
+		}
+		children[parentID] = append(children[parentID], folder)
+	}
+	return children
+}
+
+// WalkFolders visits the given folders depth first, starting from the top-level ones, with each
+// folder visited before its subfolders. The visit function is called with a folder and the value
+// returned for its parent, or root for top-level folders.
+func WalkFolders[T any](folders []*Folder, root T, visit func(folder *Folder, parent T) T) {
+	children := FolderChildren(folders)
+
+	var walk func(parentID ID, parent T, depth int)
+	walk = func(parentID ID, parent T, depth int) {
+		// Folders can not be nested deeper than their number, unless they form a cycle.
+		if depth > len(folders) {
+			return
+		}
+		for _, folder := range children[parentID] {
+			walk(folder.ID, visit(folder, parent), depth+1)
+		}
+	}
+	walk(0, root, 0)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalkFolders(t *testing.T) {
+	t.Parallel()
+
+	folders := []*Folder{
+		{ID: 1, Name: "Tech"},
+		{ID: 2, Name: "Go", ParentID: pointer(ID(1))},
+		{ID: 3, Name: "News"},
+		{ID: 4, Name: "Testing", ParentID: pointer(ID(2))},
+		// Folders 5 and 6 form a cycle, so they are not reachable from the top level.
+		{ID: 5, Name: "Loop A", ParentID: pointer(ID(6))},
+		{ID: 6, Name: "Loop B", ParentID: pointer(ID(5))},
+	}
+
+	paths := make([]string, 0)
+	WalkFolders(
+		folders,
+		"",
+		func(folder *Folder, parent string) string {
+			path := parent + "/" + folder.Name
+			paths = append(paths, path)
+			return path
+		},
+	)
+
+	assert.Equal(t, []string{"/Tech", "/Tech/Go", "/Tech/Go/Testing", "/News"}, paths)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"time"
+
+	"github.com/bow/neon/api"
+)
+
+// Page selects a part of a list result.
+type Page struct {
+	// Size is the maximum number of items returned. Zero means all remaining items.
+	Size uint32
+	// Token is the next page token returned by a previous list call, or empty for the first
+	// page. Subsequent calls must use the same filter and sort order as the first one.
+	Token string
+}
+
+// FeedFilter restricts the feeds returned by a list call. Empty fields do not restrict.
+type FeedFilter struct {
+	IDs []ID
+	// Tags selects feeds that have at least one of the given tags.
+	Tags      []string
+	IsStarred *bool
+}
+
+type FeedSortOrder uint8
+
+const (
+	// FeedSortUpdateTimeDesc sorts feeds by their latest update time, newest first. Feeds
+	// without update times are sorted by their subscription time.
+	FeedSortUpdateTimeDesc FeedSortOrder = iota
+	FeedSortUpdateTimeAsc
+	FeedSortTitleAsc
+	FeedSortTitleDesc
+)
+
+// EntryFilter restricts the entries returned by a list call. Empty fields do not restrict.
+// Time ranges include their lower bounds and exclude their upper bounds.
+type EntryFilter struct {
+	FeedIDs []ID
+	// FeedTags selects entries of feeds that have at least one of the given tags.
+	FeedTags        []string
+	IsFeedStarred   *bool
+	IsRead          *bool
+	IsBookmarked    *bool
+	PublishedAfter  *time.Time
+	PublishedBefore *time.Time
+	UpdatedAfter    *time.Time
+	UpdatedBefore   *time.Time
+	// Authors selects entries with at least one of the given authors, ignoring case.
+	Authors []string
+	// Tags selects entries that have at least one of the given tags.
+	Tags          []string
+	HasEnclosures *bool
+}
+
+type EntrySortOrder uint8
+
+const (
+	// EntrySortUpdateTimeDesc sorts entries by their update time, newest first. Entries
+	// without update times are sorted by their publication time.
+	EntrySortUpdateTimeDesc EntrySortOrder = iota
+	EntrySortUpdateTimeAsc
+	EntrySortPubTimeDesc
+	EntrySortPubTimeAsc
+)
+
+func FromFeedSortOrderPb(pb api.ListFeedsRequest_SortOrder) FeedSortOrder {
+	switch pb {
+	case api.ListFeedsRequest_SORT_ORDER_UPDATE_TIME_ASC:
+		return FeedSortUpdateTimeAsc
+	case api.ListFeedsRequest_SORT_ORDER_TITLE_ASC:
+		return FeedSortTitleAsc
+	case api.ListFeedsRequest_SORT_ORDER_TITLE_DESC:
+		return FeedSortTitleDesc
+	case api.ListFeedsRequest_SORT_ORDER_UPDATE_TIME_DESC:
+		return FeedSortUpdateTimeDesc
+	default:
+		return FeedSortUpdateTimeDesc
+	}
+}
+
+func FromEntrySortOrderPb(pb api.ListEntriesRequest_SortOrder) EntrySortOrder {
+	switch pb {
+	case api.ListEntriesRequest_SORT_ORDER_UPDATE_TIME_ASC:
+		return EntrySortUpdateTimeAsc
+	case api.ListEntriesRequest_SORT_ORDER_PUB_TIME_DESC:
+		return EntrySortPubTimeDesc
+	case api.ListEntriesRequest_SORT_ORDER_PUB_TIME_ASC:
+		return EntrySortPubTimeAsc
+	case api.ListEntriesRequest_SORT_ORDER_UPDATE_TIME_DESC:
+		return EntrySortUpdateTimeDesc
+	default:
+		return EntrySortUpdateTimeDesc
+	}
+}
+
+func ToFeedSortOrderPb(order FeedSortOrder) api.ListFeedsRequest_SortOrder {
+	switch order {
+	case FeedSortUpdateTimeAsc:
+		return api.ListFeedsRequest_SORT_ORDER_UPDATE_TIME_ASC
+	case FeedSortTitleAsc:
+		return api.ListFeedsRequest_SORT_ORDER_TITLE_ASC
+	case FeedSortTitleDesc:
+		return api.ListFeedsRequest_SORT_ORDER_TITLE_DESC
+	case FeedSortUpdateTimeDesc:
+		return api.ListFeedsRequest_SORT_ORDER_UPDATE_TIME_DESC
+	default:
+		return api.ListFeedsRequest_SORT_ORDER_UPDATE_TIME_DESC
+	}
+}
+
+func ToEntrySortOrderPb(order EntrySortOrder) api.ListEntriesRequest_SortOrder {
+	switch order {
+	case EntrySortUpdateTimeAsc:
+		return api.ListEntriesRequest_SORT_ORDER_UPDATE_TIME_ASC
+	case EntrySortPubTimeDesc:
+		return api.ListEntriesRequest_SORT_ORDER_PUB_TIME_DESC
+	case EntrySortPubTimeAsc:
+		return api.ListEntriesRequest_SORT_ORDER_PUB_TIME_ASC
+	case EntrySortUpdateTimeDesc:
+		return api.ListEntriesRequest_SORT_ORDER_UPDATE_TIME_DESC
+	default:
+		return api.ListEntriesRequest_SORT_ORDER_UPDATE_TIME_DESC
+	}
+}
@@ -3,12 +3,21 @@
 
 package entity
 
+import "time"
+
 // PullResult is a container for a pull operation.
 type PullResult struct {
 	status PullStatus
 	url    *string
+	feedID ID
 	feed   *Feed
 	err    error
+	// changed are the entries inserted or updated by the pull.
+	changed []*Entry
+	// newURL is the URL the feed has moved to, if the pull detected a move.
+	newURL *string
+	// duration is how long the pull took.
+	duration time.Duration
 }
 
 func NewPullResultFromFeed(url *string, feed *Feed) PullResult {
@@ -19,6 +28,16 @@ func NewPullResultFromError(url *string, err error) PullResult {
 	return PullResult{status: PullFail, url: url, err: err}
 }
 
+func NewPullResultNotModified(url *string) PullResult {
+	return PullResult{status: PullNotModified, url: url}
+}
+
+// NewPullResultSkipped returns a result of a feed that was not pulled because it is backing off
+// after failed pulls.
+func NewPullResultSkipped(url *string) PullResult {
+	return PullResult{status: PullSkipped, url: url}
+}
+
 func (msg PullResult) Feed() *Feed {
 	if msg.status == PullSuccess {
 		return msg.feed
@@ -33,6 +52,23 @@ func (msg PullResult) Error() error {
 	return nil
 }
 
+// ChangedEntries returns the entries that were inserted or updated by a successful pull.
+func (msg PullResult) ChangedEntries() []*Entry {
+	if msg.status == PullSuccess {
+		return msg.changed
+	}
+	return nil
+}
+
+func (msg PullResult) Status() PullStatus {
+	return msg.status
+}
+
+// FeedID returns the ID of the pulled feed, or zero if the result is not tied to a feed.
+func (msg PullResult) FeedID() ID {
+	return msg.feedID
+}
+
 func (msg PullResult) URL() string {
 	if msg.url != nil {
 		return *msg.url
@@ -40,6 +76,20 @@ func (msg PullResult) URL() string {
 	return ""
 }
 
+// NewURL returns the URL the feed has permanently moved to, or an empty string if the feed did
+// not move.
+func (msg PullResult) NewURL() string {
+	if msg.newURL != nil {
+		return *msg.newURL
+	}
+	return ""
+}
+
+// Duration returns how long the pull took. It is zero for results not produced by a pull.
+func (msg PullResult) Duration() time.Duration {
+	return msg.duration
+}
+
 func (msg *PullResult) SetError(err error) {
 	msg.err = err
 }
@@ -48,9 +98,44 @@ func (msg *PullResult) SetStatus(status PullStatus) {
 	msg.status = status
 }
 
+func (msg *PullResult) SetChangedEntries(entries []*Entry) {
+	msg.changed = entries
+}
+
+func (msg *PullResult) SetFeedID(id ID) {
+	msg.feedID = id
+}
+
+func (msg *PullResult) SetNewURL(url string) {
+	msg.newURL = &url
+}
+
+func (msg *PullResult) SetDuration(d time.Duration) {
+	msg.duration = d
+}
+
 type PullStatus int
 
 const (
 	PullSuccess PullStatus = iota
 	PullFail
+	// PullNotModified means the feed source reported no changes since the previous pull.
+	PullNotModified
+	// PullSkipped means the feed was not pulled because it is backing off after failed pulls.
+	PullSkipped
 )
+
+func (s PullStatus) String() string {
+	switch s {
+	case PullSuccess:
+		return "success"
+	case PullFail:
+		return "fail"
+	case PullNotModified:
+		return "not modified"
+	case PullSkipped:
+		return "skipped"
+	default:
+		return "unknown"
+	}
+}
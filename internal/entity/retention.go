@@ -0,0 +1,121 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bow/neon/api"
+)
+
+// RetentionPolicy determines which entries are removed when entries are pruned. Entries are
+// pruned if they exceed either limit, unless they are kept by one of the keep flags.
+type RetentionPolicy struct {
+	// FeedID is the ID of the feed to which the policy applies, or nil for the global policy.
+	// The global policy applies to all feeds without their own policies.
+	FeedID *ID
+	// MaxAge is the maximum age of entries, based on their update or publication times. Entries
+	// without either time are never too old.
+	MaxAge *time.Duration
+	// MaxEntries is the maximum number of entries kept per feed, newest first.
+	MaxEntries     *uint32
+	KeepBookmarked bool
+	KeepUnread     bool
+}
+
+func FromRetentionPolicyPb(pb *api.RetentionPolicy) *RetentionPolicy {
+	if pb == nil {
+		return nil
+	}
+	policy := RetentionPolicy{
+		FeedID:         pb.FeedId,
+		MaxEntries:     pb.MaxEntries,
+		KeepBookmarked: pb.GetKeepBookmarked(),
+		KeepUnread:     pb.GetKeepUnread(),
+	}
+	if pb.MaxAge != nil {
+		maxAge := pb.MaxAge.AsDuration()
+		policy.MaxAge = &maxAge
+	}
+	return &policy
+}
+
+func FromRetentionPolicyPbs(pbs []*api.RetentionPolicy) []*RetentionPolicy {
+	policies := make([]*RetentionPolicy, len(pbs))
+	for i, pb := range pbs {
+		policies[i] = FromRetentionPolicyPb(pb)
+	}
+	return policies
+}
+
+func ToRetentionPolicyPb(policy *RetentionPolicy) *api.RetentionPolicy {
+	if policy == nil {
+		return nil
+	}
+	pb := api.RetentionPolicy{
+		FeedId:         policy.FeedID,
+		MaxEntries:     policy.MaxEntries,
+		KeepBookmarked: policy.KeepBookmarked,
+		KeepUnread:     policy.KeepUnread,
+	}
+	if policy.MaxAge != nil {
+		pb.MaxAge = durationpb.New(*policy.MaxAge)
+	}
+	return &pb
+}
+
+func ToRetentionPolicyPbs(policies []*RetentionPolicy) []*api.RetentionPolicy {
+	pbs := make([]*api.RetentionPolicy, len(policies))
+	for i, policy := range policies {
+		pbs[i] = ToRetentionPolicyPb(policy)
+	}
+	return pbs
+}
+
+// PruneResult reports the entries of a feed pruned by a prune operation.
+type PruneResult struct {
+	FeedID    ID
+	FeedTitle string
+	NumPruned uint32
+	NumKept   uint32
+}
+
+func FromPruneResultPb(pb *api.PruneEntriesResponse_Result) *PruneResult {
+	if pb == nil {
+		return nil
+	}
+	return &PruneResult{
+		FeedID:    pb.GetFeedId(),
+		FeedTitle: pb.GetFeedTitle(),
+		NumPruned: pb.GetNumPruned(),
+		NumKept:   pb.GetNumKept(),
+	}
+}
+
+func FromPruneResultPbs(pbs []*api.PruneEntriesResponse_Result) []*PruneResult {
+	results := make([]*PruneResult, len(pbs))
+	for i, pb := range pbs {
+		results[i] = FromPruneResultPb(pb)
+	}
+	return results
+}
+
+func ToPruneResultPb(result *PruneResult) *api.PruneEntriesResponse_Result {
+	return &api.PruneEntriesResponse_Result{
+		FeedId:    result.FeedID,
+		FeedTitle: result.FeedTitle,
+		NumPruned: result.NumPruned,
+		NumKept:   result.NumKept,
+	}
+}
+
+func ToPruneResultPbs(results []*PruneResult) []*api.PruneEntriesResponse_Result {
+	pbs := make([]*api.PruneEntriesResponse_Result, len(results))
+	for i, result := range results {
+		pbs[i] = ToPruneResultPb(result)
+	}
+	return pbs
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bow/neon/api"
+)
+
+// Rule is a user-defined rule applied to new entries when feeds are pulled. Entries whose field
+// matches the rule pattern are acted upon with the rule action.
+type Rule struct {
+	ID ID
+	// FeedID is the ID of the feed to whose entries the rule applies, or nil for all feeds.
+	FeedID    *ID
+	Field     RuleField
+	MatchType RuleMatchType
+	Pattern   string
+	Action    RuleAction
+	// Tag is the tag added to matching entries by tag rules. It is empty for other rules.
+	Tag        string
+	CreateTime time.Time
+}
+
+// RuleField is the entry field matched by a rule.
+type RuleField uint8
+
+const (
+	RuleFieldTitle RuleField = iota + 1
+	// RuleFieldContent matches either the description or the content of entries.
+	RuleFieldContent
+	// RuleFieldAuthor matches the name of any of the entry authors.
+	RuleFieldAuthor
+	RuleFieldURL
+)
+
+func (f RuleField) String() string {
+	switch f {
+	case RuleFieldTitle:
+		return "title"
+	case RuleFieldContent:
+		return "content"
+	case RuleFieldAuthor:
+		return "author"
+	case RuleFieldURL:
+		return "url"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseRuleField parses the given string representation of a rule field.
+func ParseRuleField(value string) (RuleField, error) {
+	for _, field := range []RuleField{
+		RuleFieldTitle,
+		RuleFieldContent,
+		RuleFieldAuthor,
+		RuleFieldURL,
+	} {
+		if value == field.String() {
+			return field, nil
+		}
+	}
+	return 0, InvalidArgumentError{Reason: fmt.Sprintf("unknown rule field %q", value)}
+}
+
+// RuleMatchType determines how a rule pattern is matched against an entry field.
+type RuleMatchType uint8
+
+const (
+	// RuleMatchSubstring matches fields containing the pattern, ignoring case.
+	RuleMatchSubstring RuleMatchType = iota + 1
+	// RuleMatchRegex matches fields against the pattern as a regular expression.
+	RuleMatchRegex
+)
+
+func (m RuleMatchType) String() string {
+	switch m {
+	case RuleMatchSubstring:
+		return "substring"
+	case RuleMatchRegex:
+		return "regex"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseRuleMatchType parses the given string representation of a rule match type.
+func ParseRuleMatchType(value string) (RuleMatchType, error) {
+	for _, mt := range []RuleMatchType{RuleMatchSubstring, RuleMatchRegex} {
+		if value == mt.String() {
+			return mt, nil
+		}
+	}
+	return 0, InvalidArgumentError{Reason: fmt.Sprintf("unknown rule match type %q", value)}
+}
+
+// RuleAction is what a rule does with matching entries.
+type RuleAction uint8
+
+const (
+	RuleActionMarkRead RuleAction = iota + 1
+	RuleActionBookmark
+	RuleActionTag
+	// RuleActionDrop drops matching entries, so that they are never added.
+	RuleActionDrop
+)
+
+func (a RuleAction) String() string {
+	switch a {
+	case RuleActionMarkRead:
+		return "read"
+	case RuleActionBookmark:
+		return "bookmark"
+	case RuleActionTag:
+		return "tag"
+	case RuleActionDrop:
+		return "drop"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseRuleAction parses the given string representation of a rule action.
+func ParseRuleAction(value string) (RuleAction, error) {
+	for _, action := range []RuleAction{
+		RuleActionMarkRead,
+		RuleActionBookmark,
+		RuleActionTag,
+		RuleActionDrop,
+	} {
+		if value == action.String() {
+			return action, nil
+		}
+	}
+	return 0, InvalidArgumentError{Reason: fmt.Sprintf("unknown rule action %q", value)}
+}
+
+// FromRulePb converts the given protobuf rule. Unspecified enum values become unknown values,
+// which are rejected when the rule is checked.
+func FromRulePb(pb *api.Rule) *Rule {
+	if pb == nil {
+		return nil
+	}
+	rule := Rule{
+		ID:      pb.GetId(),
+		FeedID:  pb.FeedId,
+		Pattern: pb.GetPattern(),
+		Tag:     pb.GetTag(),
+	}
+	if pb.GetCreateTime() != nil {
+		rule.CreateTime = pb.GetCreateTime().AsTime()
+	}
+
+	switch pb.GetField() {
+	case api.Rule_FIELD_TITLE:
+		rule.Field = RuleFieldTitle
+	case api.Rule_FIELD_CONTENT:
+		rule.Field = RuleFieldContent
+	case api.Rule_FIELD_AUTHOR:
+		rule.Field = RuleFieldAuthor
+	case api.Rule_FIELD_URL:
+		rule.Field = RuleFieldURL
+	case api.Rule_FIELD_UNSPECIFIED:
+	}
+
+	switch pb.GetMatchType() {
+	case api.Rule_MATCH_TYPE_SUBSTRING:
+		rule.MatchType = RuleMatchSubstring
+	case api.Rule_MATCH_TYPE_REGEX:
+		rule.MatchType = RuleMatchRegex
+	case api.Rule_MATCH_TYPE_UNSPECIFIED:
+	}
+
+	switch pb.GetAction() {
+	case api.Rule_ACTION_MARK_READ:
+		rule.Action = RuleActionMarkRead
+	case api.Rule_ACTION_BOOKMARK:
+		rule.Action = RuleActionBookmark
+	case api.Rule_ACTION_TAG:
+		rule.Action = RuleActionTag
+	case api.Rule_ACTION_DROP:
+		rule.Action = RuleActionDrop
+	case api.Rule_ACTION_UNSPECIFIED:
+	}
+
+	return &rule
+}
+
+func FromRulePbs(pbs []*api.Rule) []*Rule {
+	rules := make([]*Rule, 0, len(pbs))
+	for _, pb := range pbs {
+		if rule := FromRulePb(pb); rule != nil {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// RuleTarget contains the entry values that rules are matched against.
+type RuleTarget struct {
+	FeedID      ID
+	Title       string
+	Description string
+	Content     string
+	Authors     []string
+	URL         string
+}
+
+// RuleMatcher matches entries against a rule.
+type RuleMatcher struct {
+	rule    *Rule
+	pattern string
+	re      *regexp.Regexp
+}
+
+// NewRuleMatcher checks the given rule and creates a matcher for it.
+func NewRuleMatcher(rule *Rule) (*RuleMatcher, error) {
+	if rule.Field.String() == "unknown" {
+		return nil, InvalidArgumentError{Reason: "rule field is unknown"}
+	}
+	if rule.MatchType.String() == "unknown" {
+		return nil, InvalidArgumentError{Reason: "rule match type is unknown"}
+	}
+	if rule.Action.String() == "unknown" {
+		return nil, InvalidArgumentError{Reason: "rule action is unknown"}
+	}
+	if rule.Pattern == "" {
+		return nil, InvalidArgumentError{Reason: "rule pattern is empty"}
+	}
+	if rule.Action == RuleActionTag && rule.Tag == "" {
+		return nil, InvalidArgumentError{Reason: "tag rules require a tag"}
+	}
+	if rule.Action != RuleActionTag && rule.Tag != "" {
+		return nil, InvalidArgumentError{Reason: "only tag rules may have a tag"}
+	}
+
+	m := RuleMatcher{rule: rule}
+	switch rule.MatchType {
+	case RuleMatchSubstring:
+		m.pattern = strings.ToLower(rule.Pattern)
+	case RuleMatchRegex:
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, InvalidArgumentError{Reason: fmt.Sprintf("invalid rule pattern: %s", err)}
+		}
+		m.re = re
+	}
+
+	return &m, nil
+}
+
+// Rule returns the rule of the matcher.
+func (m *RuleMatcher) Rule() *Rule {
+	return m.rule
+}
+
+// Matches checks whether the rule applies to the given target.
+func (m *RuleMatcher) Matches(target *RuleTarget) bool {
+	if m.rule.FeedID != nil && *m.rule.FeedID != target.FeedID {
+		return false
+	}
+
+	var values []string
+	switch m.rule.Field {
+	case RuleFieldTitle:
+		values = []string{target.Title}
+	case RuleFieldContent:
+		values = []string{target.Description, target.Content}
+	case RuleFieldAuthor:
+		values = target.Authors
+	case RuleFieldURL:
+		values = []string{target.URL}
+	}
+
+	for _, value := range values {
+		if m.matchesValue(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *RuleMatcher) matchesValue(value string) bool {
+	if value == "" {
+		return false
+	}
+	if m.re != nil {
+		return m.re.MatchString(value)
+	}
+	return strings.Contains(strings.ToLower(value), m.pattern)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleMatcherMatches(t *testing.T) {
+	target := RuleTarget{
+		FeedID:      2,
+		Title:       "Sponsored: Buy Now",
+		Description: "A short summary",
+		Content:     "The full text",
+		Authors:     []string{"Jane Doe", "John Roe"},
+		URL:         "https://a.com/ads/1",
+	}
+
+	tests := []struct {
+		name string
+		rule Rule
+		want bool
+	}{
+		{
+			name: "substring ignores case",
+			rule: Rule{Field: RuleFieldTitle, MatchType: RuleMatchSubstring, Pattern: "sponsored"},
+			want: true,
+		},
+		{
+			name: "substring mismatch",
+			rule: Rule{Field: RuleFieldTitle, MatchType: RuleMatchSubstring, Pattern: "free"},
+			want: false,
+		},
+		{
+			name: "regex",
+			rule: Rule{Field: RuleFieldURL, MatchType: RuleMatchRegex, Pattern: `/ads/\d+$`},
+			want: true,
+		},
+		{
+			name: "regex is case-sensitive",
+			rule: Rule{Field: RuleFieldTitle, MatchType: RuleMatchRegex, Pattern: `^sponsored`},
+			want: false,
+		},
+		{
+			name: "content matches description",
+			rule: Rule{Field: RuleFieldContent, MatchType: RuleMatchSubstring, Pattern: "summary"},
+			want: true,
+		},
+		{
+			name: "content matches content",
+			rule: Rule{Field: RuleFieldContent, MatchType: RuleMatchSubstring, Pattern: "full"},
+			want: true,
+		},
+		{
+			name: "any author",
+			rule: Rule{Field: RuleFieldAuthor, MatchType: RuleMatchRegex, Pattern: `^John`},
+			want: true,
+		},
+		{
+			name: "other feed",
+			rule: Rule{
+				FeedID:    pointer(ID(1)),
+				Field:     RuleFieldTitle,
+				MatchType: RuleMatchSubstring,
+				Pattern:   "sponsored",
+			},
+			want: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := test.rule
+			rule.Action = RuleActionDrop
+			m, err := NewRuleMatcher(&rule)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, m.Matches(&target))
+		})
+	}
+}
+
+func TestNewRuleMatcherErr(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+		want string
+	}{
+		{
+			name: "empty pattern",
+			rule: Rule{Field: RuleFieldTitle, MatchType: RuleMatchSubstring, Action: RuleActionDrop},
+			want: "invalid argument: rule pattern is empty",
+		},
+		{
+			name: "unknown field",
+			rule: Rule{MatchType: RuleMatchSubstring, Pattern: "x", Action: RuleActionDrop},
+			want: "invalid argument: rule field is unknown",
+		},
+		{
+			name: "tag missing",
+			rule: Rule{
+				Field:     RuleFieldTitle,
+				MatchType: RuleMatchSubstring,
+				Pattern:   "x",
+				Action:    RuleActionTag,
+			},
+			want: "invalid argument: tag rules require a tag",
+		},
+		{
+			name: "tag not allowed",
+			rule: Rule{
+				Field:     RuleFieldTitle,
+				MatchType: RuleMatchSubstring,
+				Pattern:   "x",
+				Action:    RuleActionDrop,
+				Tag:       "ads",
+			},
+			want: "invalid argument: only tag rules may have a tag",
+		},
+		{
+			name: "invalid regex",
+			rule: Rule{
+				Field:     RuleFieldTitle,
+				MatchType: RuleMatchRegex,
+				Pattern:   "(",
+				Action:    RuleActionDrop,
+			},
+			want: "invalid argument: invalid rule pattern: error parsing regexp: " +
+				"missing closing ): `(`",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := NewRuleMatcher(&test.rule)
+			assert.Nil(t, m)
+			assert.EqualError(t, err, test.want)
+		})
+	}
+}
+
+func TestParseRuleAction(t *testing.T) {
+	action, err := ParseRuleAction("bookmark")
+	require.NoError(t, err)
+	assert.Equal(t, RuleActionBookmark, action)
+
+	_, err = ParseRuleAction("delete")
+	assert.EqualError(t, err, `invalid argument: unknown rule action "delete"`)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"strings"
+
+	"github.com/bow/neon/api"
+)
+
+const (
+	// HighlightStart marks the start of a matched term in search result highlights.
+	HighlightStart = "\x02"
+	// HighlightEnd marks the end of a matched term in search result highlights.
+	HighlightEnd = "\x03"
+)
+
+// SearchResult is an entry matching a full-text search query.
+type SearchResult struct {
+	Entry *Entry
+	// TitleHighlight is the entry title, with matched terms enclosed in HighlightStart and
+	// HighlightEnd.
+	TitleHighlight string
+	// Snippet is the best-matching fragment of the entry text, with matched terms enclosed in
+	// HighlightStart and HighlightEnd.
+	Snippet string
+	// Score is the relevance of the entry; higher values mean more relevant entries.
+	Score float64
+}
+
+// ReplaceHighlights replaces the highlight markers in the given text with the given strings.
+func ReplaceHighlights(text, start, end string) string {
+	return strings.NewReplacer(HighlightStart, start, HighlightEnd, end).Replace(text)
+}
+
+func FromSearchResultPbs(pbs []*api.SearchEntriesResponse_Result) []*SearchResult {
+	results := make([]*SearchResult, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb == nil {
+			continue
+		}
+		results = append(results, &SearchResult{
+			Entry:          FromEntryPb(pb.GetEntry()),
+			TitleHighlight: pb.GetTitleHighlight(),
+			Snippet:        pb.GetSnippet(),
+			Score:          pb.GetScore(),
+		})
+	}
+	return results
+}
+
+func ToSearchResultPbs(results []*SearchResult) []*api.SearchEntriesResponse_Result {
+	pbs := make([]*api.SearchEntriesResponse_Result, len(results))
+	for i, result := range results {
+		pbs[i] = &api.SearchEntriesResponse_Result{
+			Entry:          ToEntryPb(result.Entry),
+			TitleHighlight: result.TitleHighlight,
+			Snippet:        result.Snippet,
+			Score:          result.Score,
+		}
+	}
+	return pbs
+}
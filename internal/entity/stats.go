@@ -12,3 +12,12 @@ type Stats struct {
 	LastPullTime         *time.Time
 	MostRecentUpdateTime *time.Time
 }
+
+type SchedulerStats struct {
+	NumFeeds       uint32
+	NumPullsOK     uint32
+	NumPullsFailed uint32
+	LastPullTime   *time.Time
+	NextPullTime   *time.Time
+	LastError      *string
+}
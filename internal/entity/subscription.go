@@ -13,6 +13,9 @@ import (
 type Subscription struct {
 	Title *string
 	Feeds []*Feed
+	// Folders are the folders containing the feeds. The folder IDs of subscriptions parsed from
+	// OPML documents are only meaningful within the subscription.
+	Folders []*Folder
 }
 
 func NewSubscriptionFromRawOPML(payload []byte) (*Subscription, error) {
@@ -27,10 +30,31 @@ func NewSubscriptionFromRawOPML(payload []byte) (*Subscription, error) {
 
 func NewSubscriptionFromOPML(doc *opml.Doc) (*Subscription, error) {
 
-	feeds := make([]*Feed, len(doc.Body.Outlines))
-	for i, outl := range doc.Body.Outlines {
+	sub := Subscription{Feeds: make([]*Feed, 0)}
+	if err := sub.addOutlines(doc.Body.Outlines, nil); err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+// addOutlines adds the feeds and folders of the given outlines, which are contained in the folder
+// with the given ID.
+func (sub *Subscription) addOutlines(outls []*opml.Outline, folderID *ID) error {
+	for _, outl := range outls {
+		if outl.IsFolder() {
+			if outl.Text == "" {
+				return fmt.Errorf("missing title for folder in OPML document")
+			}
+			folder := Folder{ID: ID(len(sub.Folders) + 1), Name: outl.Text, ParentID: folderID}
+			sub.Folders = append(sub.Folders, &folder)
+			if err := sub.addOutlines(outl.Outlines, &folder.ID); err != nil {
+				return err
+			}
+			continue
+		}
 		if outl.Text == "" {
-			return nil, fmt.Errorf(
+			return fmt.Errorf(
 				"missing title for feed with URL=%s in OPML document", outl.XMLURL,
 			)
 		}
@@ -40,6 +64,7 @@ func NewSubscriptionFromOPML(doc *opml.Doc) (*Subscription, error) {
 			FeedURL:     outl.XMLURL,
 			SiteURL:     outl.HTMLURL,
 			Tags:        outl.Categories,
+			FolderID:    folderID,
 
 			ID:         0,
 			Subscribed: time.Time{},
@@ -48,12 +73,9 @@ func NewSubscriptionFromOPML(doc *opml.Doc) (*Subscription, error) {
 		if star := outl.IsStarred; star != nil {
 			feed.IsStarred = *star
 		}
-		feeds[i] = &feed
+		sub.Feeds = append(sub.Feeds, &feed)
 	}
-
-	sub := Subscription{Feeds: feeds}
-
-	return &sub, nil
+	return nil
 }
 
 func (sub *Subscription) Export() ([]byte, error) {
@@ -62,11 +84,53 @@ func (sub *Subscription) Export() ([]byte, error) {
 		et = *sub.Title
 	}
 
-	doc := opml.New(et, time.Now())
+	var (
+		doc   = opml.New(et, time.Now())
+		feeds = make(map[ID][]*Feed)
+	)
+	known := make(map[ID]struct{}, len(sub.Folders))
+	for _, folder := range sub.Folders {
+		known[folder.ID] = struct{}{}
+	}
 	for _, feed := range sub.Feeds {
-		if err := doc.AddOutline(feed); err != nil {
-			return nil, err
+		var folderID ID
+		if feed.FolderID != nil {
+			if _, ok := known[*feed.FolderID]; ok {
+				folderID = *feed.FolderID
+			}
+		}
+		feeds[folderID] = append(feeds[folderID], feed)
+	}
+
+	// Folder outlines are nested first, so that subfolders come before the feeds of a folder.
+	var (
+		top       = opml.Outline{Outlines: make([]*opml.Outline, 0)}
+		outlByIDs = map[ID]*opml.Outline{0: &top}
+	)
+	WalkFolders(
+		sub.Folders,
+		&top,
+		func(folder *Folder, parent *opml.Outline) *opml.Outline {
+			outl := opml.Outline{Text: folder.Name, Outlines: make([]*opml.Outline, 0)}
+			parent.Outlines = append(parent.Outlines, &outl)
+			outlByIDs[folder.ID] = &outl
+			return &outl
+		},
+	)
+	for folderID, folderFeeds := range feeds {
+		parent, ok := outlByIDs[folderID]
+		if !ok {
+			continue
+		}
+		for _, feed := range folderFeeds {
+			outl, err := feed.Outline()
+			if err != nil {
+				return nil, err
+			}
+			parent.Outlines = append(parent.Outlines, outl)
 		}
 	}
+	doc.Body.Outlines = top.Outlines
+
 	return doc.XML()
 }
@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSubscriptionFromRawOPMLOkFolders(t *testing.T) {
+	raw := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head>
+    <title>subscriptions</title>
+  </head>
+  <body>
+    <outline text="News">
+      <outline text="Tech">
+        <outline text="Feed A" type="rss" xmlUrl="http://a.com/feed.xml"/>
+      </outline>
+      <outline text="Feed B" type="rss" xmlUrl="http://b.com/feed.xml"/>
+    </outline>
+    <outline text="Feed C" type="rss" xmlUrl="http://c.com/feed.xml"/>
+  </body>
+</opml>
+`
+	a := assert.New(t)
+	r := require.New(t)
+
+	sub, err := NewSubscriptionFromRawOPML([]byte(raw))
+	r.NoError(err)
+
+	a.Equal(
+		[]*Folder{
+			{ID: 1, Name: "News"},
+			{ID: 2, Name: "Tech", ParentID: pointer(ID(1))},
+		},
+		sub.Folders,
+	)
+	r.Len(sub.Feeds, 3)
+	a.Equal("Feed A", sub.Feeds[0].Title)
+	a.Equal(pointer(ID(2)), sub.Feeds[0].FolderID)
+	a.Equal("Feed B", sub.Feeds[1].Title)
+	a.Equal(pointer(ID(1)), sub.Feeds[1].FolderID)
+	a.Equal("Feed C", sub.Feeds[2].Title)
+	a.Nil(sub.Feeds[2].FolderID)
+}
+
+func TestNewSubscriptionFromRawOPMLErrFolderTitle(t *testing.T) {
+	raw := `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <body>
+    <outline>
+      <outline text="Feed A" type="rss" xmlUrl="http://a.com/feed.xml"/>
+    </outline>
+  </body>
+</opml>
+`
+	a := assert.New(t)
+
+	sub, err := NewSubscriptionFromRawOPML([]byte(raw))
+	a.Nil(sub)
+	a.EqualError(err, "missing title for folder in OPML document")
+}
+
+func TestSubscriptionExportOkFolders(t *testing.T) {
+	a := assert.New(t)
+	r := require.New(t)
+
+	sub := Subscription{
+		Feeds: []*Feed{
+			{Title: "Feed A", FeedURL: "http://a.com/feed.xml", FolderID: pointer(ID(7))},
+			{Title: "Feed B", FeedURL: "http://b.com/feed.xml", FolderID: pointer(ID(3))},
+			{Title: "Feed C", FeedURL: "http://c.com/feed.xml", FolderID: pointer(ID(404))},
+		},
+		Folders: []*Folder{
+			{ID: 3, Name: "News"},
+			{ID: 7, Name: "Tech", ParentID: pointer(ID(3))},
+		},
+	}
+
+	payload, err := sub.Export()
+	r.NoError(err)
+
+	// Feeds in unknown folders are exported at the top level.
+	rsub, err := NewSubscriptionFromRawOPML(payload)
+	r.NoError(err)
+	a.Equal(
+		[]*Folder{
+			{ID: 1, Name: "News"},
+			{ID: 2, Name: "Tech", ParentID: pointer(ID(1))},
+		},
+		rsub.Folders,
+	)
+	r.Len(rsub.Feeds, 3)
+	a.Equal("Feed A", rsub.Feeds[0].Title)
+	a.Equal(pointer(ID(2)), rsub.Feeds[0].FolderID)
+	a.Equal("Feed B", rsub.Feeds[1].Title)
+	a.Equal(pointer(ID(1)), rsub.Feeds[1].FolderID)
+	a.Equal("Feed C", rsub.Feeds[2].Title)
+	a.Nil(rsub.Feeds[2].FolderID)
+}
+
+func pointer[T any](value T) *T { return &value }
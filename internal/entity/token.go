@@ -0,0 +1,60 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Token is an API token for authenticating service clients. The token secret itself is only
+// known when the token is created.
+type Token struct {
+	ID         ID
+	Name       string
+	Scope      TokenScope
+	CreateTime time.Time
+}
+
+// TokenScope determines the operations allowed for a token. Each scope also allows the
+// operations of the scopes before it.
+type TokenScope uint8
+
+const (
+	// TokenScopeRead allows operations that do not modify feeds or entries.
+	TokenScopeRead TokenScope = iota + 1
+	// TokenScopeWrite allows adding, editing, and pulling feeds, and editing entries.
+	TokenScopeWrite
+	// TokenScopeAdmin allows all operations, including deleting feeds and importing
+	// subscriptions.
+	TokenScopeAdmin
+)
+
+func (s TokenScope) String() string {
+	switch s {
+	case TokenScopeRead:
+		return "read"
+	case TokenScopeWrite:
+		return "write"
+	case TokenScopeAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+// Allows checks whether operations requiring the given scope are allowed.
+func (s TokenScope) Allows(required TokenScope) bool {
+	return s >= required
+}
+
+// ParseTokenScope parses the given string representation of a token scope.
+func ParseTokenScope(value string) (TokenScope, error) {
+	for _, scope := range []TokenScope{TokenScopeRead, TokenScopeWrite, TokenScopeAdmin} {
+		if value == scope.String() {
+			return scope, nil
+		}
+	}
+	return 0, InvalidArgumentError{Reason: fmt.Sprintf("unknown token scope %q", value)}
+}
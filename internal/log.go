@@ -25,12 +25,35 @@ const (
 	jsonLogStyle
 )
 
+// Default logging settings, used when they are not set in the environment.
+const (
+	DefaultLogLevel = "info"
+	DefaultLogStyle = "pretty"
+)
+
 func MustSetupLogging(writer io.Writer) {
 	level := getOrExit("log-level", parseLogLevel, zerolog.InfoLevel)
 	style := getOrExit("log-style", parseLogStyle, prettyLogStyle)
 	setupLogging(level, style, writer)
 }
 
+// SetupLogging sets up logging with the given level and style, written like the values of
+// their environment variables.
+func SetupLogging(rawLevel, rawStyle string, writer io.Writer) error {
+	level, style, err := parseLogging(rawLevel, rawStyle)
+	if err != nil {
+		return err
+	}
+	setupLogging(level, style, writer)
+	return nil
+}
+
+// ValidateLogging returns an error if the given level or style is invalid.
+func ValidateLogging(rawLevel, rawStyle string) error {
+	_, _, err := parseLogging(rawLevel, rawStyle)
+	return err
+}
+
 func setupLogging(
 	level zerolog.Level,
 	style logStyle,
@@ -68,6 +91,18 @@ func setupLogging(
 	zlog.Logger = zerolog.New(cw).With().Timestamp().Logger()
 }
 
+func parseLogging(rawLevel, rawStyle string) (zerolog.Level, logStyle, error) {
+	level, err := parseLogLevel(rawLevel)
+	if err != nil {
+		return zerolog.NoLevel, noLogStyle, err
+	}
+	style, err := parseLogStyle(rawStyle)
+	if err != nil {
+		return zerolog.NoLevel, noLogStyle, err
+	}
+	return level, style, nil
+}
+
 func parseLogLevel(raw string) (zerolog.Level, error) {
 	level, err := zerolog.ParseLevel(strings.ToLower(raw))
 	if err != nil {
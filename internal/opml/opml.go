@@ -114,17 +114,24 @@ type Body struct {
 	Outlines []*Outline `xml:"outline"`
 }
 
-// Outline is a single outline item in the OPML body. It represents a single subscription / feed.
-// Nesting is not supported.
+// Outline is a single outline item in the OPML body. It represents either a single subscription /
+// feed, or a folder when it has no XML URL. Folders contain nested outlines.
 type Outline struct {
 	Text   string `xml:"text,attr"`
-	Type   string `xml:"type,attr"`
-	XMLURL string `xml:"xmlUrl,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	XMLURL string `xml:"xmlUrl,attr,omitempty"`
 
 	Categories  Categories `xml:"category,attr"`
 	Description *string    `xml:"description,attr"`
 	HTMLURL     *string    `xml:"htmlUrl,attr"`
 	IsStarred   *bool      `xml:"https://github.com/bow/neon isStarred,attr,omitempty"`
+
+	Outlines []*Outline `xml:"outline"`
+}
+
+// IsFolder checks whether the outline is a folder instead of a subscription.
+func (outl *Outline) IsFolder() bool {
+	return outl.XMLURL == ""
 }
 
 type Outliner interface {
@@ -11,9 +11,13 @@ import (
 
 // Backend describes the console backend.
 type Backend interface {
+	EditEntriesF(context.Context, []*entity.EntryEditOp) func() ([]*entity.Entry, error)
 	GetStatsF(context.Context) func() (*entity.Stats, error)
 	GetAllFeedsF(context.Context) func() ([]*entity.Feed, error)
+	ListFoldersF(context.Context) func() ([]*entity.Folder, error)
 	PullFeedsF(context.Context, []entity.ID) func() (<-chan entity.PullResult, error)
+	SearchEntriesF(context.Context, string) func() ([]*entity.SearchResult, error)
+	StreamEntriesF(context.Context) func() (<-chan *entity.Entry, <-chan error, error)
 	String() string
 }
 
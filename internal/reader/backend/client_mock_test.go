@@ -62,6 +62,46 @@ func (mr *MockNeonClientMockRecorder) AddFeed(ctx, in any, opts ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFeed", reflect.TypeOf((*MockNeonClient)(nil).AddFeed), varargs...)
 }
 
+// CreateFolder mocks base method.
+func (m *MockNeonClient) CreateFolder(ctx context.Context, in *api.CreateFolderRequest, opts ...grpc.CallOption) (*api.CreateFolderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateFolder", varargs...)
+	ret0, _ := ret[0].(*api.CreateFolderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockNeonClientMockRecorder) CreateFolder(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockNeonClient)(nil).CreateFolder), varargs...)
+}
+
+// CreateRule mocks base method.
+func (m *MockNeonClient) CreateRule(ctx context.Context, in *api.CreateRuleRequest, opts ...grpc.CallOption) (*api.CreateRuleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRule", varargs...)
+	ret0, _ := ret[0].(*api.CreateRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockNeonClientMockRecorder) CreateRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockNeonClient)(nil).CreateRule), varargs...)
+}
+
 // DeleteFeeds mocks base method.
 func (m *MockNeonClient) DeleteFeeds(ctx context.Context, in *api.DeleteFeedsRequest, opts ...grpc.CallOption) (*api.DeleteFeedsResponse, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +122,86 @@ func (mr *MockNeonClientMockRecorder) DeleteFeeds(ctx, in any, opts ...any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeds", reflect.TypeOf((*MockNeonClient)(nil).DeleteFeeds), varargs...)
 }
 
+// DeleteFolders mocks base method.
+func (m *MockNeonClient) DeleteFolders(ctx context.Context, in *api.DeleteFoldersRequest, opts ...grpc.CallOption) (*api.DeleteFoldersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteFolders", varargs...)
+	ret0, _ := ret[0].(*api.DeleteFoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFolders indicates an expected call of DeleteFolders.
+func (mr *MockNeonClientMockRecorder) DeleteFolders(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolders", reflect.TypeOf((*MockNeonClient)(nil).DeleteFolders), varargs...)
+}
+
+// DeleteRetentionPolicy mocks base method.
+func (m *MockNeonClient) DeleteRetentionPolicy(ctx context.Context, in *api.DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*api.DeleteRetentionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRetentionPolicy", varargs...)
+	ret0, _ := ret[0].(*api.DeleteRetentionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionPolicy indicates an expected call of DeleteRetentionPolicy.
+func (mr *MockNeonClientMockRecorder) DeleteRetentionPolicy(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicy", reflect.TypeOf((*MockNeonClient)(nil).DeleteRetentionPolicy), varargs...)
+}
+
+// DeleteRules mocks base method.
+func (m *MockNeonClient) DeleteRules(ctx context.Context, in *api.DeleteRulesRequest, opts ...grpc.CallOption) (*api.DeleteRulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRules", varargs...)
+	ret0, _ := ret[0].(*api.DeleteRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRules indicates an expected call of DeleteRules.
+func (mr *MockNeonClientMockRecorder) DeleteRules(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRules", reflect.TypeOf((*MockNeonClient)(nil).DeleteRules), varargs...)
+}
+
+// DiscoverFeeds mocks base method.
+func (m *MockNeonClient) DiscoverFeeds(ctx context.Context, in *api.DiscoverFeedsRequest, opts ...grpc.CallOption) (*api.DiscoverFeedsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiscoverFeeds", varargs...)
+	ret0, _ := ret[0].(*api.DiscoverFeedsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverFeeds indicates an expected call of DiscoverFeeds.
+func (mr *MockNeonClientMockRecorder) DiscoverFeeds(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverFeeds", reflect.TypeOf((*MockNeonClient)(nil).DiscoverFeeds), varargs...)
+}
+
 // EditEntries mocks base method.
 func (m *MockNeonClient) EditEntries(ctx context.Context, in *api.EditEntriesRequest, opts ...grpc.CallOption) (*api.EditEntriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +242,26 @@ func (mr *MockNeonClientMockRecorder) EditFeeds(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFeeds", reflect.TypeOf((*MockNeonClient)(nil).EditFeeds), varargs...)
 }
 
+// EditFolders mocks base method.
+func (m *MockNeonClient) EditFolders(ctx context.Context, in *api.EditFoldersRequest, opts ...grpc.CallOption) (*api.EditFoldersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EditFolders", varargs...)
+	ret0, _ := ret[0].(*api.EditFoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditFolders indicates an expected call of EditFolders.
+func (mr *MockNeonClientMockRecorder) EditFolders(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFolders", reflect.TypeOf((*MockNeonClient)(nil).EditFolders), varargs...)
+}
+
 // ExportOPML mocks base method.
 func (m *MockNeonClient) ExportOPML(ctx context.Context, in *api.ExportOPMLRequest, opts ...grpc.CallOption) (*api.ExportOPMLResponse, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +282,26 @@ func (mr *MockNeonClientMockRecorder) ExportOPML(ctx, in any, opts ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOPML", reflect.TypeOf((*MockNeonClient)(nil).ExportOPML), varargs...)
 }
 
+// FetchEntryContent mocks base method.
+func (m *MockNeonClient) FetchEntryContent(ctx context.Context, in *api.FetchEntryContentRequest, opts ...grpc.CallOption) (*api.FetchEntryContentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchEntryContent", varargs...)
+	ret0, _ := ret[0].(*api.FetchEntryContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEntryContent indicates an expected call of FetchEntryContent.
+func (mr *MockNeonClientMockRecorder) FetchEntryContent(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEntryContent", reflect.TypeOf((*MockNeonClient)(nil).FetchEntryContent), varargs...)
+}
+
 // GetEntry mocks base method.
 func (m *MockNeonClient) GetEntry(ctx context.Context, in *api.GetEntryRequest, opts ...grpc.CallOption) (*api.GetEntryResponse, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +422,106 @@ func (mr *MockNeonClientMockRecorder) ListFeeds(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeds", reflect.TypeOf((*MockNeonClient)(nil).ListFeeds), varargs...)
 }
 
+// ListFolders mocks base method.
+func (m *MockNeonClient) ListFolders(ctx context.Context, in *api.ListFoldersRequest, opts ...grpc.CallOption) (*api.ListFoldersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFolders", varargs...)
+	ret0, _ := ret[0].(*api.ListFoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockNeonClientMockRecorder) ListFolders(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockNeonClient)(nil).ListFolders), varargs...)
+}
+
+// ListRetentionPolicies mocks base method.
+func (m *MockNeonClient) ListRetentionPolicies(ctx context.Context, in *api.ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*api.ListRetentionPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRetentionPolicies", varargs...)
+	ret0, _ := ret[0].(*api.ListRetentionPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRetentionPolicies indicates an expected call of ListRetentionPolicies.
+func (mr *MockNeonClientMockRecorder) ListRetentionPolicies(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRetentionPolicies", reflect.TypeOf((*MockNeonClient)(nil).ListRetentionPolicies), varargs...)
+}
+
+// ListRules mocks base method.
+func (m *MockNeonClient) ListRules(ctx context.Context, in *api.ListRulesRequest, opts ...grpc.CallOption) (*api.ListRulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRules", varargs...)
+	ret0, _ := ret[0].(*api.ListRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockNeonClientMockRecorder) ListRules(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockNeonClient)(nil).ListRules), varargs...)
+}
+
+// MatchRule mocks base method.
+func (m *MockNeonClient) MatchRule(ctx context.Context, in *api.MatchRuleRequest, opts ...grpc.CallOption) (*api.MatchRuleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MatchRule", varargs...)
+	ret0, _ := ret[0].(*api.MatchRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchRule indicates an expected call of MatchRule.
+func (mr *MockNeonClientMockRecorder) MatchRule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchRule", reflect.TypeOf((*MockNeonClient)(nil).MatchRule), varargs...)
+}
+
+// PruneEntries mocks base method.
+func (m *MockNeonClient) PruneEntries(ctx context.Context, in *api.PruneEntriesRequest, opts ...grpc.CallOption) (*api.PruneEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PruneEntries", varargs...)
+	ret0, _ := ret[0].(*api.PruneEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneEntries indicates an expected call of PruneEntries.
+func (mr *MockNeonClientMockRecorder) PruneEntries(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneEntries", reflect.TypeOf((*MockNeonClient)(nil).PruneEntries), varargs...)
+}
+
 // PullFeeds mocks base method.
 func (m *MockNeonClient) PullFeeds(ctx context.Context, in *api.PullFeedsRequest, opts ...grpc.CallOption) (api.Neon_PullFeedsClient, error) {
 	m.ctrl.T.Helper()
@@ -282,6 +542,46 @@ func (mr *MockNeonClientMockRecorder) PullFeeds(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFeeds", reflect.TypeOf((*MockNeonClient)(nil).PullFeeds), varargs...)
 }
 
+// SearchEntries mocks base method.
+func (m *MockNeonClient) SearchEntries(ctx context.Context, in *api.SearchEntriesRequest, opts ...grpc.CallOption) (*api.SearchEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchEntries", varargs...)
+	ret0, _ := ret[0].(*api.SearchEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEntries indicates an expected call of SearchEntries.
+func (mr *MockNeonClientMockRecorder) SearchEntries(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEntries", reflect.TypeOf((*MockNeonClient)(nil).SearchEntries), varargs...)
+}
+
+// SetRetentionPolicy mocks base method.
+func (m *MockNeonClient) SetRetentionPolicy(ctx context.Context, in *api.SetRetentionPolicyRequest, opts ...grpc.CallOption) (*api.SetRetentionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", varargs...)
+	ret0, _ := ret[0].(*api.SetRetentionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockNeonClientMockRecorder) SetRetentionPolicy(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockNeonClient)(nil).SetRetentionPolicy), varargs...)
+}
+
 // StreamEntries mocks base method.
 func (m *MockNeonClient) StreamEntries(ctx context.Context, in *api.StreamEntriesRequest, opts ...grpc.CallOption) (api.Neon_StreamEntriesClient, error) {
 	m.ctrl.T.Helper()
@@ -586,6 +886,36 @@ func (mr *MockNeonServerMockRecorder) AddFeed(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFeed", reflect.TypeOf((*MockNeonServer)(nil).AddFeed), arg0, arg1)
 }
 
+// CreateFolder mocks base method.
+func (m *MockNeonServer) CreateFolder(arg0 context.Context, arg1 *api.CreateFolderRequest) (*api.CreateFolderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", arg0, arg1)
+	ret0, _ := ret[0].(*api.CreateFolderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockNeonServerMockRecorder) CreateFolder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockNeonServer)(nil).CreateFolder), arg0, arg1)
+}
+
+// CreateRule mocks base method.
+func (m *MockNeonServer) CreateRule(arg0 context.Context, arg1 *api.CreateRuleRequest) (*api.CreateRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", arg0, arg1)
+	ret0, _ := ret[0].(*api.CreateRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockNeonServerMockRecorder) CreateRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockNeonServer)(nil).CreateRule), arg0, arg1)
+}
+
 // DeleteFeeds mocks base method.
 func (m *MockNeonServer) DeleteFeeds(arg0 context.Context, arg1 *api.DeleteFeedsRequest) (*api.DeleteFeedsResponse, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +931,66 @@ func (mr *MockNeonServerMockRecorder) DeleteFeeds(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeds", reflect.TypeOf((*MockNeonServer)(nil).DeleteFeeds), arg0, arg1)
 }
 
+// DeleteFolders mocks base method.
+func (m *MockNeonServer) DeleteFolders(arg0 context.Context, arg1 *api.DeleteFoldersRequest) (*api.DeleteFoldersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolders", arg0, arg1)
+	ret0, _ := ret[0].(*api.DeleteFoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFolders indicates an expected call of DeleteFolders.
+func (mr *MockNeonServerMockRecorder) DeleteFolders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolders", reflect.TypeOf((*MockNeonServer)(nil).DeleteFolders), arg0, arg1)
+}
+
+// DeleteRetentionPolicy mocks base method.
+func (m *MockNeonServer) DeleteRetentionPolicy(arg0 context.Context, arg1 *api.DeleteRetentionPolicyRequest) (*api.DeleteRetentionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*api.DeleteRetentionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRetentionPolicy indicates an expected call of DeleteRetentionPolicy.
+func (mr *MockNeonServerMockRecorder) DeleteRetentionPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicy", reflect.TypeOf((*MockNeonServer)(nil).DeleteRetentionPolicy), arg0, arg1)
+}
+
+// DeleteRules mocks base method.
+func (m *MockNeonServer) DeleteRules(arg0 context.Context, arg1 *api.DeleteRulesRequest) (*api.DeleteRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRules", arg0, arg1)
+	ret0, _ := ret[0].(*api.DeleteRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRules indicates an expected call of DeleteRules.
+func (mr *MockNeonServerMockRecorder) DeleteRules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRules", reflect.TypeOf((*MockNeonServer)(nil).DeleteRules), arg0, arg1)
+}
+
+// DiscoverFeeds mocks base method.
+func (m *MockNeonServer) DiscoverFeeds(arg0 context.Context, arg1 *api.DiscoverFeedsRequest) (*api.DiscoverFeedsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverFeeds", arg0, arg1)
+	ret0, _ := ret[0].(*api.DiscoverFeedsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverFeeds indicates an expected call of DiscoverFeeds.
+func (mr *MockNeonServerMockRecorder) DiscoverFeeds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverFeeds", reflect.TypeOf((*MockNeonServer)(nil).DiscoverFeeds), arg0, arg1)
+}
+
 // EditEntries mocks base method.
 func (m *MockNeonServer) EditEntries(arg0 context.Context, arg1 *api.EditEntriesRequest) (*api.EditEntriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -631,6 +1021,21 @@ func (mr *MockNeonServerMockRecorder) EditFeeds(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFeeds", reflect.TypeOf((*MockNeonServer)(nil).EditFeeds), arg0, arg1)
 }
 
+// EditFolders mocks base method.
+func (m *MockNeonServer) EditFolders(arg0 context.Context, arg1 *api.EditFoldersRequest) (*api.EditFoldersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditFolders", arg0, arg1)
+	ret0, _ := ret[0].(*api.EditFoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditFolders indicates an expected call of EditFolders.
+func (mr *MockNeonServerMockRecorder) EditFolders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFolders", reflect.TypeOf((*MockNeonServer)(nil).EditFolders), arg0, arg1)
+}
+
 // ExportOPML mocks base method.
 func (m *MockNeonServer) ExportOPML(arg0 context.Context, arg1 *api.ExportOPMLRequest) (*api.ExportOPMLResponse, error) {
 	m.ctrl.T.Helper()
@@ -646,6 +1051,21 @@ func (mr *MockNeonServerMockRecorder) ExportOPML(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOPML", reflect.TypeOf((*MockNeonServer)(nil).ExportOPML), arg0, arg1)
 }
 
+// FetchEntryContent mocks base method.
+func (m *MockNeonServer) FetchEntryContent(arg0 context.Context, arg1 *api.FetchEntryContentRequest) (*api.FetchEntryContentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchEntryContent", arg0, arg1)
+	ret0, _ := ret[0].(*api.FetchEntryContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEntryContent indicates an expected call of FetchEntryContent.
+func (mr *MockNeonServerMockRecorder) FetchEntryContent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEntryContent", reflect.TypeOf((*MockNeonServer)(nil).FetchEntryContent), arg0, arg1)
+}
+
 // GetEntry mocks base method.
 func (m *MockNeonServer) GetEntry(arg0 context.Context, arg1 *api.GetEntryRequest) (*api.GetEntryResponse, error) {
 	m.ctrl.T.Helper()
@@ -736,6 +1156,81 @@ func (mr *MockNeonServerMockRecorder) ListFeeds(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeds", reflect.TypeOf((*MockNeonServer)(nil).ListFeeds), arg0, arg1)
 }
 
+// ListFolders mocks base method.
+func (m *MockNeonServer) ListFolders(arg0 context.Context, arg1 *api.ListFoldersRequest) (*api.ListFoldersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFolders", arg0, arg1)
+	ret0, _ := ret[0].(*api.ListFoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockNeonServerMockRecorder) ListFolders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockNeonServer)(nil).ListFolders), arg0, arg1)
+}
+
+// ListRetentionPolicies mocks base method.
+func (m *MockNeonServer) ListRetentionPolicies(arg0 context.Context, arg1 *api.ListRetentionPoliciesRequest) (*api.ListRetentionPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRetentionPolicies", arg0, arg1)
+	ret0, _ := ret[0].(*api.ListRetentionPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRetentionPolicies indicates an expected call of ListRetentionPolicies.
+func (mr *MockNeonServerMockRecorder) ListRetentionPolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRetentionPolicies", reflect.TypeOf((*MockNeonServer)(nil).ListRetentionPolicies), arg0, arg1)
+}
+
+// ListRules mocks base method.
+func (m *MockNeonServer) ListRules(arg0 context.Context, arg1 *api.ListRulesRequest) (*api.ListRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", arg0, arg1)
+	ret0, _ := ret[0].(*api.ListRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockNeonServerMockRecorder) ListRules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockNeonServer)(nil).ListRules), arg0, arg1)
+}
+
+// MatchRule mocks base method.
+func (m *MockNeonServer) MatchRule(arg0 context.Context, arg1 *api.MatchRuleRequest) (*api.MatchRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchRule", arg0, arg1)
+	ret0, _ := ret[0].(*api.MatchRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchRule indicates an expected call of MatchRule.
+func (mr *MockNeonServerMockRecorder) MatchRule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchRule", reflect.TypeOf((*MockNeonServer)(nil).MatchRule), arg0, arg1)
+}
+
+// PruneEntries mocks base method.
+func (m *MockNeonServer) PruneEntries(arg0 context.Context, arg1 *api.PruneEntriesRequest) (*api.PruneEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneEntries", arg0, arg1)
+	ret0, _ := ret[0].(*api.PruneEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneEntries indicates an expected call of PruneEntries.
+func (mr *MockNeonServerMockRecorder) PruneEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneEntries", reflect.TypeOf((*MockNeonServer)(nil).PruneEntries), arg0, arg1)
+}
+
 // PullFeeds mocks base method.
 func (m *MockNeonServer) PullFeeds(arg0 *api.PullFeedsRequest, arg1 api.Neon_PullFeedsServer) error {
 	m.ctrl.T.Helper()
@@ -750,6 +1245,36 @@ func (mr *MockNeonServerMockRecorder) PullFeeds(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFeeds", reflect.TypeOf((*MockNeonServer)(nil).PullFeeds), arg0, arg1)
 }
 
+// SearchEntries mocks base method.
+func (m *MockNeonServer) SearchEntries(arg0 context.Context, arg1 *api.SearchEntriesRequest) (*api.SearchEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEntries", arg0, arg1)
+	ret0, _ := ret[0].(*api.SearchEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEntries indicates an expected call of SearchEntries.
+func (mr *MockNeonServerMockRecorder) SearchEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEntries", reflect.TypeOf((*MockNeonServer)(nil).SearchEntries), arg0, arg1)
+}
+
+// SetRetentionPolicy mocks base method.
+func (m *MockNeonServer) SetRetentionPolicy(arg0 context.Context, arg1 *api.SetRetentionPolicyRequest) (*api.SetRetentionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*api.SetRetentionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockNeonServerMockRecorder) SetRetentionPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockNeonServer)(nil).SetRetentionPolicy), arg0, arg1)
+}
+
 // StreamEntries mocks base method.
 func (m *MockNeonServer) StreamEntries(arg0 *api.StreamEntriesRequest, arg1 api.Neon_StreamEntriesServer) error {
 	m.ctrl.T.Helper()
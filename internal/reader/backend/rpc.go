@@ -15,6 +15,12 @@ import (
 	"google.golang.org/grpc"
 )
 
+// listEntriesPageSize is the number of entries fetched per call when listing feed entries.
+const listEntriesPageSize = 500
+
+// errStreamEnded is sent when the server ends an entry subscription, e.g. when it shuts down.
+var errStreamEnded = errors.New("entry stream ended by the server")
+
 type RPC struct {
 	addr   string
 	client api.NeonClient
@@ -41,6 +47,16 @@ func newRPCWithClient(
 	return &RPC{addr: addr, client: client}
 }
 
+// EditEntriesF returns a function that applies the given edits and returns the edited entries.
+func (r *RPC) EditEntriesF(
+	ctx context.Context,
+	ops []*entity.EntryEditOp,
+) func() ([]*entity.Entry, error) {
+	return func() ([]*entity.Entry, error) {
+		return r.EditEntries(ctx, ops)
+	}
+}
+
 func (r *RPC) GetStatsF(ctx context.Context) func() (*entity.Stats, error) {
 	return func() (*entity.Stats, error) {
 		rsp, err := r.client.GetStats(ctx, &api.GetStatsRequest{})
@@ -62,6 +78,13 @@ func (r *RPC) GetAllFeedsF(ctx context.Context) func() ([]*entity.Feed, error) {
 	}
 }
 
+// ListFoldersF returns a function that lists all feed folders.
+func (r *RPC) ListFoldersF(ctx context.Context) func() ([]*entity.Folder, error) {
+	return func() ([]*entity.Folder, error) {
+		return r.ListFolders(ctx)
+	}
+}
+
 func (r *RPC) PullFeedsF(
 	ctx context.Context,
 	ids []entity.ID,
@@ -88,17 +111,62 @@ func (r *RPC) PullFeedsF(
 					}
 					return
 				}
-				if perr := rsp.Error; perr != nil {
-					ch <- entity.NewPullResultFromError(&rsp.Url, fmt.Errorf("%s", *perr))
-					continue
-				}
-				ch <- entity.NewPullResultFromFeed(&rsp.Url, entity.FromFeedPb(rsp.GetFeed()))
+				ch <- fromPullFeedsResponsePb(rsp)
 			}
 		}()
 		return ch, nil
 	}
 }
 
+func (r *RPC) SearchEntriesF(
+	ctx context.Context,
+	query string,
+) func() ([]*entity.SearchResult, error) {
+	return func() ([]*entity.SearchResult, error) {
+		return r.SearchEntries(ctx, query, nil, nil)
+	}
+}
+
+// StreamEntriesF returns a function that subscribes to entries added or updated on the server.
+// The entries channel is closed when the subscription ends, after the error that ended it is sent
+// to the error channel. Once the given context is done, the context error is returned instead.
+func (r *RPC) StreamEntriesF(
+	ctx context.Context,
+) func() (<-chan *entity.Entry, <-chan error, error) {
+	return func() (<-chan *entity.Entry, <-chan error, error) {
+		stream, err := r.client.StreamEntries(ctx, &api.StreamEntriesRequest{})
+		if err != nil {
+			if cerr := ctx.Err(); cerr != nil {
+				return nil, nil, cerr
+			}
+			return nil, nil, err
+		}
+
+		ch := make(chan *entity.Entry)
+		errCh := make(chan error, 1)
+		go func() {
+			defer close(ch)
+			defer close(errCh)
+			for {
+				rsp, serr := stream.Recv()
+				if serr != nil {
+					switch {
+					case ctx.Err() != nil:
+						errCh <- ctx.Err()
+					case errors.Is(serr, io.EOF):
+						errCh <- errStreamEnded
+					default:
+						errCh <- serr
+					}
+					return
+				}
+				ch <- entity.FromEntryPb(rsp.GetEntry())
+			}
+		}()
+		return ch, errCh, nil
+	}
+}
+
 func (r *RPC) String() string {
 	return fmt.Sprintf("grpc://%s", r.addr)
 }
@@ -128,26 +196,28 @@ func (r *RPC) fillEmptyFeeds(
 		chs[i] = ch
 		go func() {
 			defer close(ch)
-			stream, err := r.client.StreamEntries(
-				ctx,
-				&api.StreamEntriesRequest{FeedId: feed.ID},
-			)
-			if err != nil {
-				ch <- errResult[*entity.Feed](err)
-				return
-			}
+			var token string
 			for {
-				srsp, serr := stream.Recv()
-				if serr != nil {
-					if serr != io.EOF {
-						ch <- errResult[*entity.Feed](serr)
-					} else {
-						ch <- okResult(feed)
-					}
+				rsp, err := r.client.ListEntries(
+					ctx,
+					&api.ListEntriesRequest{
+						FeedIds:   []uint32{feed.ID},
+						PageSize:  listEntriesPageSize,
+						PageToken: token,
+					},
+				)
+				if err != nil {
+					ch <- errResult[*entity.Feed](err)
+					return
+				}
+				for _, pb := range rsp.GetEntries() {
+					entry := entity.FromEntryPb(pb)
+					feed.Entries[entry.ID] = entry
+				}
+				if token = rsp.GetNextPageToken(); token == "" {
+					ch <- okResult(feed)
 					return
 				}
-				entry := entity.FromEntryPb(srsp.GetEntry())
-				feed.Entries[entry.ID] = entry
 			}
 		}()
 	}
@@ -0,0 +1,453 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package backend
+
+// The methods in this file mirror those of the datastore, so that a server can be used in
+// place of a local datastore. Timeouts accepted by the datastore methods are ignored, since
+// they are determined by the server.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bow/neon/api"
+	"github.com/bow/neon/internal/entity"
+)
+
+// ErrNotInAPI is returned by operations that the server API does not provide.
+var ErrNotInAPI = errors.New("operation not provided by the server API")
+
+func (r *RPC) AddFeed(
+	ctx context.Context,
+	feedURL string,
+	title *string,
+	desc *string,
+	tags []string,
+	isStarred *bool,
+	_ *time.Duration,
+) (*entity.Feed, bool, error) {
+
+	req := api.AddFeedRequest{
+		Url:         feedURL,
+		Title:       title,
+		Description: desc,
+		Tags:        tags,
+		IsStarred:   isStarred,
+	}
+	rsp, err := r.client.AddFeed(ctx, &req)
+	if err != nil {
+		// The server only reports that the URL is ambiguous, so the candidates are fetched
+		// separately.
+		if status.Code(err) == codes.FailedPrecondition {
+			candidates, derr := r.DiscoverFeeds(ctx, feedURL, nil)
+			if derr == nil && len(candidates) > 1 {
+				return nil, false, entity.AmbiguousFeedURLError{
+					URL:        feedURL,
+					Candidates: candidates,
+				}
+			}
+		}
+		return nil, false, err
+	}
+
+	return entity.FromFeedPb(rsp.GetFeed()), rsp.GetIsAdded(), nil
+}
+
+func (r *RPC) DiscoverFeeds(
+	ctx context.Context,
+	pageURL string,
+	_ *time.Duration,
+) ([]*entity.FeedCandidate, error) {
+	rsp, err := r.client.DiscoverFeeds(ctx, &api.DiscoverFeedsRequest{Url: pageURL})
+	if err != nil {
+		return nil, err
+	}
+	return entity.FromFeedCandidatePbs(rsp.GetCandidates()), nil
+}
+
+// EditFeeds applies the given edits and returns the edited feeds. The server replaces the tags
+// of every edited feed, so the current tags are sent along for edits that leave them unchanged.
+func (r *RPC) EditFeeds(ctx context.Context, ops []*entity.FeedEditOp) ([]*entity.Feed, error) {
+
+	var keepTagIDs []entity.ID
+	for _, op := range ops {
+		if op.Tags == nil {
+			keepTagIDs = append(keepTagIDs, op.ID)
+		}
+	}
+	currentTags := make(map[entity.ID][]string)
+	if len(keepTagIDs) > 0 {
+		nmax := uint32(0)
+		feeds, _, err := r.ListFeeds(
+			ctx,
+			&nmax,
+			&entity.FeedFilter{IDs: keepTagIDs},
+			entity.FeedSortUpdateTimeDesc,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, feed := range feeds {
+			currentTags[feed.ID] = feed.Tags
+		}
+	}
+
+	req := api.EditFeedsRequest{Ops: make([]*api.EditFeedsRequest_Op, len(ops))}
+	for i, op := range ops {
+		tags := currentTags[op.ID]
+		if op.Tags != nil {
+			tags = *op.Tags
+		}
+		req.Ops[i] = &api.EditFeedsRequest_Op{
+			Id: op.ID,
+			Fields: &api.EditFeedsRequest_Op_Fields{
+				Title:        op.Title,
+				Description:  op.Description,
+				Tags:         tags,
+				IsStarred:    op.IsStarred,
+				FolderId:     op.FolderID,
+				FetchContent: op.FetchContent,
+			},
+		}
+	}
+	rsp, err := r.client.EditFeeds(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.FromFeedPbs(rsp.GetFeeds()), nil
+}
+
+func (r *RPC) ListFeeds(
+	ctx context.Context,
+	maxEntriesPerFeed *uint32,
+	filter *entity.FeedFilter,
+	order entity.FeedSortOrder,
+	page *entity.Page,
+) ([]*entity.Feed, string, error) {
+
+	req := api.ListFeedsRequest{
+		MaxEntriesPerFeed: maxEntriesPerFeed,
+		SortOrder:         entity.ToFeedSortOrderPb(order),
+	}
+	if filter != nil {
+		req.FeedIds = filter.IDs
+		req.Tags = filter.Tags
+		req.IsStarred = filter.IsStarred
+	}
+	if page != nil {
+		req.PageSize = page.Size
+		req.PageToken = page.Token
+	}
+	rsp, err := r.client.ListFeeds(ctx, &req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return entity.FromFeedPbs(rsp.GetFeeds()), rsp.GetNextPageToken(), nil
+}
+
+// PullFeeds pulls the given feeds, or all feeds if none are given. Failures to start the pull
+// are sent as results without URLs. The entry read status filter is not part of the API, so
+// only nil is accepted.
+func (r *RPC) PullFeeds(
+	ctx context.Context,
+	ids []entity.ID,
+	entryReadStatus *bool,
+	maxEntriesPerFeed *uint32,
+	_ *time.Duration,
+	force bool,
+) <-chan entity.PullResult {
+
+	ch := make(chan entity.PullResult)
+
+	go func() {
+		defer close(ch)
+
+		if entryReadStatus != nil {
+			ch <- entity.NewPullResultFromError(
+				nil,
+				fmt.Errorf("pulling entries by read status: %w", ErrNotInAPI),
+			)
+			return
+		}
+
+		req := api.PullFeedsRequest{
+			FeedIds:           ids,
+			MaxEntriesPerFeed: maxEntriesPerFeed,
+			Force:             force,
+		}
+		stream, err := r.client.PullFeeds(ctx, &req)
+		if err != nil {
+			ch <- entity.NewPullResultFromError(nil, err)
+			return
+		}
+		for {
+			rsp, serr := stream.Recv()
+			if serr != nil {
+				if serr != io.EOF {
+					ch <- entity.NewPullResultFromError(nil, serr)
+				}
+				return
+			}
+			ch <- fromPullFeedsResponsePb(rsp)
+		}
+	}()
+
+	return ch
+}
+
+func (r *RPC) DeleteFeeds(ctx context.Context, ids []entity.ID) error {
+	_, err := r.client.DeleteFeeds(ctx, &api.DeleteFeedsRequest{FeedIds: ids})
+	return err
+}
+
+func (r *RPC) ListEntries(
+	ctx context.Context,
+	filter *entity.EntryFilter,
+	order entity.EntrySortOrder,
+	page *entity.Page,
+) ([]*entity.Entry, string, error) {
+
+	req := api.ListEntriesRequest{SortOrder: entity.ToEntrySortOrderPb(order)}
+	if filter != nil {
+		req.FeedIds = filter.FeedIDs
+		req.FeedTags = filter.FeedTags
+		req.IsFeedStarred = filter.IsFeedStarred
+		req.IsRead = filter.IsRead
+		req.IsBookmarked = filter.IsBookmarked
+		req.PublishedAfter = entity.ToTimestampPb(filter.PublishedAfter)
+		req.PublishedBefore = entity.ToTimestampPb(filter.PublishedBefore)
+		req.UpdatedAfter = entity.ToTimestampPb(filter.UpdatedAfter)
+		req.UpdatedBefore = entity.ToTimestampPb(filter.UpdatedBefore)
+		req.Authors = filter.Authors
+		req.Tags = filter.Tags
+		req.HasEnclosures = filter.HasEnclosures
+	}
+	if page != nil {
+		req.PageSize = page.Size
+		req.PageToken = page.Token
+	}
+	rsp, err := r.client.ListEntries(ctx, &req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return fromEntryPbs(rsp.GetEntries()), rsp.GetNextPageToken(), nil
+}
+
+func (r *RPC) EditEntries(ctx context.Context, ops []*entity.EntryEditOp) ([]*entity.Entry, error) {
+	req := api.EditEntriesRequest{Ops: make([]*api.EditEntriesRequest_Op, len(ops))}
+	for i, op := range ops {
+		req.Ops[i] = &api.EditEntriesRequest_Op{
+			Id: op.ID,
+			Fields: &api.EditEntriesRequest_Op_Fields{
+				IsRead:       op.IsRead,
+				IsBookmarked: op.IsBookmarked,
+			},
+		}
+	}
+	rsp, err := r.client.EditEntries(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return fromEntryPbs(rsp.GetEntries()), nil
+}
+
+func (r *RPC) GetEntry(ctx context.Context, id entity.ID) (*entity.Entry, error) {
+	rsp, err := r.client.GetEntry(ctx, &api.GetEntryRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return entity.FromEntryPb(rsp.GetEntry()), nil
+}
+
+func (r *RPC) FetchEntryContent(
+	ctx context.Context,
+	id entity.ID,
+	_ *time.Duration,
+) (*entity.Entry, error) {
+	rsp, err := r.client.FetchEntryContent(ctx, &api.FetchEntryContentRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return entity.FromEntryPb(rsp.GetEntry()), nil
+}
+
+func (r *RPC) SearchEntries(
+	ctx context.Context,
+	query string,
+	feedIDs []entity.ID,
+	maxResults *uint32,
+) ([]*entity.SearchResult, error) {
+
+	req := api.SearchEntriesRequest{Query: query, FeedIds: feedIDs, MaxResults: maxResults}
+	rsp, err := r.client.SearchEntries(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.FromSearchResultPbs(rsp.GetResults()), nil
+}
+
+func (r *RPC) PruneEntries(
+	ctx context.Context,
+	feedIDs []entity.ID,
+	dryRun bool,
+) ([]*entity.PruneResult, error) {
+
+	req := api.PruneEntriesRequest{FeedIds: feedIDs, DryRun: dryRun}
+	rsp, err := r.client.PruneEntries(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.FromPruneResultPbs(rsp.GetResults()), nil
+}
+
+func (r *RPC) SetRetentionPolicy(ctx context.Context, policy *entity.RetentionPolicy) error {
+	req := api.SetRetentionPolicyRequest{Policy: entity.ToRetentionPolicyPb(policy)}
+	_, err := r.client.SetRetentionPolicy(ctx, &req)
+	return err
+}
+
+func (r *RPC) DeleteRetentionPolicy(ctx context.Context, feedID *entity.ID) error {
+	req := api.DeleteRetentionPolicyRequest{FeedId: feedID}
+	_, err := r.client.DeleteRetentionPolicy(ctx, &req)
+	return err
+}
+
+func (r *RPC) ListRetentionPolicies(ctx context.Context) ([]*entity.RetentionPolicy, error) {
+	req := api.ListRetentionPoliciesRequest{}
+	rsp, err := r.client.ListRetentionPolicies(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.FromRetentionPolicyPbs(rsp.GetPolicies()), nil
+}
+
+func (r *RPC) ExportSubscription(
+	ctx context.Context,
+	title *string,
+) (*entity.Subscription, error) {
+
+	rsp, err := r.client.ExportOPML(ctx, &api.ExportOPMLRequest{Title: title})
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewSubscriptionFromRawOPML(rsp.GetPayload())
+}
+
+func (r *RPC) ImportSubscription(
+	ctx context.Context,
+	sub *entity.Subscription,
+) (processed int, imported int, err error) {
+
+	if len(sub.Feeds) == 0 {
+		return 0, 0, nil
+	}
+	payload, err := sub.Export()
+	if err != nil {
+		return 0, 0, err
+	}
+	rsp, err := r.client.ImportOPML(ctx, &api.ImportOPMLRequest{Payload: payload})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int(rsp.GetNumProcessed()), int(rsp.GetNumImported()), nil
+}
+
+func (r *RPC) CreateFolder(
+	ctx context.Context,
+	name string,
+	parentID *entity.ID,
+) (*entity.Folder, error) {
+
+	rsp, err := r.client.CreateFolder(
+		ctx,
+		&api.CreateFolderRequest{Name: name, ParentId: parentID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.FromFolderPb(rsp.GetFolder()), nil
+}
+
+func (r *RPC) ListFolders(ctx context.Context) ([]*entity.Folder, error) {
+	rsp, err := r.client.ListFolders(ctx, &api.ListFoldersRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return entity.FromFolderPbs(rsp.GetFolders()), nil
+}
+
+func (r *RPC) EditFolders(
+	ctx context.Context,
+	ops []*entity.FolderEditOp,
+) ([]*entity.Folder, error) {
+
+	req := api.EditFoldersRequest{Ops: make([]*api.EditFoldersRequest_Op, len(ops))}
+	for i, op := range ops {
+		req.Ops[i] = &api.EditFoldersRequest_Op{
+			Id: op.ID,
+			Fields: &api.EditFoldersRequest_Op_Fields{
+				Name:     op.Name,
+				ParentId: op.ParentID,
+			},
+		}
+	}
+	rsp, err := r.client.EditFolders(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.FromFolderPbs(rsp.GetFolders()), nil
+}
+
+func (r *RPC) DeleteFolders(ctx context.Context, ids []entity.ID) error {
+	_, err := r.client.DeleteFolders(ctx, &api.DeleteFoldersRequest{FolderIds: ids})
+	return err
+}
+
+func fromPullFeedsResponsePb(rsp *api.PullFeedsResponse) entity.PullResult {
+	var pr entity.PullResult
+	// nolint:exhaustive
+	switch rsp.GetStatus() {
+	case api.PullFeedsResponse_PULL_STATUS_NOT_MODIFIED:
+		pr = entity.NewPullResultNotModified(&rsp.Url)
+	case api.PullFeedsResponse_PULL_STATUS_SKIPPED:
+		pr = entity.NewPullResultSkipped(&rsp.Url)
+	default:
+		// Servers without pull statuses only report failures through the error.
+		if perr := rsp.Error; perr != nil {
+			return entity.NewPullResultFromError(&rsp.Url, fmt.Errorf("%s", *perr))
+		}
+		pr = entity.NewPullResultFromFeed(&rsp.Url, entity.FromFeedPb(rsp.GetFeed()))
+	}
+	if newURL := rsp.GetNewUrl(); newURL != "" {
+		pr.SetNewURL(newURL)
+	}
+	return pr
+}
+
+func fromEntryPbs(pbs []*api.Entry) []*entity.Entry {
+	entries := make([]*entity.Entry, 0, len(pbs))
+	for _, pb := range pbs {
+		if entry := entity.FromEntryPb(pb); entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
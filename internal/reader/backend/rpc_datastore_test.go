@@ -0,0 +1,386 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bow/neon/api"
+	"github.com/bow/neon/internal/entity"
+)
+
+// protoEq matches protobuf messages equal to the given message.
+func protoEq(want proto.Message) gomock.Matcher {
+	return gomock.Cond(
+		func(v any) bool {
+			got, ok := v.(proto.Message)
+			return ok && proto.Equal(want, got)
+		},
+	)
+}
+
+// newTestFeedPb sets the times that the server always sets on the given feed.
+func newTestFeedPb(pb *api.Feed) *api.Feed {
+	pb.SubTime = timestamppb.New(time.Now())
+	pb.LastPullTime = timestamppb.New(time.Now())
+	return pb
+}
+
+func TestAddFeedOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		AddFeed(
+			gomock.Any(),
+			protoEq(
+				&api.AddFeedRequest{
+					Url:       "http://a.com/feed.xml",
+					Title:     pointer("A"),
+					Tags:      []string{"news"},
+					IsStarred: pointer(true),
+				},
+			),
+		).
+		Return(
+			&api.AddFeedResponse{
+				Feed:    newTestFeedPb(&api.Feed{Id: 2, Title: "A", FeedUrl: "http://a.com/feed.xml"}),
+				IsAdded: true,
+			},
+			nil,
+		)
+
+	feed, added, err := rpc.AddFeed(
+		context.Background(),
+		"http://a.com/feed.xml",
+		pointer("A"),
+		nil,
+		[]string{"news"},
+		pointer(true),
+		pointer(time.Second),
+	)
+	r.NoError(err)
+	a.True(added)
+	a.Equal(entity.ID(2), feed.ID)
+	a.Equal("A", feed.Title)
+}
+
+func TestAddFeedErrAmbiguous(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		AddFeed(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.FailedPrecondition, "found 2 feeds at http://a.com"))
+	client.EXPECT().
+		DiscoverFeeds(gomock.Any(), protoEq(&api.DiscoverFeedsRequest{Url: "http://a.com"})).
+		Return(
+			&api.DiscoverFeedsResponse{
+				Candidates: []*api.DiscoverFeedsResponse_Candidate{
+					{Url: "http://a.com/rss.xml", Title: "RSS"},
+					{Url: "http://a.com/atom.xml", Title: "Atom"},
+				},
+			},
+			nil,
+		)
+
+	feed, added, err := rpc.AddFeed(
+		context.Background(),
+		"http://a.com",
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	r.Nil(feed)
+	a.False(added)
+
+	var aerr entity.AmbiguousFeedURLError
+	r.ErrorAs(err, &aerr)
+	a.Equal("http://a.com", aerr.URL)
+	a.Equal(
+		[]*entity.FeedCandidate{
+			{URL: "http://a.com/rss.xml", Title: "RSS"},
+			{URL: "http://a.com/atom.xml", Title: "Atom"},
+		},
+		aerr.Candidates,
+	)
+}
+
+func TestEditFeedsOkKeepTags(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		ListFeeds(
+			gomock.Any(),
+			protoEq(&api.ListFeedsRequest{MaxEntriesPerFeed: pointer(uint32(0)), FeedIds: []uint32{3}}),
+		).
+		Return(
+			&api.ListFeedsResponse{
+				Feeds: []*api.Feed{newTestFeedPb(&api.Feed{Id: 3, Tags: []string{"a", "b"}})},
+			},
+			nil,
+		)
+	client.EXPECT().
+		EditFeeds(
+			gomock.Any(),
+			protoEq(
+				&api.EditFeedsRequest{
+					Ops: []*api.EditFeedsRequest_Op{
+						{
+							Id: 3,
+							Fields: &api.EditFeedsRequest_Op_Fields{
+								Title: pointer("X"),
+								Tags:  []string{"a", "b"},
+							},
+						},
+						{
+							Id: 4,
+							Fields: &api.EditFeedsRequest_Op_Fields{
+								Tags: []string{"c"},
+							},
+						},
+					},
+				},
+			),
+		).
+		Return(
+			&api.EditFeedsResponse{
+				Feeds: []*api.Feed{
+					newTestFeedPb(&api.Feed{Id: 3, Title: "X", Tags: []string{"a", "b"}}),
+					newTestFeedPb(&api.Feed{Id: 4, Tags: []string{"c"}}),
+				},
+			},
+			nil,
+		)
+
+	feeds, err := rpc.EditFeeds(
+		context.Background(),
+		[]*entity.FeedEditOp{
+			{ID: 3, Title: pointer("X")},
+			{ID: 4, Tags: &[]string{"c"}},
+		},
+	)
+	r.NoError(err)
+	r.Len(feeds, 2)
+	a.Equal("X", feeds[0].Title)
+	a.Equal([]string{"c"}, feeds[1].Tags)
+}
+
+func TestListEntriesOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	client.EXPECT().
+		ListEntries(
+			gomock.Any(),
+			protoEq(
+				&api.ListEntriesRequest{
+					FeedIds:        []uint32{1},
+					IsRead:         pointer(false),
+					PublishedAfter: timestamppb.New(after),
+					Authors:        []string{"alice"},
+					SortOrder:      api.ListEntriesRequest_SORT_ORDER_PUB_TIME_ASC,
+					PageSize:       10,
+					PageToken:      "abc",
+				},
+			),
+		).
+		Return(
+			&api.ListEntriesResponse{
+				Entries:       []*api.Entry{{Id: 5, FeedId: 1}},
+				NextPageToken: "def",
+			},
+			nil,
+		)
+
+	entries, token, err := rpc.ListEntries(
+		context.Background(),
+		&entity.EntryFilter{
+			FeedIDs:        []entity.ID{1},
+			IsRead:         pointer(false),
+			PublishedAfter: &after,
+			Authors:        []string{"alice"},
+		},
+		entity.EntrySortPubTimeAsc,
+		&entity.Page{Size: 10, Token: "abc"},
+	)
+	r.NoError(err)
+	r.Len(entries, 1)
+	a.Equal(entity.ID(5), entries[0].ID)
+	a.Equal("def", token)
+}
+
+func TestPullFeedsOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+	streamClient := NewMockNeon_PullFeedsClient(gomock.NewController(t))
+
+	client.EXPECT().
+		PullFeeds(
+			gomock.Any(),
+			protoEq(&api.PullFeedsRequest{FeedIds: []uint32{1, 2}, Force: true}),
+		).
+		Return(streamClient, nil)
+	streamClient.EXPECT().
+		Recv().
+		Return(&api.PullFeedsResponse{Url: "http://a.com/feed.xml", Error: pointer("http 500")}, nil)
+	streamClient.EXPECT().
+		Recv().
+		Return(
+			&api.PullFeedsResponse{
+				Url:    "http://b.com/feed.xml",
+				NewUrl: pointer("https://b.com/feed.xml"),
+				Status: api.PullFeedsResponse_PULL_STATUS_NOT_MODIFIED,
+			},
+			nil,
+		)
+	streamClient.EXPECT().
+		Recv().
+		Return(
+			&api.PullFeedsResponse{
+				Url:    "http://c.com/feed.xml",
+				Status: api.PullFeedsResponse_PULL_STATUS_SKIPPED,
+			},
+			nil,
+		)
+	streamClient.EXPECT().
+		Recv().
+		Return(
+			&api.PullFeedsResponse{
+				Url:    "http://d.com/feed.xml",
+				Status: api.PullFeedsResponse_PULL_STATUS_SUCCESS,
+			},
+			nil,
+		)
+	streamClient.EXPECT().
+		Recv().
+		Return(nil, io.EOF)
+
+	prs := make([]entity.PullResult, 0)
+	for pr := range rpc.PullFeeds(context.Background(), []entity.ID{1, 2}, nil, nil, nil, true) {
+		prs = append(prs, pr)
+	}
+	r.Len(prs, 4)
+	a.Equal("http://a.com/feed.xml", prs[0].URL())
+	a.EqualError(prs[0].Error(), "http 500")
+	a.Equal(entity.PullFail, prs[0].Status())
+
+	a.Equal("http://b.com/feed.xml", prs[1].URL())
+	a.Equal("https://b.com/feed.xml", prs[1].NewURL())
+	a.Equal(entity.PullNotModified, prs[1].Status())
+
+	a.Equal("http://c.com/feed.xml", prs[2].URL())
+	a.Equal(entity.PullSkipped, prs[2].Status())
+
+	a.Equal("http://d.com/feed.xml", prs[3].URL())
+	a.Equal(entity.PullSuccess, prs[3].Status())
+	a.Nil(prs[3].Feed())
+}
+
+func TestPullFeedsErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		PullFeeds(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("call fail"))
+
+	prs := make([]entity.PullResult, 0)
+	for pr := range rpc.PullFeeds(context.Background(), nil, nil, nil, nil, false) {
+		prs = append(prs, pr)
+	}
+	r.Len(prs, 1)
+	a.Empty(prs[0].URL())
+	a.EqualError(prs[0].Error(), "call fail")
+}
+
+func TestListRetentionPoliciesOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	maxAge := 720 * time.Hour
+	policies := []*entity.RetentionPolicy{
+		{MaxAge: &maxAge, KeepBookmarked: true},
+		{FeedID: pointer(entity.ID(2)), MaxEntries: pointer(uint32(10))},
+	}
+	client.EXPECT().
+		ListRetentionPolicies(gomock.Any(), protoEq(&api.ListRetentionPoliciesRequest{})).
+		Return(
+			&api.ListRetentionPoliciesResponse{Policies: entity.ToRetentionPolicyPbs(policies)},
+			nil,
+		)
+
+	listed, err := rpc.ListRetentionPolicies(context.Background())
+	r.NoError(err)
+	a.Equal(policies, listed)
+}
+
+func TestSetRetentionPolicyOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	maxAge := 48 * time.Hour
+	policy := entity.RetentionPolicy{FeedID: pointer(entity.ID(3)), MaxAge: &maxAge}
+	req := api.SetRetentionPolicyRequest{Policy: entity.ToRetentionPolicyPb(&policy)}
+	client.EXPECT().
+		SetRetentionPolicy(gomock.Any(), protoEq(&req)).
+		Return(&api.SetRetentionPolicyResponse{}, nil)
+
+	err := rpc.SetRetentionPolicy(context.Background(), &policy)
+	r.NoError(err)
+}
+
+func TestDeleteRetentionPolicyErr(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	req := api.DeleteRetentionPolicyRequest{FeedId: pointer(uint32(5))}
+	client.EXPECT().
+		DeleteRetentionPolicy(gomock.Any(), protoEq(&req)).
+		Return(nil, fmt.Errorf("call fail"))
+
+	err := rpc.DeleteRetentionPolicy(context.Background(), pointer(entity.ID(5)))
+	a.EqualError(err, "call fail")
+}
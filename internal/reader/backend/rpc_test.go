@@ -14,12 +14,130 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bow/neon/api"
 	"github.com/bow/neon/internal/entity"
 )
 
+func TestEditEntriesFOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		EditEntries(
+			gomock.Any(),
+			gomock.Cond(
+				func(v any) bool {
+					req, ok := v.(*api.EditEntriesRequest)
+					if !ok || len(req.GetOps()) != 2 {
+						return false
+					}
+					op1, op2 := req.GetOps()[0], req.GetOps()[1]
+					return op1.GetId() == 3 &&
+						op1.GetFields().IsRead != nil && op1.GetFields().GetIsRead() &&
+						op1.GetFields().IsBookmarked == nil &&
+						op2.GetId() == 5 &&
+						op2.GetFields().IsRead == nil &&
+						op2.GetFields().IsBookmarked != nil && !op2.GetFields().GetIsBookmarked()
+				},
+			),
+		).
+		Return(
+			&api.EditEntriesResponse{
+				Entries: []*api.Entry{
+					{Id: 3, FeedId: 1, IsRead: true},
+					{Id: 5, FeedId: 1, IsBookmarked: false},
+				},
+			},
+			nil,
+		)
+
+	entries, err := rpc.EditEntriesF(
+		context.Background(),
+		[]*entity.EntryEditOp{
+			{ID: 3, IsRead: pointer(true)},
+			{ID: 5, IsBookmarked: pointer(false)},
+		},
+	)()
+	r.NoError(err)
+	r.Len(entries, 2)
+	a.Equal(entity.ID(3), entries[0].ID)
+	a.True(entries[0].IsRead)
+	a.Equal(entity.ID(5), entries[1].ID)
+	a.False(entries[1].IsBookmarked)
+}
+
+func TestEditEntriesFErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		EditEntries(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("nope"))
+
+	entries, err := rpc.EditEntriesF(
+		context.Background(),
+		[]*entity.EntryEditOp{{ID: 3, IsRead: pointer(true)}},
+	)()
+	r.Nil(entries)
+	a.EqualError(err, "nope")
+}
+
+func TestListFoldersFOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		ListFolders(gomock.Any(), gomock.Any()).
+		Return(
+			&api.ListFoldersResponse{
+				Folders: []*api.Folder{
+					{Id: 1, Name: "News"},
+					{Id: 2, Name: "Tech", ParentId: pointer(uint32(1))},
+				},
+			},
+			nil,
+		)
+
+	folders, err := rpc.ListFoldersF(context.Background())()
+	r.NoError(err)
+	a.Equal(
+		[]*entity.Folder{
+			{ID: 1, Name: "News"},
+			{ID: 2, Name: "Tech", ParentID: pointer(entity.ID(1))},
+		},
+		folders,
+	)
+}
+
+func TestListFoldersFErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		ListFolders(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("nope"))
+
+	folders, err := rpc.ListFoldersF(context.Background())()
+	r.Nil(folders)
+	a.EqualError(err, "nope")
+}
+
 func TestGetStatsFOk(t *testing.T) {
 	t.Parallel()
 
@@ -55,14 +173,58 @@ func TestGetStatsFErr(t *testing.T) {
 	a.EqualError(err, "nope")
 }
 
+func TestSearchEntriesFOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		SearchEntries(gomock.Any(), &api.SearchEntriesRequest{Query: "tomato*"}).
+		Return(
+			&api.SearchEntriesResponse{
+				Results: []*api.SearchEntriesResponse_Result{
+					{
+						Entry:          &api.Entry{Id: 5, FeedId: 2, Title: "Tomatoes"},
+						TitleHighlight: "\x02Tomatoes\x03",
+						Score:          2.5,
+					},
+				},
+			},
+			nil,
+		)
+
+	results, err := rpc.SearchEntriesF(context.Background(), "tomato*")()
+	r.NoError(err)
+	r.Len(results, 1)
+	a.Equal(entity.ID(5), results[0].Entry.ID)
+	a.Equal("\x02Tomatoes\x03", results[0].TitleHighlight)
+	a.Equal(2.5, results[0].Score)
+}
+
+func TestSearchEntriesFErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		SearchEntries(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("nope"))
+
+	results, err := rpc.SearchEntriesF(context.Background(), "tomato")()
+	r.Nil(results)
+	a.EqualError(err, "nope")
+}
+
 func TestGetAllFeedsFOk(t *testing.T) {
 	t.Parallel()
 
 	r := require.New(t)
 	a := assert.New(t)
 	rpc, client := newBackendRPCTest(t)
-	streamClient1 := NewMockNeon_StreamEntriesClient(gomock.NewController(t))
-	streamClient2 := NewMockNeon_StreamEntriesClient(gomock.NewController(t))
 
 	client.EXPECT().
 		ListFeeds(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -89,52 +251,26 @@ func TestGetAllFeedsFOk(t *testing.T) {
 		)
 
 	client.EXPECT().
-		StreamEntries(
-			gomock.Any(),
-			gomock.Cond(
-				func(v any) bool {
-					req, ok := v.(*api.StreamEntriesRequest)
-					return ok && req.GetFeedId() == uint32(5)
-				},
-			),
-		).
-		Return(streamClient1, nil)
-	streamClient1.EXPECT().
-		Recv().
+		ListEntries(gomock.Any(), listEntriesReq(5, ""), gomock.Any()).
 		Return(
-			&api.StreamEntriesResponse{Entry: &api.Entry{Id: uint32(1), Title: "F1-A"}},
+			&api.ListEntriesResponse{
+				Entries:       []*api.Entry{{Id: uint32(1), Title: "F1-A"}},
+				NextPageToken: "next",
+			},
 			nil,
 		)
-	streamClient1.EXPECT().
-		Recv().
+	client.EXPECT().
+		ListEntries(gomock.Any(), listEntriesReq(5, "next"), gomock.Any()).
 		Return(
-			&api.StreamEntriesResponse{Entry: &api.Entry{Id: uint32(2), Title: "F1-B"}},
+			&api.ListEntriesResponse{Entries: []*api.Entry{{Id: uint32(2), Title: "F1-B"}}},
 			nil,
 		)
-	streamClient1.EXPECT().
-		Recv().
-		Return(nil, io.EOF)
-
 	client.EXPECT().
-		StreamEntries(
-			gomock.Any(),
-			gomock.Cond(
-				func(v any) bool {
-					req, ok := v.(*api.StreamEntriesRequest)
-					return ok && req.GetFeedId() == uint32(8)
-				},
-			),
-		).
-		Return(streamClient2, nil)
-	streamClient2.EXPECT().
-		Recv().
+		ListEntries(gomock.Any(), listEntriesReq(8, ""), gomock.Any()).
 		Return(
-			&api.StreamEntriesResponse{Entry: &api.Entry{Id: uint32(3), Title: "F3-A"}},
+			&api.ListEntriesResponse{Entries: []*api.Entry{{Id: uint32(3), Title: "F3-A"}}},
 			nil,
 		)
-	streamClient2.EXPECT().
-		Recv().
-		Return(nil, io.EOF)
 
 	feeds, err := rpc.GetAllFeedsF(context.Background())()
 	r.NoError(err)
@@ -169,13 +305,12 @@ func TestGetAllFeedsFErrList(t *testing.T) {
 	a.EqualError(err, "nope")
 }
 
-func TestGetAllFeedsFErrStream(t *testing.T) {
+func TestGetAllFeedsFErrListEntries(t *testing.T) {
 	t.Parallel()
 
 	r := require.New(t)
 	a := assert.New(t)
 	rpc, client := newBackendRPCTest(t)
-	streamClient1 := NewMockNeon_StreamEntriesClient(gomock.NewController(t))
 
 	client.EXPECT().
 		ListFeeds(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -202,86 +337,134 @@ func TestGetAllFeedsFErrStream(t *testing.T) {
 		)
 
 	client.EXPECT().
-		StreamEntries(gomock.Any(), gomock.Any()).
-		Return(streamClient1, nil)
-	streamClient1.EXPECT().
-		Recv().
-		Return(&api.StreamEntriesResponse{Entry: &api.Entry{Title: "F1-A"}}, nil)
-	streamClient1.EXPECT().
-		Recv().
-		Return(&api.StreamEntriesResponse{Entry: &api.Entry{Title: "F1-B"}}, nil)
-	streamClient1.EXPECT().
-		Recv().
-		Return(nil, io.EOF)
-
+		ListEntries(gomock.Any(), listEntriesReq(5, ""), gomock.Any()).
+		Return(
+			&api.ListEntriesResponse{
+				Entries:       []*api.Entry{{Id: uint32(1), Title: "F1-A"}},
+				NextPageToken: "next",
+			},
+			nil,
+		)
 	client.EXPECT().
-		StreamEntries(gomock.Any(), gomock.Any()).
-		Return(nil, fmt.Errorf("bzzt"))
+		ListEntries(gomock.Any(), listEntriesReq(5, "next"), gomock.Any()).
+		Return(nil, fmt.Errorf("cracck"))
+	client.EXPECT().
+		ListEntries(gomock.Any(), listEntriesReq(8, ""), gomock.Any()).
+		Return(&api.ListEntriesResponse{}, nil).
+		MaxTimes(1)
 
 	feeds, err := rpc.GetAllFeedsF(context.Background())()
 	r.Nil(feeds)
-	a.EqualError(err, "bzzt")
+	a.EqualError(err, "cracck")
 }
 
-func TestGetAllFeedsFErrStreamRecv(t *testing.T) {
+func TestStreamEntriesFOk(t *testing.T) {
 	t.Parallel()
 
 	r := require.New(t)
 	a := assert.New(t)
 	rpc, client := newBackendRPCTest(t)
-	streamClient1 := NewMockNeon_StreamEntriesClient(gomock.NewController(t))
-	streamClient2 := NewMockNeon_StreamEntriesClient(gomock.NewController(t))
+	streamClient := NewMockNeon_StreamEntriesClient(gomock.NewController(t))
 
 	client.EXPECT().
-		ListFeeds(gomock.Any(), gomock.Any(), gomock.Any()).
+		StreamEntries(gomock.Any(), gomock.Any()).
+		Return(streamClient, nil)
+	streamClient.EXPECT().
+		Recv().
 		Return(
-			&api.ListFeedsResponse{
-				Feeds: []*api.Feed{
-					{
-						Id:           uint32(5),
-						Title:        "F1",
-						FeedUrl:      "https://f1.com/feed.xml",
-						SubTime:      timestamppb.New(time.Now()),
-						LastPullTime: timestamppb.New(time.Now()),
-					},
-					{
-						Id:           uint32(8),
-						Title:        "F3",
-						FeedUrl:      "https://f3.com/feed.xml",
-						SubTime:      timestamppb.New(time.Now()),
-						LastPullTime: timestamppb.New(time.Now()),
-					},
-				},
-			},
+			&api.StreamEntriesResponse{Entry: &api.Entry{Id: uint32(1), FeedId: uint32(5)}},
 			nil,
 		)
-
-	client.EXPECT().
-		StreamEntries(gomock.Any(), gomock.Any()).
-		Return(streamClient1, nil)
-	streamClient1.EXPECT().
-		Recv().
-		Return(&api.StreamEntriesResponse{Entry: &api.Entry{Title: "F1-A"}}, nil)
-	streamClient1.EXPECT().
+	streamClient.EXPECT().
 		Recv().
-		Return(&api.StreamEntriesResponse{Entry: &api.Entry{Title: "F1-B"}}, nil)
-	streamClient1.EXPECT().
+		Return(
+			&api.StreamEntriesResponse{Entry: &api.Entry{Id: uint32(2), FeedId: uint32(8)}},
+			nil,
+		)
+	streamClient.EXPECT().
 		Recv().
 		Return(nil, io.EOF)
 
+	ch, errCh, err := rpc.StreamEntriesF(context.Background())()
+	r.NoError(err)
+
+	entries := make([]*entity.Entry, 0)
+	for entry := range ch {
+		entries = append(entries, entry)
+	}
+	r.Len(entries, 2)
+	a.Equal(uint32(1), entries[0].ID)
+	a.Equal(uint32(5), entries[0].FeedID)
+	a.Equal(uint32(2), entries[1].ID)
+	a.Equal(uint32(8), entries[1].FeedID)
+	a.ErrorIs(<-errCh, errStreamEnded)
+}
+
+func TestStreamEntriesFErrStream(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+	streamClient := NewMockNeon_StreamEntriesClient(gomock.NewController(t))
+
 	client.EXPECT().
 		StreamEntries(gomock.Any(), gomock.Any()).
-		Return(streamClient2, nil)
-	streamClient2.EXPECT().
+		Return(streamClient, nil)
+	streamClient.EXPECT().
 		Recv().
-		Return(&api.StreamEntriesResponse{Entry: &api.Entry{Title: "F3-A"}}, nil)
-	streamClient2.EXPECT().
+		Return(nil, fmt.Errorf("stream fail"))
+
+	ch, errCh, err := rpc.StreamEntriesF(context.Background())()
+	r.NoError(err)
+
+	_, open := <-ch
+	a.False(open)
+	a.EqualError(<-errCh, "stream fail")
+}
+
+func TestStreamEntriesFErrCanceled(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+	streamClient := NewMockNeon_StreamEntriesClient(gomock.NewController(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client.EXPECT().
+		StreamEntries(gomock.Any(), gomock.Any()).
+		Return(streamClient, nil)
+	streamClient.EXPECT().
 		Recv().
-		Return(nil, fmt.Errorf("cracck"))
+		DoAndReturn(func() (*api.StreamEntriesResponse, error) {
+			cancel()
+			return nil, status.Error(codes.Canceled, "context canceled")
+		})
 
-	feeds, err := rpc.GetAllFeedsF(context.Background())()
-	r.Nil(feeds)
-	a.EqualError(err, "cracck")
+	ch, errCh, err := rpc.StreamEntriesF(ctx)()
+	r.NoError(err)
+
+	_, open := <-ch
+	a.False(open)
+	a.ErrorIs(<-errCh, context.Canceled)
+}
+
+func TestStreamEntriesFErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	rpc, client := newBackendRPCTest(t)
+
+	client.EXPECT().
+		StreamEntries(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("nope"))
+
+	ch, errCh, err := rpc.StreamEntriesF(context.Background())()
+	r.Nil(ch)
+	r.Nil(errCh)
+	a.EqualError(err, "nope")
 }
 
 func TestPullFeedsFExtended(t *testing.T) {
@@ -309,7 +492,7 @@ func TestPullFeedsFExtended(t *testing.T) {
 		Recv().
 		Return(
 			&api.PullFeedsResponse{
-				Url: "https://ok.com/feed.xml",
+				Url: "http://ok.com/feed.xml",
 				Feed: &api.Feed{
 					Id:           uint32(5),
 					Title:        "OK",
@@ -317,7 +500,8 @@ func TestPullFeedsFExtended(t *testing.T) {
 					SubTime:      timestamppb.New(time.Now()),
 					LastPullTime: timestamppb.New(time.Now()),
 				},
-				Error: nil,
+				Error:  nil,
+				NewUrl: pointer("https://ok.com/feed.xml"),
 			},
 			nil,
 		)
@@ -339,9 +523,11 @@ func TestPullFeedsFExtended(t *testing.T) {
 	a.Equal("https://err.com/feed.xml", pr0.URL())
 	a.Nil(pr0.Feed())
 	a.EqualError(pr0.Error(), "http 404")
+	a.Empty(pr0.NewURL())
 
 	pr1 := prs[1] // #nosec: G602
-	a.Equal("https://ok.com/feed.xml", pr1.URL())
+	a.Equal("http://ok.com/feed.xml", pr1.URL())
+	a.Equal("https://ok.com/feed.xml", pr1.NewURL())
 	a.NotNil(pr1.Feed())
 	a.Equal("OK", pr1.Feed().Title)
 	a.Nil(pr1.Error())
@@ -401,3 +587,15 @@ func newBackendRPCTest(t *testing.T) (*RPC, *MockNeonClient) {
 }
 
 func pointer[T any](value T) *T { return &value }
+
+func listEntriesReq(feedID entity.ID, pageToken string) gomock.Matcher {
+	return gomock.Cond(
+		func(v any) bool {
+			req, ok := v.(*api.ListEntriesRequest)
+			return ok &&
+				len(req.GetFeedIds()) == 1 &&
+				req.GetFeedIds()[0] == feedID &&
+				req.GetPageToken() == pageToken
+		},
+	)
+}
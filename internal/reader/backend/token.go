@@ -0,0 +1,37 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package backend
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// tokenCredentials attaches a bearer token to every call.
+type tokenCredentials struct {
+	token string
+}
+
+// Ensure tokenCredentials implements credentials.PerRPCCredentials.
+var _ credentials.PerRPCCredentials = tokenCredentials{}
+
+func (tc tokenCredentials) GetRequestMetadata(
+	_ context.Context,
+	_ ...string,
+) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + tc.token}, nil
+}
+
+// RequireTransportSecurity allows tokens to be sent without TLS, since unix socket and loopback
+// connections are not necessarily encrypted.
+func (tc tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// WithToken returns a dial option that authenticates all calls with the given bearer token.
+func WithToken(token string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(tokenCredentials{token: token})
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenCredentials(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	creds := tokenCredentials{token: "neon_secret"}
+	md, err := creds.GetRequestMetadata(context.Background())
+	r.NoError(err)
+
+	a.Equal(map[string]string{"authorization": "Bearer neon_secret"}, md)
+	a.False(creds.RequireTransportSecurity())
+}
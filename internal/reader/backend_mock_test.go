@@ -40,6 +40,20 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 	return m.recorder
 }
 
+// EditEntriesF mocks base method.
+func (m *MockBackend) EditEntriesF(arg0 context.Context, arg1 []*entity.EntryEditOp) func() ([]*entity.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditEntriesF", arg0, arg1)
+	ret0, _ := ret[0].(func() ([]*entity.Entry, error))
+	return ret0
+}
+
+// EditEntriesF indicates an expected call of EditEntriesF.
+func (mr *MockBackendMockRecorder) EditEntriesF(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditEntriesF", reflect.TypeOf((*MockBackend)(nil).EditEntriesF), arg0, arg1)
+}
+
 // GetAllFeedsF mocks base method.
 func (m *MockBackend) GetAllFeedsF(arg0 context.Context) func() ([]*entity.Feed, error) {
 	m.ctrl.T.Helper()
@@ -68,6 +82,20 @@ func (mr *MockBackendMockRecorder) GetStatsF(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsF", reflect.TypeOf((*MockBackend)(nil).GetStatsF), arg0)
 }
 
+// ListFoldersF mocks base method.
+func (m *MockBackend) ListFoldersF(arg0 context.Context) func() ([]*entity.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFoldersF", arg0)
+	ret0, _ := ret[0].(func() ([]*entity.Folder, error))
+	return ret0
+}
+
+// ListFoldersF indicates an expected call of ListFoldersF.
+func (mr *MockBackendMockRecorder) ListFoldersF(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFoldersF", reflect.TypeOf((*MockBackend)(nil).ListFoldersF), arg0)
+}
+
 // PullFeedsF mocks base method.
 func (m *MockBackend) PullFeedsF(arg0 context.Context, arg1 []entity.ID) func() (<-chan entity.PullResult, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +110,34 @@ func (mr *MockBackendMockRecorder) PullFeedsF(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFeedsF", reflect.TypeOf((*MockBackend)(nil).PullFeedsF), arg0, arg1)
 }
 
+// SearchEntriesF mocks base method.
+func (m *MockBackend) SearchEntriesF(arg0 context.Context, arg1 string) func() ([]*entity.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEntriesF", arg0, arg1)
+	ret0, _ := ret[0].(func() ([]*entity.SearchResult, error))
+	return ret0
+}
+
+// SearchEntriesF indicates an expected call of SearchEntriesF.
+func (mr *MockBackendMockRecorder) SearchEntriesF(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEntriesF", reflect.TypeOf((*MockBackend)(nil).SearchEntriesF), arg0, arg1)
+}
+
+// StreamEntriesF mocks base method.
+func (m *MockBackend) StreamEntriesF(arg0 context.Context) func() (<-chan *entity.Entry, <-chan error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamEntriesF", arg0)
+	ret0, _ := ret[0].(func() (<-chan *entity.Entry, <-chan error, error))
+	return ret0
+}
+
+// StreamEntriesF indicates an expected call of StreamEntriesF.
+func (mr *MockBackendMockRecorder) StreamEntriesF(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEntriesF", reflect.TypeOf((*MockBackend)(nil).StreamEntriesF), arg0)
+}
+
 // String mocks base method.
 func (m *MockBackend) String() string {
 	m.ctrl.T.Helper()
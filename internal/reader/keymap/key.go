@@ -0,0 +1,145 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package keymap
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+const (
+	altPrefix   = "Alt-"
+	ctrlPrefix  = "Ctrl-"
+	shiftPrefix = "Shift-"
+
+	spaceName = "Space"
+)
+
+// keyNames maps the lowercased names of the special keys to their names.
+var keyNames = func() map[string]string {
+	names := map[string]string{
+		"escape": tcell.KeyNames[tcell.KeyEscape],
+		"return": tcell.KeyNames[tcell.KeyEnter],
+	}
+	for _, name := range tcell.KeyNames {
+		names[strings.ToLower(name)] = name
+	}
+	return names
+}()
+
+// KeyName returns the name of the key pressed in the given event, in the form used in bindings.
+func KeyName(event *tcell.EventKey) string {
+	var (
+		mods = event.Modifiers()
+		alt  = mods&tcell.ModAlt != 0
+	)
+
+	if event.Key() == tcell.KeyRune {
+		name := string(event.Rune())
+		if name == " " {
+			name = spaceName
+		}
+		return fmtKey(alt, false, false, name)
+	}
+
+	name, found := tcell.KeyNames[event.Key()]
+	if !found {
+		name = fmt.Sprintf("Key[%d]", event.Key())
+	}
+	ctrl := mods&tcell.ModCtrl != 0 && !strings.HasPrefix(name, ctrlPrefix)
+	shift := mods&tcell.ModShift != 0
+
+	return fmtKey(alt, ctrl, shift, name)
+}
+
+// parseKeys parses a sequence of keys separated by spaces into the form used in bindings.
+func parseKeys(raw string) (string, error) {
+	fields := strings.Fields(raw)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("empty key sequence")
+	}
+	keys := make([]string, len(fields))
+	for i, field := range fields {
+		key, err := parseKey(field)
+		if err != nil {
+			return "", err
+		}
+		keys[i] = key
+	}
+	return strings.Join(keys, " "), nil
+}
+
+// parseKey parses a single key, which is either a character or the name of a special key,
+// optionally prefixed with 'Alt-' ('M-'), 'Ctrl-' ('C-'), or 'Shift-' ('S-').
+func parseKey(raw string) (string, error) {
+	var (
+		alt, ctrl, shift bool
+		rest             = raw
+	)
+	for {
+		prefix, name, found := strings.Cut(rest, "-")
+		if !found || name == "" {
+			break
+		}
+		switch strings.ToLower(prefix) {
+		case "alt", "a", "meta", "m":
+			alt = true
+		case "ctrl", "c":
+			ctrl = true
+		case "shift", "s":
+			shift = true
+		default:
+			return "", fmt.Errorf("unknown key modifier %q in %q", prefix, raw)
+		}
+		rest = name
+	}
+
+	if utf8.RuneCountInString(rest) == 1 {
+		if shift {
+			return "", fmt.Errorf("invalid key %q: use the shifted character instead", raw)
+		}
+		if ctrl {
+			name := ctrlPrefix + strings.ToUpper(rest)
+			if _, found := keyNames[strings.ToLower(name)]; !found {
+				return "", fmt.Errorf("unknown key %q", raw)
+			}
+			return fmtKey(alt, false, false, name), nil
+		}
+		return fmtKey(alt, false, false, rest), nil
+	}
+
+	if strings.EqualFold(rest, spaceName) {
+		if ctrl || shift {
+			return "", fmt.Errorf("unknown key %q", raw)
+		}
+		return fmtKey(alt, false, false, spaceName), nil
+	}
+	name, found := keyNames[strings.ToLower(rest)]
+	if !found {
+		return "", fmt.Errorf("unknown key %q", raw)
+	}
+	if strings.HasPrefix(name, ctrlPrefix) {
+		ctrl = false
+	}
+
+	return fmtKey(alt, ctrl, shift, name), nil
+}
+
+func fmtKey(alt, ctrl, shift bool, name string) string {
+	var b strings.Builder
+	if alt {
+		b.WriteString(altPrefix)
+	}
+	if ctrl {
+		b.WriteString(ctrlPrefix)
+	}
+	if shift {
+		b.WriteString(shiftPrefix)
+	}
+	b.WriteString(name)
+	return b.String()
+}
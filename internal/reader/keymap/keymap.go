@@ -0,0 +1,437 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package keymap defines the actions of the reader and the keys bound to them.
+package keymap
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Scope is the part of the reader in which the keys of an action are handled.
+type Scope uint8
+
+const (
+	ScopeFeedsPane Scope = iota
+	ScopeEntriesPane
+	ScopeGlobal
+)
+
+func (s Scope) String() string {
+	switch s {
+	case ScopeFeedsPane:
+		return "feeds pane"
+	case ScopeEntriesPane:
+		return "entries pane"
+	case ScopeGlobal:
+		return "global"
+	default:
+		return fmt.Sprintf("Scope(%d)", s)
+	}
+}
+
+// Names of the reader actions.
+const (
+	ActionPullAllFeeds      = "pull-all-feeds"
+	ActionPullFeed          = "pull-feed"
+	ActionMarkFeedsRead     = "mark-feeds-read"
+	ActionToggleAllFolds    = "toggle-all-folds"
+	ActionToggleFold        = "toggle-fold"
+	ActionToggleGrouping    = "toggle-grouping"
+	ActionToggleEntryRead   = "toggle-entry-read"
+	ActionToggleBookmark    = "toggle-bookmark"
+	ActionSearch            = "search"
+	ActionFocusFeedsPane    = "focus-feeds-pane"
+	ActionFocusEntriesPane  = "focus-entries-pane"
+	ActionFocusReadingPane  = "focus-reading-pane"
+	ActionFocusNextPane     = "focus-next-pane"
+	ActionFocusPreviousPane = "focus-previous-pane"
+	ActionToggleStatusBar   = "toggle-status-bar"
+	ActionClearStatusBar    = "clear-status-bar"
+	ActionUnfocus           = "unfocus"
+	ActionReloadTheme       = "reload-theme"
+	ActionToggleStats       = "toggle-stats"
+	ActionToggleAbout       = "toggle-about"
+	ActionToggleHelp        = "toggle-help"
+	ActionQuit              = "quit"
+)
+
+// Action is an action of the reader that keys can be bound to.
+type Action struct {
+	Name        string
+	Scope       Scope
+	Description string
+}
+
+// actions are all actions of the reader, in the order in which they are shown in the help.
+var actions = []Action{
+	{ActionPullFeed, ScopeFeedsPane, "Pull current feed"},
+	{ActionPullAllFeeds, ScopeFeedsPane, "Pull all feeds"},
+	{ActionMarkFeedsRead, ScopeFeedsPane, "Mark all entries in current feed or group read"},
+	{ActionToggleFold, ScopeFeedsPane, "Expand / collapse current group"},
+	{ActionToggleAllFolds, ScopeFeedsPane, "Expand / collapse all feeds"},
+	{ActionToggleGrouping, ScopeFeedsPane, "Group feeds by update time / folder"},
+
+	{ActionToggleEntryRead, ScopeEntriesPane, "Toggle current entry read / unread"},
+	{ActionToggleBookmark, ScopeEntriesPane, "Add / remove current entry from bookmarks"},
+
+	{ActionSearch, ScopeGlobal, "Search entries"},
+	{ActionFocusFeedsPane, ScopeGlobal, "Set focus to feeds pane"},
+	{ActionFocusEntriesPane, ScopeGlobal, "Set focus to entries pane"},
+	{ActionFocusReadingPane, ScopeGlobal, "Set focus to reading pane"},
+	{ActionFocusNextPane, ScopeGlobal, "Switch to next pane"},
+	{ActionFocusPreviousPane, ScopeGlobal, "Switch to previous pane"},
+	{ActionToggleStatusBar, ScopeGlobal, "Toggle status bar"},
+	{ActionClearStatusBar, ScopeGlobal, "Clear status bar"},
+	{ActionUnfocus, ScopeGlobal, "Unset current focus or close open frame"},
+	{ActionReloadTheme, ScopeGlobal, "Reload theme"},
+	{ActionToggleStats, ScopeGlobal, "Toggle stats popup and show latest values"},
+	{ActionToggleAbout, ScopeGlobal, "Toggle 'about' popup"},
+	{ActionToggleHelp, ScopeGlobal, "Toggle this help"},
+	{ActionQuit, ScopeGlobal, "Quit reader"},
+}
+
+// Actions returns all actions of the reader, in the order in which they are shown in the help.
+func Actions() []Action {
+	return append([]Action(nil), actions...)
+}
+
+func findAction(name string) (Action, bool) {
+	for _, action := range actions {
+		if action.Name == name {
+			return action, true
+		}
+	}
+	return Action{}, false
+}
+
+const DefaultPreset = "default"
+
+// presets are the built-in keymaps. Presets other than the default one only set the bindings
+// that differ from the default ones.
+var presets = map[string]map[string][]string{
+	DefaultPreset: {
+		ActionPullFeed:       {"p"},
+		ActionPullAllFeeds:   {"P"},
+		ActionMarkFeedsRead:  {"r"},
+		ActionToggleFold:     {"z"},
+		ActionToggleAllFolds: {"Z"},
+		ActionToggleGrouping: {"g"},
+
+		ActionToggleEntryRead: {"r"},
+		ActionToggleBookmark:  {"m"},
+
+		ActionSearch:            {"/"},
+		ActionFocusFeedsPane:    {"F"},
+		ActionFocusEntriesPane:  {"E"},
+		ActionFocusReadingPane:  {"R"},
+		ActionFocusNextPane:     {"Tab"},
+		ActionFocusPreviousPane: {"Alt-Tab"},
+		ActionToggleStatusBar:   {"b"},
+		ActionClearStatusBar:    {"c"},
+		ActionUnfocus:           {"Esc"},
+		ActionReloadTheme:       {"T"},
+		ActionToggleStats:       {"S"},
+		ActionToggleAbout:       {"A"},
+		ActionToggleHelp:        {"H", "?"},
+		ActionQuit:              {"q"},
+	},
+	"vim": {
+		ActionToggleFold:        {"z a"},
+		ActionToggleAllFolds:    {"z A"},
+		ActionFocusNextPane:     {"Tab", "Ctrl-W w"},
+		ActionFocusPreviousPane: {"Alt-Tab", "Ctrl-W W"},
+		ActionToggleHelp:        {"H", "?", ": h"},
+		ActionQuit:              {"q", ": q"},
+	},
+	"emacs": {
+		ActionSearch:        {"/", "Ctrl-S"},
+		ActionFocusNextPane: {"Tab", "Ctrl-X o"},
+		ActionUnfocus:       {"Esc", "Ctrl-G"},
+		ActionQuit:          {"q", "Ctrl-X Ctrl-C"},
+	},
+}
+
+// Presets returns the names of the built-in keymaps.
+func Presets() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Keymap binds key sequences to actions. A key sequence is one or more keys separated by spaces,
+// which are pressed one after another.
+type Keymap struct {
+	name     string
+	bindings map[string][]string
+
+	// lookup maps the key sequences of each scope to their actions.
+	lookup map[Scope]map[string]string
+	// prefixes holds the incomplete key sequences of each scope.
+	prefixes map[Scope]map[string]bool
+}
+
+// Default returns the default keymap.
+func Default() *Keymap {
+	km, err := newKeymap(DefaultPreset, presets[DefaultPreset])
+	if err != nil {
+		panic(err)
+	}
+	return km
+}
+
+// Load loads the keymap with the given name, or from the given file if the name is a path to a
+// TOML file. Presets take precedence over the keymap files in the given directory.
+//
+// Keymap files set the bindings of actions on top of their base keymap, which is the default
+// preset unless set otherwise with the base setting. The bindings are set in a table of action
+// names to one key sequence or a list of key sequences; an empty list unbinds an action.
+func Load(name string, dir string) (*Keymap, error) {
+	bindings, err := loadBindings(name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	km, err := newKeymap(name, bindings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keymap %q: %w", name, err)
+	}
+	return km, nil
+}
+
+const (
+	baseKey     = "base"
+	bindingsKey = "bindings"
+)
+
+// loadBindings loads the raw bindings of the keymap with the given name, with the bindings of its
+// base keymaps applied first.
+func loadBindings(name string, dir string, children []string) (map[string][]string, error) {
+	for _, child := range children {
+		if child == name {
+			return nil, fmt.Errorf("keymap %q inherits from itself", name)
+		}
+	}
+
+	bindings := make(map[string][]string)
+	for action, keys := range presets[DefaultPreset] {
+		bindings[action] = keys
+	}
+	if preset, found := presets[name]; found {
+		for action, keys := range preset {
+			bindings[action] = keys
+		}
+		return bindings, nil
+	}
+
+	raw, err := readKeymapFile(name, dir)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Base     string         `toml:"base"`
+		Bindings map[string]any `toml:"bindings"`
+	}
+	decoder := toml.NewDecoder(strings.NewReader(string(raw))).DisallowUnknownFields()
+	if err = decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("invalid keymap %q: %w", name, err)
+	}
+
+	base := file.Base
+	if base == "" {
+		base = DefaultPreset
+	}
+	if bindings, err = loadBindings(base, dir, append(children, name)); err != nil {
+		return nil, err
+	}
+	for action, value := range file.Bindings {
+		keys, err := toKeys(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keymap %q: %s of %q", name, err, action)
+		}
+		bindings[action] = keys
+	}
+
+	return bindings, nil
+}
+
+func readKeymapFile(name string, dir string) ([]byte, error) {
+	if strings.HasSuffix(name, ".toml") || strings.ContainsRune(name, filepath.Separator) {
+		return os.ReadFile(name)
+	}
+	if dir != "" {
+		raw, err := os.ReadFile(filepath.Join(dir, name+".toml"))
+		if err == nil {
+			return raw, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("keymap %q does not exist", name)
+}
+
+// toKeys converts the given binding setting to its key sequences.
+func toKeys(value any) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		keys := make([]string, len(v))
+		for i, item := range v {
+			key, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid keys")
+			}
+			keys[i] = key
+		}
+		return keys, nil
+	default:
+		return nil, fmt.Errorf("invalid keys")
+	}
+}
+
+// newKeymap creates a keymap from the given raw bindings, checking that all actions exist and
+// that every key sequence is bound to only one action.
+func newKeymap(name string, raw map[string][]string) (*Keymap, error) {
+	km := Keymap{
+		name:     name,
+		bindings: make(map[string][]string),
+		lookup:   make(map[Scope]map[string]string),
+		prefixes: make(map[Scope]map[string]bool),
+	}
+
+	names := make([]string, 0, len(raw))
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		action, found := findAction(name)
+		if !found {
+			return nil, fmt.Errorf("unknown action %q", name)
+		}
+		for _, rawKeys := range raw[name] {
+			keys, err := parseKeys(rawKeys)
+			if err != nil {
+				return nil, fmt.Errorf("invalid keys of %q: %w", name, err)
+			}
+			if err = km.bind(action, keys); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &km, nil
+}
+
+// bind binds the given key sequence to the given action. Since the global keys are handled
+// before the keys of the panes, global key sequences may not overlap with those of any pane.
+func (km *Keymap) bind(action Action, keys string) error {
+	scopes := []Scope{action.Scope}
+	if action.Scope == ScopeGlobal {
+		scopes = []Scope{ScopeGlobal, ScopeFeedsPane, ScopeEntriesPane}
+	} else {
+		scopes = append(scopes, ScopeGlobal)
+	}
+	for _, scope := range scopes {
+		if err := km.checkOverlap(scope, action, keys); err != nil {
+			return err
+		}
+	}
+
+	if km.lookup[action.Scope] == nil {
+		km.lookup[action.Scope] = make(map[string]string)
+		km.prefixes[action.Scope] = make(map[string]bool)
+	}
+	km.lookup[action.Scope][keys] = action.Name
+	fields := strings.Fields(keys)
+	for i := 1; i < len(fields); i++ {
+		km.prefixes[action.Scope][strings.Join(fields[:i], " ")] = true
+	}
+	km.bindings[action.Name] = append(km.bindings[action.Name], keys)
+
+	return nil
+}
+
+func (km *Keymap) checkOverlap(scope Scope, action Action, keys string) error {
+	for bound, other := range km.lookup[scope] {
+		switch {
+		case bound == keys:
+			return fmt.Errorf("keys %q are bound to both %q and %q", keys, other, action.Name)
+		case strings.HasPrefix(bound, keys+" "):
+			return fmt.Errorf(
+				"keys %q of %q start the keys %q of %q",
+				keys, action.Name, bound, other,
+			)
+		case strings.HasPrefix(keys, bound+" "):
+			return fmt.Errorf(
+				"keys %q of %q start the keys %q of %q",
+				bound, other, keys, action.Name,
+			)
+		}
+	}
+	return nil
+}
+
+// Name returns the name of the keymap.
+func (km *Keymap) Name() string {
+	return km.name
+}
+
+// Keys returns the key sequences bound to the given action.
+func (km *Keymap) Keys(action string) []string {
+	return km.bindings[action]
+}
+
+// Matcher returns a new matcher of the keys of the given scope.
+func (km *Keymap) Matcher(scope Scope) *Matcher {
+	return &Matcher{km: km, scope: scope}
+}
+
+// Matcher matches pressed keys to actions, keeping track of the keys pressed so far of key
+// sequences that are not complete yet. It is not safe for concurrent use.
+type Matcher struct {
+	km      *Keymap
+	scope   Scope
+	pending []string
+}
+
+// Match returns the name of the action whose key sequence is completed by the key of the given
+// event. It returns true if the key was consumed, which is also the case if the key continues
+// a key sequence that is not complete yet, for which the returned name is empty.
+func (m *Matcher) Match(event *tcell.EventKey) (string, bool) {
+	return m.match(KeyName(event))
+}
+
+func (m *Matcher) match(key string) (string, bool) {
+	keys := strings.Join(append(m.pending, key), " ")
+
+	if action, found := m.km.lookup[m.scope][keys]; found {
+		m.pending = nil
+		return action, true
+	}
+	if m.km.prefixes[m.scope][keys] {
+		m.pending = append(m.pending, key)
+		return "", true
+	}
+
+	// The key does not continue the pending sequence, so it may start a new one.
+	if len(m.pending) > 0 {
+		m.pending = nil
+		return m.match(key)
+	}
+	return "", false
+}
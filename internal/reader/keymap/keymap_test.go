@@ -0,0 +1,209 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package keymap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestKeymap(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestKeyName(t *testing.T) {
+	t.Parallel()
+
+	for want, event := range map[string]*tcell.EventKey{
+		"q":             tcell.NewEventKey(tcell.KeyRune, 'q', tcell.ModNone),
+		"Space":         tcell.NewEventKey(tcell.KeyRune, ' ', tcell.ModNone),
+		"Alt-x":         tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModAlt),
+		"Tab":           tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone),
+		"Alt-Tab":       tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModAlt),
+		"Ctrl-W":        tcell.NewEventKey(tcell.KeyCtrlW, 0, tcell.ModCtrl),
+		"Ctrl-Shift-Up": tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModCtrl|tcell.ModShift),
+	} {
+		assert.Equal(t, want, KeyName(event))
+	}
+}
+
+func TestParseKeysOk(t *testing.T) {
+	t.Parallel()
+
+	for raw, want := range map[string]string{
+		"q":              "q",
+		"?":              "?",
+		"-":              "-",
+		"space":          "Space",
+		"M-x":            "Alt-x",
+		"alt-tab":        "Alt-Tab",
+		"C-x  C-c":       "Ctrl-X Ctrl-C",
+		"ctrl-w W":       "Ctrl-W W",
+		"escape":         "Esc",
+		"S-C-up":         "Ctrl-Shift-Up",
+		"Ctrl-Backspace": "Ctrl-Backspace",
+	} {
+		keys, err := parseKeys(raw)
+		require.NoError(t, err, raw)
+		assert.Equal(t, want, keys, raw)
+	}
+}
+
+func TestParseKeysErr(t *testing.T) {
+	t.Parallel()
+
+	for raw, msg := range map[string]string{
+		"":         "empty key sequence",
+		"Hyper-x":  `unknown key modifier "Hyper" in "Hyper-x"`,
+		"S-a":      `invalid key "S-a": use the shifted character instead`,
+		"C-1":      `unknown key "C-1"`,
+		"Shift-Sp": `unknown key "Shift-Sp"`,
+		"q qq":     `unknown key "qq"`,
+	} {
+		_, err := parseKeys(raw)
+		assert.EqualError(t, err, msg, raw)
+	}
+}
+
+func TestPresetsOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	a.Equal([]string{"default", "emacs", "vim"}, Presets())
+	for _, name := range Presets() {
+		km, err := Load(name, "")
+		r.NoError(err, name)
+		a.Equal(name, km.Name())
+	}
+
+	km := Default()
+	for _, action := range Actions() {
+		a.NotEmpty(km.Keys(action.Name), action.Name)
+	}
+	a.Equal([]string{"H", "?"}, km.Keys(ActionToggleHelp))
+
+	vim, err := Load("vim", "")
+	r.NoError(err)
+	a.Equal([]string{"z a"}, vim.Keys(ActionToggleFold))
+	a.Equal([]string{"p"}, vim.Keys(ActionPullFeed))
+}
+
+func TestLoadFileOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	dir := t.TempDir()
+	writeTestKeymap(t, dir, "mine.toml", `
+base = "emacs"
+
+[bindings]
+toggle-help = "F1"
+pull-all-feeds = ["C-r", "P"]
+reload-theme = []
+`)
+	path := writeTestKeymap(t, dir, "other.toml", `
+base = "mine"
+
+[bindings]
+quit = "C-q"
+`)
+
+	km, err := Load("mine", dir)
+	r.NoError(err)
+	a.Equal("mine", km.Name())
+	a.Equal([]string{"F1"}, km.Keys(ActionToggleHelp))
+	a.Equal([]string{"Ctrl-R", "P"}, km.Keys(ActionPullAllFeeds))
+	a.Empty(km.Keys(ActionReloadTheme))
+	a.Equal([]string{"q", "Ctrl-X Ctrl-C"}, km.Keys(ActionQuit))
+
+	km, err = Load(path, dir)
+	r.NoError(err)
+	a.Equal([]string{"Ctrl-Q"}, km.Keys(ActionQuit))
+	a.Equal([]string{"F1"}, km.Keys(ActionToggleHelp))
+}
+
+func TestLoadErr(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestKeymap(t, dir, "action.toml", "[bindings]\nfly = \"f\"\n")
+	writeTestKeymap(t, dir, "key.toml", "[bindings]\nquit = \"C-q q\"\nsearch = \"Hyper-s\"\n")
+	writeTestKeymap(t, dir, "value.toml", "[bindings]\nquit = 1\n")
+	writeTestKeymap(t, dir, "same.toml", "[bindings]\nquit = \"S\"\n")
+	writeTestKeymap(t, dir, "prefix.toml", "[bindings]\ntoggle-fold = \"Z z\"\n")
+	writeTestKeymap(t, dir, "global.toml", "[bindings]\nquit = \"p q\"\n")
+	writeTestKeymap(t, dir, "setting.toml", "bindngs = {}\n")
+	writeTestKeymap(t, dir, "loop-a.toml", "base = \"loop-b\"\n")
+	writeTestKeymap(t, dir, "loop-b.toml", "base = \"loop-a\"\n")
+
+	for name, msg := range map[string]string{
+		"nope":   `keymap "nope" does not exist`,
+		"action": `invalid keymap "action": unknown action "fly"`,
+		"key": `invalid keymap "key": invalid keys of "search": ` +
+			`unknown key modifier "Hyper" in "Hyper-s"`,
+		"value":   `invalid keymap "value": invalid keys of "quit"`,
+		"same":    `invalid keymap "same": keys "S" are bound to both`,
+		"prefix":  `invalid keymap "prefix": keys "Z" of "toggle-all-folds" start the keys "Z z"`,
+		"global":  `invalid keymap "global": keys "p" of "pull-feed" start the keys "p q" of "quit"`,
+		"setting": `invalid keymap "setting"`,
+		"loop-a":  `keymap "loop-a" inherits from itself`,
+	} {
+		km, err := Load(name, dir)
+		assert.Nil(t, km, name)
+		assert.ErrorContains(t, err, msg, name)
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	km, err := Load("emacs", "")
+	require.NoError(t, err)
+
+	m := km.Matcher(ScopeGlobal)
+	for _, tc := range []struct {
+		key      string
+		action   string
+		consumed bool
+	}{
+		{"q", ActionQuit, true},
+		{"Ctrl-X", "", true},
+		{"Ctrl-C", ActionQuit, true},
+		{"Ctrl-X", "", true},
+		{"o", ActionFocusNextPane, true},
+		// A key that breaks a pending sequence is matched on its own.
+		{"Ctrl-X", "", true},
+		{"/", ActionSearch, true},
+		{"Ctrl-X", "", true},
+		{"j", "", false},
+		{"o", "", false},
+		{"p", "", false},
+	} {
+		action, consumed := m.match(tc.key)
+		a.Equal(tc.action, action, tc.key)
+		a.Equal(tc.consumed, consumed, tc.key)
+	}
+
+	action, consumed := km.Matcher(ScopeFeedsPane).Match(
+		tcell.NewEventKey(tcell.KeyRune, 'p', tcell.ModNone),
+	)
+	a.Equal(ActionPullFeed, action)
+	a.True(consumed)
+}
@@ -52,6 +52,18 @@ func (mr *MockOperatorMockRecorder) ClearStatusBar(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearStatusBar", reflect.TypeOf((*MockOperator)(nil).ClearStatusBar), arg0)
 }
 
+// EditEntries mocks base method.
+func (m *MockOperator) EditEntries(arg0 *ui.Display, arg1 func() ([]*entity.Entry, error)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EditEntries", arg0, arg1)
+}
+
+// EditEntries indicates an expected call of EditEntries.
+func (mr *MockOperatorMockRecorder) EditEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditEntries", reflect.TypeOf((*MockOperator)(nil).EditEntries), arg0, arg1)
+}
+
 // FocusEntriesPane mocks base method.
 func (m *MockOperator) FocusEntriesPane(arg0 *ui.Display) {
 	m.ctrl.T.Helper()
@@ -112,6 +124,20 @@ func (mr *MockOperatorMockRecorder) FocusReadingPane(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FocusReadingPane", reflect.TypeOf((*MockOperator)(nil).FocusReadingPane), arg0)
 }
 
+// GetCurrentEntry mocks base method.
+func (m *MockOperator) GetCurrentEntry(arg0 *ui.Display) *entity.Entry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentEntry", arg0)
+	ret0, _ := ret[0].(*entity.Entry)
+	return ret0
+}
+
+// GetCurrentEntry indicates an expected call of GetCurrentEntry.
+func (mr *MockOperatorMockRecorder) GetCurrentEntry(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentEntry", reflect.TypeOf((*MockOperator)(nil).GetCurrentEntry), arg0)
+}
+
 // GetCurrentFeed mocks base method.
 func (m *MockOperator) GetCurrentFeed(arg0 *ui.Display) *entity.Feed {
 	m.ctrl.T.Helper()
@@ -126,6 +152,20 @@ func (mr *MockOperatorMockRecorder) GetCurrentFeed(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentFeed", reflect.TypeOf((*MockOperator)(nil).GetCurrentFeed), arg0)
 }
 
+// GetCurrentFeeds mocks base method.
+func (m *MockOperator) GetCurrentFeeds(arg0 *ui.Display) []*entity.Feed {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentFeeds", arg0)
+	ret0, _ := ret[0].([]*entity.Feed)
+	return ret0
+}
+
+// GetCurrentFeeds indicates an expected call of GetCurrentFeeds.
+func (mr *MockOperatorMockRecorder) GetCurrentFeeds(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentFeeds", reflect.TypeOf((*MockOperator)(nil).GetCurrentFeeds), arg0)
+}
+
 // PopulateFeedsPane mocks base method.
 func (m *MockOperator) PopulateFeedsPane(arg0 *ui.Display, arg1 func() ([]*entity.Feed, error)) {
 	m.ctrl.T.Helper()
@@ -162,6 +202,18 @@ func (mr *MockOperatorMockRecorder) RefreshStats(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshStats", reflect.TypeOf((*MockOperator)(nil).RefreshStats), arg0, arg1)
 }
 
+// ReloadTheme mocks base method.
+func (m *MockOperator) ReloadTheme(arg0 *ui.Display) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReloadTheme", arg0)
+}
+
+// ReloadTheme indicates an expected call of ReloadTheme.
+func (mr *MockOperatorMockRecorder) ReloadTheme(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadTheme", reflect.TypeOf((*MockOperator)(nil).ReloadTheme), arg0)
+}
+
 // ShowIntroPopup mocks base method.
 func (m *MockOperator) ShowIntroPopup(arg0 *ui.Display) {
 	m.ctrl.T.Helper()
@@ -174,6 +226,30 @@ func (mr *MockOperatorMockRecorder) ShowIntroPopup(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowIntroPopup", reflect.TypeOf((*MockOperator)(nil).ShowIntroPopup), arg0)
 }
 
+// ShowSearchPopup mocks base method.
+func (m *MockOperator) ShowSearchPopup(arg0 *ui.Display, arg1 func(string) ([]*entity.SearchResult, error)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShowSearchPopup", arg0, arg1)
+}
+
+// ShowSearchPopup indicates an expected call of ShowSearchPopup.
+func (mr *MockOperatorMockRecorder) ShowSearchPopup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowSearchPopup", reflect.TypeOf((*MockOperator)(nil).ShowSearchPopup), arg0, arg1)
+}
+
+// StreamEntries mocks base method.
+func (m *MockOperator) StreamEntries(arg0 *ui.Display, arg1 func() (<-chan *entity.Entry, <-chan error, error), arg2 func() ([]*entity.Feed, error)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StreamEntries", arg0, arg1, arg2)
+}
+
+// StreamEntries indicates an expected call of StreamEntries.
+func (mr *MockOperatorMockRecorder) StreamEntries(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEntries", reflect.TypeOf((*MockOperator)(nil).StreamEntries), arg0, arg1, arg2)
+}
+
 // ToggleAboutPopup mocks base method.
 func (m *MockOperator) ToggleAboutPopup(arg0 *ui.Display, arg1 string) {
 	m.ctrl.T.Helper()
@@ -210,6 +286,18 @@ func (mr *MockOperatorMockRecorder) ToggleCurrentFeedFold(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleCurrentFeedFold", reflect.TypeOf((*MockOperator)(nil).ToggleCurrentFeedFold), arg0)
 }
 
+// ToggleFeedsGrouping mocks base method.
+func (m *MockOperator) ToggleFeedsGrouping(arg0 *ui.Display, arg1 func() ([]*entity.Folder, error)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ToggleFeedsGrouping", arg0, arg1)
+}
+
+// ToggleFeedsGrouping indicates an expected call of ToggleFeedsGrouping.
+func (mr *MockOperatorMockRecorder) ToggleFeedsGrouping(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleFeedsGrouping", reflect.TypeOf((*MockOperator)(nil).ToggleFeedsGrouping), arg0, arg1)
+}
+
 // ToggleHelpPopup mocks base method.
 func (m *MockOperator) ToggleHelpPopup(arg0 *ui.Display) {
 	m.ctrl.T.Helper()
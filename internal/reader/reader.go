@@ -13,6 +13,7 @@ import (
 
 	"github.com/bow/neon/internal/entity"
 	bknd "github.com/bow/neon/internal/reader/backend"
+	"github.com/bow/neon/internal/reader/keymap"
 	st "github.com/bow/neon/internal/reader/state"
 	"github.com/bow/neon/internal/reader/ui"
 )
@@ -24,6 +25,7 @@ type Reader struct {
 	opr     ui.Operator
 	backend bknd.Backend
 	state   st.State
+	keymap  *keymap.Keymap
 
 	callTimeout time.Duration
 
@@ -43,94 +45,93 @@ func (r *Reader) Start() error {
 		r.opr.PopulateFeedsPane(r.display, r.backend.GetAllFeedsF(ctx))
 		r.opr.RefreshStats(r.display, r.backend.GetStatsF(ctx))
 		r.opr.FocusFeedsPane(r.display)
+		r.opr.StreamEntries(r.display, r.backend.StreamEntriesF(r.ctx), r.reloadFeeds)
 		r.prestartDone <- struct{}{}
 	}()
 	return r.display.Start()
 }
 
-// nolint:revive
 func (r *Reader) globalKeyHandler() ui.KeyHandler {
 	r.mustDefinedFields()
 
-	statsPopupLock := make(chan struct{}, 1)
+	handler := r.keyHandler(keymap.ScopeGlobal, r.globalActions())
 
 	return func(event *tcell.EventKey) *tcell.EventKey {
-		var (
-			key  = event.Key()
-			keyr = event.Rune()
-		)
+		if r.display.InputActive() {
+			return event
+		}
+		return handler(event)
+	}
+}
 
-		// nolint:exhaustive
-		switch key {
-
-		case tcell.KeyRune:
-			switch keyr {
-			case 'A':
-				r.opr.ToggleAboutPopup(r.display, r.backend.String())
-				return nil
-
-			case 'E':
-				r.opr.FocusEntriesPane(r.display)
-				return nil
-
-			case 'F':
-				r.opr.FocusFeedsPane(r.display)
-				return nil
-
-			case 'R':
-				r.opr.FocusReadingPane(r.display)
-				return nil
-
-			case 'S':
-				go func() {
-					select {
-					case statsPopupLock <- struct{}{}:
-						defer func() { <-statsPopupLock }()
-					default:
-						return
-					}
-					ctx, cancel := r.callCtx()
-					defer cancel()
-					r.opr.ToggleStatsPopup(r.display, r.backend.GetStatsF(ctx))
-					r.display.Draw()
-				}()
-				return nil
-
-			case 'H', '?':
-				r.opr.ToggleHelpPopup(r.display)
-				return nil
-
-			case 'b':
-				r.opr.ToggleStatusBar(r.display)
-				return nil
-
-			case 'c':
-				r.opr.ClearStatusBar(r.display)
-				return nil
-
-			case 'q':
-				r.display.Stop()
-				return nil
-			}
+func (r *Reader) feedsPaneKeyHandler() ui.KeyHandler {
+	return r.keyHandler(keymap.ScopeFeedsPane, r.feedsPaneActions())
+}
 
-		case tcell.KeyTab:
-			if event.Modifiers()&tcell.ModAlt == 0 {
-				r.opr.FocusNextPane(r.display)
-			} else {
-				r.opr.FocusPreviousPane(r.display)
-			}
-			return nil
+func (r *Reader) entriesPaneKeyHandler() ui.KeyHandler {
+	return r.keyHandler(keymap.ScopeEntriesPane, r.entriesPaneActions())
+}
 
-		case tcell.KeyEscape:
-			r.opr.UnfocusFront(r.display)
-			return nil
+// keyHandler returns the handler that runs the actions whose keys are pressed in the given scope.
+// Keys that start a key sequence are consumed until the sequence is complete or broken.
+func (r *Reader) keyHandler(scope keymap.Scope, actions map[string]func()) ui.KeyHandler {
+	matcher := r.keymap.Matcher(scope)
+
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		name, consumed := matcher.Match(event)
+		if !consumed {
+			return event
 		}
+		if action, found := actions[name]; found {
+			action()
+		}
+		return nil
+	}
+}
+
+func (r *Reader) globalActions() map[string]func() {
+	statsPopupLock := make(chan struct{}, 1)
+
+	search := func(query string) ([]*entity.SearchResult, error) {
+		ctx, cancel := r.callCtx()
+		defer cancel()
+		return r.backend.SearchEntriesF(ctx, query)()
+	}
 
-		return event
+	return map[string]func(){
+		keymap.ActionToggleAbout: func() {
+			r.opr.ToggleAboutPopup(r.display, r.backend.String())
+		},
+		keymap.ActionFocusEntriesPane: func() { r.opr.FocusEntriesPane(r.display) },
+		keymap.ActionFocusFeedsPane:   func() { r.opr.FocusFeedsPane(r.display) },
+		keymap.ActionFocusReadingPane: func() { r.opr.FocusReadingPane(r.display) },
+		keymap.ActionToggleStats: func() {
+			go func() {
+				select {
+				case statsPopupLock <- struct{}{}:
+					defer func() { <-statsPopupLock }()
+				default:
+					return
+				}
+				ctx, cancel := r.callCtx()
+				defer cancel()
+				r.opr.ToggleStatsPopup(r.display, r.backend.GetStatsF(ctx))
+				r.display.Draw()
+			}()
+		},
+		keymap.ActionReloadTheme:       func() { r.opr.ReloadTheme(r.display) },
+		keymap.ActionToggleHelp:        func() { r.opr.ToggleHelpPopup(r.display) },
+		keymap.ActionSearch:            func() { r.opr.ShowSearchPopup(r.display, search) },
+		keymap.ActionToggleStatusBar:   func() { r.opr.ToggleStatusBar(r.display) },
+		keymap.ActionClearStatusBar:    func() { r.opr.ClearStatusBar(r.display) },
+		keymap.ActionQuit:              func() { r.display.Stop() },
+		keymap.ActionFocusNextPane:     func() { r.opr.FocusNextPane(r.display) },
+		keymap.ActionFocusPreviousPane: func() { r.opr.FocusPreviousPane(r.display) },
+		keymap.ActionUnfocus:           func() { r.opr.UnfocusFront(r.display) },
 	}
 }
 
-func (r *Reader) feedsPaneKeyHandler() ui.KeyHandler {
+func (r *Reader) feedsPaneActions() map[string]func() {
 	pullFeedsLock := make(chan struct{}, 1)
 
 	pullFeeds := func(feed *entity.Feed) {
@@ -158,35 +159,82 @@ func (r *Reader) feedsPaneKeyHandler() ui.KeyHandler {
 		r.opr.RefreshStats(r.display, r.backend.GetStatsF(ctxs))
 	}
 
-	return func(event *tcell.EventKey) *tcell.EventKey {
-		keyr := event.Rune()
-
-		// nolint:exhaustive
-		switch keyr {
-
-		case 'P':
-			go pullFeeds(nil)
-			return nil
-
-		case 'p':
+	return map[string]func(){
+		keymap.ActionPullAllFeeds: func() { go pullFeeds(nil) },
+		keymap.ActionPullFeed: func() {
 			if current := r.opr.GetCurrentFeed(r.display); current != nil {
 				go pullFeeds(current)
 			}
-			return nil
+		},
+		keymap.ActionMarkFeedsRead: func() {
+			ops := make([]*entity.EntryEditOp, 0)
+			for _, feed := range r.opr.GetCurrentFeeds(r.display) {
+				for _, entry := range feed.Entries {
+					if !entry.IsRead {
+						ops = append(ops, &entity.EntryEditOp{ID: entry.ID, IsRead: pointer(true)})
+					}
+				}
+			}
+			if len(ops) > 0 {
+				go r.editEntries(ops)
+			}
+		},
+		keymap.ActionToggleAllFolds: func() { r.opr.ToggleAllFeedsFold(r.display) },
+		keymap.ActionToggleGrouping: func() { go r.toggleFeedsGrouping() },
+		keymap.ActionToggleFold:     func() { r.opr.ToggleCurrentFeedFold(r.display) },
+	}
+}
 
-		case 'Z':
-			r.opr.ToggleAllFeedsFold(r.display)
-			return nil
+func (r *Reader) entriesPaneActions() map[string]func() {
+	return map[string]func(){
+		keymap.ActionToggleBookmark: func() {
+			if current := r.opr.GetCurrentEntry(r.display); current != nil {
+				op := entity.EntryEditOp{
+					ID:           current.ID,
+					IsBookmarked: pointer(!current.IsBookmarked),
+				}
+				go r.editEntries([]*entity.EntryEditOp{&op})
+			}
+		},
+		keymap.ActionToggleEntryRead: func() {
+			if current := r.opr.GetCurrentEntry(r.display); current != nil {
+				op := entity.EntryEditOp{ID: current.ID, IsRead: pointer(!current.IsRead)}
+				go r.editEntries([]*entity.EntryEditOp{&op})
+			}
+		},
+	}
+}
 
-		case 'z':
-			r.opr.ToggleCurrentFeedFold(r.display)
-			return nil
+// entryOpenedHandler returns the function that marks entries read once they are opened.
+func (r *Reader) entryOpenedHandler() func(*entity.Entry) {
+	return func(entry *entity.Entry) {
+		if entry.IsRead {
+			return
 		}
-
-		return event
+		op := entity.EntryEditOp{ID: entry.ID, IsRead: pointer(true)}
+		go r.editEntries([]*entity.EntryEditOp{&op})
 	}
 }
 
+func (r *Reader) editEntries(ops []*entity.EntryEditOp) {
+	ctx, cancel := r.callCtx()
+	defer cancel()
+	r.opr.EditEntries(r.display, r.backend.EditEntriesF(ctx, ops))
+}
+
+func (r *Reader) toggleFeedsGrouping() {
+	ctx, cancel := r.callCtx()
+	defer cancel()
+	r.opr.ToggleFeedsGrouping(r.display, r.backend.ListFoldersF(ctx))
+}
+
+// reloadFeeds fetches all feeds with their entries.
+func (r *Reader) reloadFeeds() ([]*entity.Feed, error) {
+	ctx, cancel := r.callCtx()
+	defer cancel()
+	return r.backend.GetAllFeedsF(ctx)()
+}
+
 func (r *Reader) callCtx() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(r.ctx, r.callTimeout)
 }
@@ -203,13 +251,21 @@ func (r *Reader) mustDefinedFields() {
 	if r.backend == nil {
 		panic("can not set handler with nil backend")
 	}
+
+	if r.keymap == nil {
+		panic("can not set handler with nil keymap")
+	}
 }
 
 type Builder struct {
 	ctx       context.Context
 	themeName string
+	themeDir  string
 	scr       tcell.Screen
 
+	keymapName string
+	keymapDir  string
+
 	// rpcBackend args.
 	addr           string
 	dopts          []grpc.DialOption
@@ -226,6 +282,7 @@ func NewBuilder(ctx context.Context) *Builder {
 	b := Builder{
 		ctx:         ctx,
 		themeName:   "dark",
+		keymapName:  keymap.DefaultPreset,
 		dopts:       nil,
 		callTimeout: 3 * time.Second,
 	}
@@ -262,6 +319,25 @@ func (b *Builder) Theme(name string) *Builder {
 	return b
 }
 
+// ThemeDir sets the directory where theme files are looked up by their names.
+func (b *Builder) ThemeDir(dir string) *Builder {
+	b.themeDir = dir
+	return b
+}
+
+// Keymap sets the keymap of the reader, which is either the name of a preset or of a keymap file
+// in the keymap directory, or the path to a keymap file.
+func (b *Builder) Keymap(name string) *Builder {
+	b.keymapName = name
+	return b
+}
+
+// KeymapDir sets the directory where keymap files are looked up by their names.
+func (b *Builder) KeymapDir(dir string) *Builder {
+	b.keymapDir = dir
+	return b
+}
+
 func (b *Builder) backend(be bknd.Backend) *Builder {
 	b.be = be
 	return b
@@ -288,9 +364,13 @@ func (b *Builder) Build() (*Reader, error) {
 		return nil, fmt.Errorf("reader server address must be specified")
 	}
 
+	km, err := keymap.Load(b.keymapName, b.keymapDir)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		be         bknd.Backend
-		err        error
 		connectCtx = b.ctx
 		cancel     context.CancelFunc
 	)
@@ -316,10 +396,11 @@ func (b *Builder) Build() (*Reader, error) {
 			return nil, err
 		}
 	}
-	dsp, err := ui.NewDisplay(scr, b.themeName)
+	dsp, err := ui.NewDisplay(scr, b.themeName, b.themeDir)
 	if err != nil {
 		return nil, err
 	}
+	dsp.SetKeymap(km)
 
 	var opr ui.Operator
 	if b.opr != nil {
@@ -341,6 +422,7 @@ func (b *Builder) Build() (*Reader, error) {
 		opr:     opr,
 		backend: be,
 		state:   stt,
+		keymap:  km,
 
 		callTimeout: b.callTimeout,
 
@@ -349,7 +431,11 @@ func (b *Builder) Build() (*Reader, error) {
 	rdr.display.SetHandlers(
 		rdr.globalKeyHandler(),
 		rdr.feedsPaneKeyHandler(),
+		rdr.entriesPaneKeyHandler(),
 	)
+	rdr.display.SetEntryOpenedFunc(rdr.entryOpenedHandler())
 
 	return &rdr, nil
 }
+
+func pointer[T any](value T) *T { return &value }
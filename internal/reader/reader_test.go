@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/reader/keymap"
 	"github.com/gdamore/tcell/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -123,6 +124,16 @@ func TestToggleStatsPopupCalled(t *testing.T) {
 	tw.screen.InjectKey(tcell.KeyRune, 'S', tcell.ModNone)
 }
 
+func TestShowSearchPopupCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	tw.opr.EXPECT().ShowSearchPopup(rdr.display, gomock.Any())
+
+	tw.screen.InjectKey(tcell.KeyRune, '/', tcell.ModNone)
+}
+
 func TestToggleStatusBarCalled(t *testing.T) {
 	tw := setupReaderTest(t)
 
@@ -133,6 +144,16 @@ func TestToggleStatusBarCalled(t *testing.T) {
 	tw.screen.InjectKey(tcell.KeyRune, 'b', tcell.ModNone)
 }
 
+func TestReloadThemeCalled(t *testing.T) {
+	tw := setupReaderTest(t)
+
+	rdr := tw.draw()
+
+	tw.opr.EXPECT().ReloadTheme(rdr.display)
+
+	tw.screen.InjectKey(tcell.KeyRune, 'T', tcell.ModNone)
+}
+
 func TestShowIntroPopupCalled(t *testing.T) {
 	tw := setupReaderTest(t)
 
@@ -152,6 +173,153 @@ func TestUnfocusFrontCalled(t *testing.T) {
 	tw.screen.InjectKey(tcell.KeyEscape, ' ', tcell.ModNone)
 }
 
+func TestToggleEntryReadCalled(t *testing.T) {
+	rdr, opr, be := setupHandlerTest(t)
+
+	editf := func() ([]*entity.Entry, error) { return nil, nil }
+	done := make(chan struct{})
+
+	opr.EXPECT().GetCurrentEntry(rdr.display).Return(&entity.Entry{ID: 3, IsRead: true})
+	be.EXPECT().
+		EditEntriesF(gomock.Any(), []*entity.EntryEditOp{{ID: 3, IsRead: pointer(false)}}).
+		Return(editf)
+	opr.EXPECT().EditEntries(rdr.display, gomock.Any()).Do(func(any, any) { close(done) })
+
+	ev := rdr.entriesPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone))
+	assert.Nil(t, ev)
+	waitDone(t, done)
+}
+
+func TestToggleEntryBookmarkCalled(t *testing.T) {
+	rdr, opr, be := setupHandlerTest(t)
+
+	editf := func() ([]*entity.Entry, error) { return nil, nil }
+	done := make(chan struct{})
+
+	opr.EXPECT().GetCurrentEntry(rdr.display).Return(&entity.Entry{ID: 5})
+	be.EXPECT().
+		EditEntriesF(gomock.Any(), []*entity.EntryEditOp{{ID: 5, IsBookmarked: pointer(true)}}).
+		Return(editf)
+	opr.EXPECT().EditEntries(rdr.display, gomock.Any()).Do(func(any, any) { close(done) })
+
+	ev := rdr.entriesPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'm', tcell.ModNone))
+	assert.Nil(t, ev)
+	waitDone(t, done)
+}
+
+func TestMarkCurrentFeedsReadCalled(t *testing.T) {
+	rdr, opr, be := setupHandlerTest(t)
+
+	editf := func() ([]*entity.Entry, error) { return nil, nil }
+	done := make(chan struct{})
+
+	opr.EXPECT().GetCurrentFeeds(rdr.display).Return(
+		[]*entity.Feed{
+			{
+				ID: 1,
+				Entries: map[entity.ID]*entity.Entry{
+					2: {ID: 2, FeedID: 1, IsRead: true},
+					3: {ID: 3, FeedID: 1},
+				},
+			},
+		},
+	)
+	be.EXPECT().
+		EditEntriesF(gomock.Any(), []*entity.EntryEditOp{{ID: 3, IsRead: pointer(true)}}).
+		Return(editf)
+	opr.EXPECT().EditEntries(rdr.display, gomock.Any()).Do(func(any, any) { close(done) })
+
+	ev := rdr.feedsPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone))
+	assert.Nil(t, ev)
+	waitDone(t, done)
+}
+
+func TestToggleFeedsGroupingCalled(t *testing.T) {
+	rdr, opr, be := setupHandlerTest(t)
+
+	listf := func() ([]*entity.Folder, error) { return nil, nil }
+	done := make(chan struct{})
+
+	be.EXPECT().ListFoldersF(gomock.Any()).Return(listf)
+	opr.EXPECT().ToggleFeedsGrouping(rdr.display, gomock.Any()).Do(func(any, any) { close(done) })
+
+	ev := rdr.feedsPaneKeyHandler()(tcell.NewEventKey(tcell.KeyRune, 'g', tcell.ModNone))
+	assert.Nil(t, ev)
+	waitDone(t, done)
+}
+
+func TestEntryOpenedMarksRead(t *testing.T) {
+	rdr, opr, be := setupHandlerTest(t)
+
+	editf := func() ([]*entity.Entry, error) { return nil, nil }
+	done := make(chan struct{})
+
+	be.EXPECT().
+		EditEntriesF(gomock.Any(), []*entity.EntryEditOp{{ID: 7, IsRead: pointer(true)}}).
+		Return(editf)
+	opr.EXPECT().EditEntries(rdr.display, gomock.Any()).Do(func(any, any) { close(done) })
+
+	opened := rdr.entryOpenedHandler()
+	opened(&entity.Entry{ID: 6, IsRead: true})
+	opened(&entity.Entry{ID: 7})
+	waitDone(t, done)
+}
+
+func TestKeyActionsHandled(t *testing.T) {
+	rdr, _, _ := setupHandlerTest(t)
+
+	handled := map[keymap.Scope]map[string]func(){
+		keymap.ScopeGlobal:      rdr.globalActions(),
+		keymap.ScopeFeedsPane:   rdr.feedsPaneActions(),
+		keymap.ScopeEntriesPane: rdr.entriesPaneActions(),
+	}
+	for _, action := range keymap.Actions() {
+		assert.Contains(t, handled[action.Scope], action.Name, action.Scope.String())
+	}
+}
+
+func TestKeymapChordCalled(t *testing.T) {
+	r := require.New(t)
+
+	var (
+		opr = NewMockOperator(gomock.NewController(t))
+		be  = NewMockBackend(gomock.NewController(t))
+	)
+
+	rdr, err := NewBuilder(context.Background()).
+		Keymap("vim").
+		backend(be).
+		screen(tcell.NewSimulationScreen("UTF-8")).
+		operator(opr).
+		state(NewMockState(gomock.NewController(t))).
+		Build()
+	r.NoError(err)
+
+	opr.EXPECT().ToggleCurrentFeedFold(rdr.display)
+	opr.EXPECT().FocusNextPane(rdr.display)
+
+	feedsHandler := rdr.feedsPaneKeyHandler()
+	r.Nil(feedsHandler(tcell.NewEventKey(tcell.KeyRune, 'z', tcell.ModNone)))
+	r.Nil(feedsHandler(tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone)))
+
+	globalHandler := rdr.globalKeyHandler()
+	r.Nil(globalHandler(tcell.NewEventKey(tcell.KeyCtrlW, 0, tcell.ModCtrl)))
+	r.Nil(globalHandler(tcell.NewEventKey(tcell.KeyRune, 'w', tcell.ModNone)))
+
+	ev := tcell.NewEventKey(tcell.KeyRune, 'j', tcell.ModNone)
+	r.Equal(ev, globalHandler(ev))
+}
+
+func TestBuildKeymapErr(t *testing.T) {
+	rdr, err := NewBuilder(context.Background()).
+		Keymap("nope").
+		backend(NewMockBackend(gomock.NewController(t))).
+		Build()
+
+	assert.Nil(t, rdr)
+	assert.EqualError(t, err, `keymap "nope" does not exist`)
+}
+
 func TestStartSmoke(t *testing.T) {
 	tw := setupReaderTest(t)
 
@@ -248,6 +416,10 @@ func setupReaderTest(t *testing.T) *testWrapper {
 
 			opr.EXPECT().FocusFeedsPane(gomock.Any())
 
+			be.EXPECT().StreamEntriesF(gomock.Any()).
+				Return(func() (<-chan *entity.Entry, <-chan error, error) { return nil, nil, nil })
+			opr.EXPECT().StreamEntries(gomock.Any(), gomock.Any(), gomock.Any())
+
 			setupWG.Done()
 
 			rerr := rdr.Start()
@@ -273,3 +445,34 @@ func setupReaderTest(t *testing.T) *testWrapper {
 
 	return tw
 }
+
+func setupHandlerTest(t *testing.T) (*Reader, *MockOperator, *MockBackend) {
+	t.Helper()
+
+	var (
+		screen = tcell.NewSimulationScreen("UTF-8")
+		opr    = NewMockOperator(gomock.NewController(t))
+		be     = NewMockBackend(gomock.NewController(t))
+		stt    = NewMockState(gomock.NewController(t))
+	)
+
+	rdr, err := NewBuilder(context.Background()).
+		backend(be).
+		screen(screen).
+		operator(opr).
+		state(stt).
+		Build()
+	require.NoError(t, err)
+
+	return rdr, opr, be
+}
+
+func waitDone(t *testing.T, done <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for call")
+	}
+}
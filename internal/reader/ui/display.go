@@ -5,26 +5,38 @@ package ui
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 
 	"github.com/bow/neon/internal"
 	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/reader/keymap"
 )
 
 type Display struct {
 	theme *Theme
 	lang  *Lang
 
+	keymap *keymap.Keymap
+
+	// themeName and themeDir are where the theme was loaded from, for reloading it.
+	themeName string
+	themeDir  string
+
 	inner *tview.Application
 	root  *tview.Pages
 
 	mainPage *tview.Grid
 
-	feedsCh   chan *entity.Feed
-	feedsPane *feedsPane
+	feedsCh     chan *entity.Feed
+	entriesCh   chan *entity.Entry
+	pullFailsCh chan entity.PullResult
+	feedsPane   *feedsPane
 
 	entriesPane *entriesPane
 
@@ -39,21 +51,30 @@ type Display struct {
 	introPopup *popup
 	statsPopup *popup
 
+	searchPopup *popup
+	searchInput *tview.InputField
+
 	handlersSet bool
 
 	focusStack tview.Primitive
 	counter    int
 }
 
-func NewDisplay(screen tcell.Screen, theme string) (*Display, error) {
-	th, err := loadTheme(theme)
+// NewDisplay creates a display with the given theme, which is either the name of a built-in theme
+// or of a theme file in the given directory, or the path to a theme file.
+func NewDisplay(screen tcell.Screen, theme string, themeDir string) (*Display, error) {
+	th, err := loadTheme(theme, themeDir)
 	if err != nil {
 		return nil, err
 	}
+	th.applyStyles()
 
 	d := Display{
-		theme: th,
-		lang:  langEN,
+		theme:     th,
+		lang:      langEN,
+		keymap:    keymap.Default(),
+		themeName: theme,
+		themeDir:  themeDir,
 		inner: tview.NewApplication().
 			EnableMouse(true).
 			SetScreen(screen),
@@ -67,12 +88,19 @@ func NewDisplay(screen tcell.Screen, theme string) (*Display, error) {
 func (d *Display) SetHandlers(
 	globalKeyHandler KeyHandler,
 	feedsPaneKeyHandler KeyHandler,
+	entriesPaneKeyHandler KeyHandler,
 ) {
 	d.inner.SetInputCapture(globalKeyHandler)
 	d.feedsPane.SetInputCapture(feedsPaneKeyHandler)
+	d.entriesPane.SetInputCapture(entriesPaneKeyHandler)
 	d.handlersSet = true
 }
 
+// SetEntryOpenedFunc sets the function called after an entry is opened in the reading pane.
+func (d *Display) SetEntryOpenedFunc(f func(*entity.Entry)) {
+	d.entriesPane.setOpenedFunc(f)
+}
+
 func (d *Display) Start() error {
 	if !d.handlersSet {
 		return fmt.Errorf("display key handlers must be set before starting")
@@ -96,22 +124,58 @@ func (d *Display) Stop() {
 
 func (d *Display) dimMainPage() {
 	d.theme.dim()
-	d.feedsPane.refreshColors()
-	d.bar.refreshColors()
+	d.refreshColors()
 }
 
 func (d *Display) normalizeMainPage() {
 	d.theme.normalize()
+	d.refreshColors()
+}
+
+func (d *Display) refreshColors() {
 	d.feedsPane.refreshColors()
+	d.entriesPane.refreshColors()
+	d.readingPane.refreshColors()
 	d.bar.refreshColors()
 }
 
+// reloadTheme loads the theme again from where it was first loaded and applies it to all panes
+// and popups. The current theme is kept if loading fails.
+func (d *Display) reloadTheme() error {
+	th, err := loadTheme(d.themeName, d.themeDir)
+	if err != nil {
+		return err
+	}
+	th.applyStyles()
+
+	// Panes keep a pointer to the theme, so it is changed in place.
+	*d.theme = *th
+	if d.frontPageName() != mainPageName {
+		d.theme.dim()
+	}
+	d.refreshColors()
+	for _, p := range []*popup{
+		d.aboutPopup,
+		d.helpPopup,
+		d.introPopup,
+		d.statsPopup,
+		d.searchPopup,
+	} {
+		p.refreshColors(d.theme)
+	}
+
+	return nil
+}
+
 const (
-	mainPageName  = "main"
-	aboutPageName = "about"
-	helpPageName  = "help"
-	introPageName = "intro"
-	statsPageName = "stats"
+	mainPageName   = "main"
+	aboutPageName  = "about"
+	helpPageName   = "help"
+	introPageName  = "intro"
+	statsPageName  = "stats"
+	searchPageName = "search"
+
+	searchPopupWidth = 64
 
 	longDateFormat = "2 January 2006 · 15:04:05 MST"
 
@@ -132,6 +196,7 @@ func (d *Display) setRoot() {
 	d.setMainPage()
 	d.setHelpPopup()
 	d.setIntroPopup()
+	d.setSearchPopup()
 
 	d.bar = newStatusBar(d.theme)
 	d.bar.setChangedFunc(func() { d.inner.Draw() })
@@ -155,6 +220,7 @@ func (d *Display) setRoot() {
 		AddPage(helpPageName, d.helpPopup, true, false).
 		AddPage(aboutPageName, d.aboutPopup, true, false).
 		AddPage(statsPageName, d.statsPopup, true, false).
+		AddPage(searchPageName, d.searchPopup, true, false).
 		AddPage(introPageName, d.introPopup, true, false)
 
 	d.root = pages
@@ -166,9 +232,19 @@ func (d *Display) setMainPage() {
 	narrowFeedsPaneWidth := 30
 
 	d.feedsCh = make(chan *entity.Feed)
+	d.entriesCh = make(chan *entity.Entry)
+	d.pullFailsCh = make(chan entity.PullResult)
 	readingPane := newReadingPane(d.theme, d.lang, narrowFeedsPaneWidth)
 	entriesPane := newEntriesPane(d.theme, d.lang, readingPane)
-	feedsPane := newFeedsPane(d.theme, d.lang, d.feedsCh, entriesPane)
+	feedsPane := newFeedsPane(
+		d.theme,
+		d.lang,
+		d.feedsCh,
+		d.entriesCh,
+		d.pullFailsCh,
+		entriesPane,
+	)
+	feedsPane.setUpdateFunc(func(f func()) { d.inner.QueueUpdateDraw(f) })
 
 	narrowFlex := tview.NewFlex().
 		SetDirection(tview.FlexRow).
@@ -257,44 +333,7 @@ func (d *Display) clearEvent() {
 }
 
 func (d *Display) setHelpPopup() {
-	// TODO: Consider moving the content out into where handlers are defined.
-	helpText := `[aqua]Feeds pane[-]
-[yellow]j/k[-]: Next / previous item
-[yellow]p[-]  : Pull current feed
-[yellow]P[-]  : Pull all feeds
-[yellow]R[-]  : Mark all entries in current feed read
-[yellow]s[-]  : Star / unstar feed
-[yellow]a[-]  : Add feed
-[yellow]e[-]  : Edit feed
-[yellow]d[-]  : Delete feed
-[yellow]Z[-]  : Expand / collapse all feeds
-
-[aqua]Entries pane[-]
-[yellow]j/k[-]: Next / previous entry
-[yellow]r[-]  : Mark current entry read
-[yellow]u[-]  : Mark current entry unread
-[yellow]b[-]  : Add / remove current entry from bookmarks
-
-[aqua]Reading pane[-]
-[yellow]j/k[-]: Scroll down / up
-[yellow]g[-]  : Go to top
-[yellow]G[-]  : Go to bottom
-
-[aqua]Global[-]
-[yellow]F[-]       : Set focus to feeds pane
-[yellow]E[-]       : Set focus to entries pane
-[yellow]R[-]       : Set focus to reading pane
-[yellow]Tab[-]     : Switch to next pane
-[yellow]Alt-Tab[-] : Switch to previous pane
-[yellow]b[-]       : Toggle status bar
-[yellow]c[-]       : Clear status bar
-[yellow]X[-]       : Export feeds to OPML
-[yellow]I[-]       : Import feeds from OPML
-[yellow]Esc[-]     : Unset current focus or close open frame
-[yellow]S[-]       : Toggle stats popup and show latest values
-[yellow]A[-]       : Toggle 'about' popup
-[yellow]H,?[-]     : Toggle this help
-[yellow]q,Ctrl-C[-]: Quit reader`
+	helpText := keysHelpText(d.keymap)
 
 	helpWidget := tview.NewTextView().
 		SetDynamicColors(true).
@@ -311,6 +350,84 @@ func (d *Display) setHelpPopup() {
 	)
 }
 
+// SetKeymap sets the keymap whose bindings are shown in the help popup.
+func (d *Display) SetKeymap(km *keymap.Keymap) {
+	d.keymap = km
+
+	helpText := keysHelpText(km)
+	helpWidget := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(helpText)
+
+	d.helpPopup.setWidth(popupWidth(helpWidget.GetText(true)))
+	d.helpPopup.setHeight(popupHeight(helpText))
+	d.helpPopup.setContent(helpWidget)
+}
+
+type keyHelp struct {
+	keys        string
+	description string
+}
+
+// keysHelpText returns the help text listing the keys bound in the given keymap, along with the
+// navigation keys handled by the panes themselves.
+func keysHelpText(km *keymap.Keymap) string {
+	bound := map[keymap.Scope][]keyHelp{
+		keymap.ScopeFeedsPane:   {{"j/k", "Next / previous item"}},
+		keymap.ScopeEntriesPane: {{"j/k", "Next / previous entry"}},
+	}
+	for _, action := range keymap.Actions() {
+		keys := km.Keys(action.Name)
+		if action.Name == keymap.ActionQuit {
+			keys = append(slices.Clone(keys), "Ctrl-C")
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		bound[action.Scope] = append(
+			bound[action.Scope],
+			keyHelp{strings.Join(keys, ","), action.Description},
+		)
+	}
+
+	sections := []struct {
+		title string
+		items []keyHelp
+	}{
+		{"Feeds pane", bound[keymap.ScopeFeedsPane]},
+		{"Entries pane", bound[keymap.ScopeEntriesPane]},
+		{
+			"Reading pane",
+			[]keyHelp{{"j/k", "Scroll down / up"}, {"g", "Go to top"}, {"G", "Go to bottom"}},
+		},
+		{"Global", bound[keymap.ScopeGlobal]},
+	}
+
+	var b strings.Builder
+	for i, section := range sections {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		fmt.Fprintf(&b, "[aqua]%s[-]", section.title)
+
+		width := 0
+		for _, item := range section.items {
+			width = max(width, utf8.RuneCountInString(item.keys))
+		}
+		for _, item := range section.items {
+			fmt.Fprintf(
+				&b,
+				"\n[yellow]%s[-]%s: %s",
+				tview.Escape(item.keys),
+				strings.Repeat(" ", width-utf8.RuneCountInString(item.keys)),
+				item.description,
+			)
+		}
+	}
+
+	return b.String()
+}
+
 func (d *Display) setIntroPopup() {
 	introText := fmt.Sprintf(`Hello and welcome the %s reader.
 
@@ -334,6 +451,81 @@ To close this message, press [yellow]<Esc>[-].
 	)
 }
 
+func (d *Display) setSearchPopup() {
+	d.searchInput = tview.NewInputField().
+		SetFieldWidth(0).
+		SetPlaceholder("terms, with '*' for prefixes").
+		SetPlaceholderTextColor(tcell.ColorGray)
+
+	d.searchPopup = newFilledPopup(
+		d.lang.searchPopupTitle,
+		d.searchInput,
+		d.theme.popupTitleFG,
+		searchPopupWidth,
+		1+verticalPopupPadding,
+		1, 1,
+		-1, -3,
+	)
+}
+
+// InputActive returns true if a text input currently has focus, in which case key events
+// should be passed on to it.
+func (d *Display) InputActive() bool {
+	_, ok := d.inner.GetFocus().(*tview.InputField)
+	return ok
+}
+
+func (d *Display) showSearchPopup(search func(string) ([]*entity.SearchResult, error)) {
+	d.searchInput.SetText("")
+	d.searchInput.SetDoneFunc(func(key tcell.Key) {
+		// nolint:exhaustive
+		switch key {
+		case tcell.KeyEnter:
+			query := d.searchInput.GetText()
+			if strings.TrimSpace(query) == "" {
+				return
+			}
+			d.hidePopup(searchPageName)
+			go d.searchEntries(query, search)
+		case tcell.KeyEscape:
+			d.hidePopup(searchPageName)
+		}
+	})
+	d.switchPopup(searchPageName, d.frontPageName())
+	d.inner.SetFocus(d.searchInput)
+}
+
+func (d *Display) searchEntries(
+	query string,
+	search func(string) ([]*entity.SearchResult, error),
+) {
+	results, err := search(query)
+	if err != nil {
+		d.errEvent(err)
+		return
+	}
+
+	entries := make([]*entity.Entry, len(results))
+	for i, result := range results {
+		entries[i] = result.Entry
+	}
+
+	switch n := len(entries); n {
+	case 0:
+		d.warnEventf("No entries found for %q", query)
+		return
+	case 1:
+		d.infoEventf("1 entry found for %q", query)
+	default:
+		d.infoEventf("%d entries found for %q", n, query)
+	}
+
+	d.inner.QueueUpdateDraw(func() {
+		d.entriesPane.setEntries(entries)
+		d.focusPane(d.entriesPane)
+	})
+}
+
 func (d *Display) setAboutPopupText(name string) {
 	commit := internal.GitCommit()
 
@@ -4,19 +4,42 @@
 package ui
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/bow/neon/internal/entity"
 )
 
-type DisplayOperator struct{}
+type DisplayOperator struct {
+	// Bounds of the delay before resubscribing to entries after the subscription fails.
+	streamRetryMin time.Duration
+	streamRetryMax time.Duration
+}
 
 func NewDisplayOperator() *DisplayOperator {
-	return &DisplayOperator{}
+	return &DisplayOperator{streamRetryMin: time.Second, streamRetryMax: time.Minute}
 }
 
 func (do *DisplayOperator) ClearStatusBar(d *Display) {
 	d.clearEvent()
 }
 
+// EditEntries merges the entries returned by the given edit into the feeds and entries panes.
+func (do *DisplayOperator) EditEntries(d *Display, f func() ([]*entity.Entry, error)) {
+	entries, err := f()
+	if err != nil {
+		d.errEvent(err)
+		return
+	}
+	go func() {
+		for _, entry := range entries {
+			d.entriesCh <- entry
+		}
+	}()
+}
+
 func (do *DisplayOperator) FocusFeedsPane(d *Display) {
 	d.focusPane(d.feedsPane)
 }
@@ -37,10 +60,20 @@ func (do *DisplayOperator) FocusReadingPane(d *Display) {
 	d.focusPane(d.readingPane)
 }
 
+func (do *DisplayOperator) GetCurrentEntry(d *Display) *entity.Entry {
+	return d.entriesPane.getCurrentEntry()
+}
+
 func (do *DisplayOperator) GetCurrentFeed(d *Display) *entity.Feed {
 	return d.feedsPane.getCurrentFeed()
 }
 
+// GetCurrentFeeds returns the currently selected feed, or all feeds in the currently selected
+// group.
+func (do *DisplayOperator) GetCurrentFeeds(d *Display) []*entity.Feed {
+	return d.feedsPane.getCurrentFeeds()
+}
+
 func (do *DisplayOperator) PopulateFeedsPane(d *Display, f func() ([]*entity.Feed, error)) {
 	feeds, err := f()
 	if err != nil {
@@ -75,9 +108,13 @@ func (do *DisplayOperator) RefreshFeeds(
 	for pr := range ch {
 		if perr := pr.Error(); perr != nil {
 			d.errEventf("Pull failed for %s: %s", pr.URL(), perr)
+			go func() { d.pullFailsCh <- pr }()
 			errc++
 		} else {
 			d.infoEventf("Pulled %s", pr.URL())
+			if newURL := pr.NewURL(); newURL != "" {
+				d.warnEventf("Feed %s moved to %s", pr.URL(), newURL)
+			}
 			go func() { d.feedsCh <- pr.Feed() }()
 			okc++
 		}
@@ -110,10 +147,61 @@ func (do *DisplayOperator) RefreshStats(d *Display, f func() (*entity.Stats, err
 	d.setStats(stats)
 }
 
+func (do *DisplayOperator) ReloadTheme(d *Display) {
+	if err := d.reloadTheme(); err != nil {
+		d.errEvent(err)
+		return
+	}
+	d.infoEventf("Reloaded theme %s", d.themeName)
+}
+
 func (do *DisplayOperator) ShowIntroPopup(d *Display) {
 	d.showPopup(introPageName)
 }
 
+func (do *DisplayOperator) ShowSearchPopup(
+	d *Display,
+	search func(string) ([]*entity.SearchResult, error),
+) {
+	if name := d.frontPageName(); name != introPageName {
+		d.showSearchPopup(search)
+	}
+}
+
+// StreamEntries merges entries received from the given subscription into the feeds pane. When
+// the subscription fails, the error is shown and the subscription is retried with an increasing
+// delay. Feeds are reloaded with the given function after resubscribing, to fill in entries sent
+// while there was no subscription. Streaming stops when the subscription ends because its context
+// is done. It returns immediately.
+func (do *DisplayOperator) StreamEntries(
+	d *Display,
+	subscribe func() (<-chan *entity.Entry, <-chan error, error),
+	reload func() ([]*entity.Feed, error),
+) {
+	go func() {
+		delay := do.streamRetryMin
+		for resubscribed := false; ; resubscribed = true {
+			ch, errCh, err := subscribe()
+			if err == nil {
+				delay = do.streamRetryMin
+				if resubscribed {
+					do.PopulateFeedsPane(d, reload)
+				}
+				for entry := range ch {
+					d.entriesCh <- entry
+				}
+				err = <-errCh
+			}
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return
+			}
+			d.errEvent(fmt.Errorf("entry stream failed, retrying in %s: %w", delay, err))
+			time.Sleep(delay)
+			delay = min(2*delay, do.streamRetryMax)
+		}
+	}()
+}
+
 func (do *DisplayOperator) ToggleAboutPopup(d *Display, backend string) {
 	if name := d.frontPageName(); name == aboutPageName {
 		d.hidePopup(name)
@@ -131,6 +219,30 @@ func (do *DisplayOperator) ToggleCurrentFeedFold(d *Display) {
 	d.feedsPane.toggleCurrentFeedFold()
 }
 
+// ToggleFeedsGrouping switches the feeds pane between grouping feeds by update time and by
+// folder. Folders are fetched with the given function when switching to folder groups. It waits
+// for the application to switch the grouping, so it must not be called from the application
+// goroutine.
+func (do *DisplayOperator) ToggleFeedsGrouping(d *Display, f func() ([]*entity.Folder, error)) {
+	var byFolder bool
+	d.inner.QueueUpdateDraw(func() {
+		if byFolder = d.feedsPane.isGroupedByFolder(); byFolder {
+			d.feedsPane.groupByPeriod()
+		}
+	})
+	if byFolder {
+		d.infoEventf("Grouping feeds by update time")
+		return
+	}
+	folders, err := f()
+	if err != nil {
+		d.errEvent(err)
+		return
+	}
+	d.inner.QueueUpdateDraw(func() { d.feedsPane.groupByFolder(folders) })
+	d.infoEventf("Grouping feeds by folder")
+}
+
 func (do *DisplayOperator) ToggleHelpPopup(d *Display) {
 	if name := d.frontPageName(); name == helpPageName {
 		d.hidePopup(name)
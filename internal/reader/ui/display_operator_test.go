@@ -4,9 +4,13 @@
 package ui
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -16,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/reader/keymap"
 )
 
 const screenW, screenH = 210, 60
@@ -38,6 +43,93 @@ func TestClearStatusBar(t *testing.T) {
 	r.Empty(w.GetText(true))
 }
 
+func TestEditEntries(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	opr.PopulateFeedsPane(
+		dsp,
+		func() ([]*entity.Feed, error) {
+			return []*entity.Feed{
+				{
+					ID:         entity.ID(1),
+					Title:      "Feed A",
+					FeedURL:    "http://a.com/feed.xml",
+					Subscribed: yesterday,
+					LastPulled: yesterday,
+					Updated:    &now,
+					Entries: map[entity.ID]*entity.Entry{
+						3: {ID: 3, FeedID: 1, Title: "Entry A3"},
+						5: {ID: 5, FeedID: 1, Title: "Entry A5"},
+					},
+				},
+			}, nil
+		},
+	)
+	r.Eventually(
+		onApp(dsp, func() bool { return len(dsp.feedsPane.GetRoot().GetChildren()) == 1 }),
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	dsp.inner.QueueUpdate(func() {
+		dsp.entriesPane.setEntries(dsp.feedsPane.store.items[1].EntriesSlice())
+	})
+
+	opr.ToggleAllFeedsFold(dsp)
+	var gnode *tview.TreeNode
+	dsp.inner.QueueUpdate(func() { gnode = dsp.feedsPane.GetRoot().GetChildren()[0] })
+	a.Equal("Updated today (2)", gnode.GetText())
+
+	opr.EditEntries(
+		dsp,
+		func() ([]*entity.Entry, error) {
+			return []*entity.Entry{{ID: 3, FeedID: 1, Title: "Entry A3", IsRead: true}}, nil
+		},
+	)
+
+	r.Eventually(
+		onApp(dsp, func() bool { return dsp.feedsPane.store.items[1].NumEntriesUnread() == 1 }),
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	var edited *entity.Entry
+	dsp.inner.QueueUpdate(func() {
+		gnode = dsp.feedsPane.GetRoot().GetChildren()[0]
+		for _, entry := range dsp.entriesPane.store.all() {
+			if entry.ID == 3 {
+				edited = entry
+			}
+		}
+	})
+	a.False(gnode.IsExpanded())
+	a.Equal("Updated today (1)", gnode.GetText())
+
+	r.NotNil(edited)
+	a.True(edited.IsRead)
+}
+
+func TestEditEntriesErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	opr.EditEntries(dsp, func() ([]*entity.Entry, error) { return nil, fmt.Errorf("nope") })
+
+	r.Eventually(
+		func() bool { return strings.Contains(eventsText(dsp), "nope") },
+		2*time.Second,
+		50*time.Millisecond,
+	)
+}
+
 func TestFocusEntriesPane(t *testing.T) {
 	t.Parallel()
 
@@ -144,7 +236,7 @@ func TestPopulateFeedsPaneErr(t *testing.T) {
 	)
 	a.Empty(dsp.feedsPane.GetRoot().GetChildren())
 	a.Eventually(
-		func() bool { return strings.Contains(dsp.bar.eventsWidget.GetText(true), "fail") },
+		func() bool { return strings.Contains(eventsText(dsp), "fail") },
 		2*time.Second,
 		500*time.Millisecond,
 	)
@@ -214,11 +306,97 @@ func TestPopulateFeedsPaneOk(t *testing.T) {
 	)
 
 	a.Eventually(
-		func() bool { return len(groupNodes()) == 3 },
+		onApp(dsp, func() bool { return len(groupNodes()) == 3 }),
 		2*time.Second,
 		500*time.Millisecond,
 	)
-	a.Len(feedNodes(), 4)
+	a.True(onApp(dsp, func() bool { return len(feedNodes()) == 4 })())
+}
+
+func TestRefreshFeedsFailed(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	feedURL := "http://w.com/feed.xml"
+	feedNode := func() *tview.TreeNode {
+		gnodes := dsp.feedsPane.GetRoot().GetChildren()
+		if len(gnodes) == 0 || len(gnodes[0].GetChildren()) == 0 {
+			return nil
+		}
+		return gnodes[0].GetChildren()[0]
+	}
+
+	draw()
+
+	opr.PopulateFeedsPane(
+		dsp,
+		func() ([]*entity.Feed, error) {
+			feeds := []*entity.Feed{
+				{
+					ID:         entity.ID(1),
+					Title:      "Feed W",
+					FeedURL:    feedURL,
+					Subscribed: twoWeeksAgo,
+					LastPulled: twoWeeksAgo,
+					Updated:    &twoWeeksAgo,
+				},
+			}
+			return feeds, nil
+		},
+	)
+	r.Eventually(
+		onApp(dsp, func() bool { return feedNode() != nil }),
+		2*time.Second,
+		100*time.Millisecond,
+	)
+	a.True(onApp(dsp, func() bool { return feedNode().GetColor() == dsp.theme.feedNode })())
+
+	opr.RefreshFeeds(
+		dsp,
+		func() (<-chan entity.PullResult, error) {
+			ch := make(chan entity.PullResult, 1)
+			ch <- entity.NewPullResultFromError(&feedURL, fmt.Errorf("status 503"))
+			close(ch)
+			return ch, nil
+		},
+		nil,
+	)
+
+	a.Eventually(
+		onApp(dsp, func() bool { return feedNode().GetColor() == dsp.theme.feedNodeFailing }),
+		2*time.Second,
+		100*time.Millisecond,
+	)
+	var feed *entity.Feed
+	dsp.inner.QueueUpdate(func() { feed = dsp.feedsPane.store.items[1] })
+	a.Equal(uint32(1), feed.PullFailures)
+	a.Equal("status 503", *feed.LastPullError)
+}
+
+func TestReloadTheme(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "theme.toml")
+	require.NoError(t, os.WriteFile(path, []byte("[title]\nnormal = \"red\"\n"), 0o600))
+	dsp.themeName = path
+
+	draw()
+
+	theme := dsp.theme
+	opr.ReloadTheme(dsp)
+	a.Same(theme, dsp.theme)
+	a.Equal(tcell.ColorRed, dsp.theme.titleFG)
+
+	require.NoError(t, os.WriteFile(path, []byte("[title]\nnormal = \"nocolor\"\n"), 0o600))
+	opr.ReloadTheme(dsp)
+	a.Equal(tcell.ColorRed, dsp.theme.titleFG)
 }
 
 func TestShowIntroPopup(t *testing.T) {
@@ -243,6 +421,205 @@ func TestShowIntroPopup(t *testing.T) {
 	r.Equal(dsp.mainPage, item)
 }
 
+func TestShowSearchPopup(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	var queries []string
+	search := func(query string) ([]*entity.SearchResult, error) {
+		queries = append(queries, query)
+		return []*entity.SearchResult{
+			{Entry: &entity.Entry{ID: 5, Title: "Tomatoes everywhere"}},
+			{Entry: &entity.Entry{ID: 2, Title: "Gardening"}},
+		}, nil
+	}
+
+	opr.ShowSearchPopup(dsp, search)
+	name, item := dsp.root.GetFrontPage()
+	a.Equal(searchPageName, name)
+	r.Equal(dsp.searchPopup, item)
+	a.True(dsp.InputActive())
+
+	dsp.searchInput.SetText("tomato*")
+	dsp.searchInput.InputHandler()(
+		tcell.NewEventKey(tcell.KeyEnter, ' ', tcell.ModNone),
+		func(tview.Primitive) {},
+	)
+
+	r.Eventually(
+		func() bool { return dsp.inner.GetFocus() == dsp.entriesPane },
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	name, _ = dsp.root.GetFrontPage()
+	a.Equal(mainPageName, name)
+	a.Equal([]string{"tomato*"}, queries)
+	r.Len(dsp.entriesPane.store.all(), 2)
+	a.Equal("Tomatoes everywhere", dsp.entriesPane.store.all()[0].Title)
+}
+
+func TestStreamEntries(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	opr.PopulateFeedsPane(
+		dsp,
+		func() ([]*entity.Feed, error) {
+			return []*entity.Feed{
+				{
+					ID:         entity.ID(1),
+					Title:      "Feed A",
+					FeedURL:    "http://a.com/feed.xml",
+					Subscribed: yesterday,
+					LastPulled: yesterday,
+					Updated:    &yesterday,
+					Entries:    map[entity.ID]*entity.Entry{},
+				},
+			}, nil
+		},
+	)
+	a.Eventually(
+		onApp(dsp, func() bool { return dsp.feedsPane.store.items[1] != nil }),
+		2*time.Second,
+		50*time.Millisecond,
+	)
+
+	ch := make(chan *entity.Entry)
+	errCh := make(chan error, 1)
+	opr.StreamEntries(
+		dsp,
+		func() (<-chan *entity.Entry, <-chan error, error) { return ch, errCh, nil },
+		nil,
+	)
+
+	ch <- &entity.Entry{ID: 3, FeedID: 1, Title: "Entry A3"}
+	ch <- &entity.Entry{ID: 4, FeedID: 2, Title: "Entry B4"}
+	ch <- &entity.Entry{ID: 5, FeedID: 1, Title: "Entry A5"}
+	errCh <- context.Canceled
+	close(ch)
+
+	a.Eventually(
+		onApp(dsp, func() bool { return dsp.feedsPane.store.items[1].NumEntriesUnread() == 2 }),
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	a.True(onApp(dsp, func() bool { return dsp.feedsPane.store.items[2] == nil })())
+}
+
+func TestStreamEntriesErr(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+	opr.streamRetryMin = 10 * time.Millisecond
+
+	draw()
+
+	var calls atomic.Int32
+	opr.StreamEntries(
+		dsp,
+		func() (<-chan *entity.Entry, <-chan error, error) {
+			if calls.Add(1) == 1 {
+				return nil, nil, fmt.Errorf("nope")
+			}
+			return nil, nil, context.Canceled
+		},
+		nil,
+	)
+
+	r.Eventually(
+		func() bool { return strings.Contains(eventsText(dsp), "nope") },
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	r.Eventually(func() bool { return calls.Load() == 2 }, 2*time.Second, 50*time.Millisecond)
+	a.Never(func() bool { return calls.Load() > 2 }, 200*time.Millisecond, 50*time.Millisecond)
+}
+
+func TestStreamEntriesResubscribe(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+	opr.streamRetryMin = 10 * time.Millisecond
+
+	draw()
+
+	var (
+		ch1    = make(chan *entity.Entry)
+		errCh1 = make(chan error, 1)
+		ch2    = make(chan *entity.Entry)
+		errCh2 = make(chan error, 1)
+		calls  atomic.Int32
+	)
+	defer func() {
+		errCh2 <- context.Canceled
+		close(ch2)
+	}()
+
+	opr.StreamEntries(
+		dsp,
+		func() (<-chan *entity.Entry, <-chan error, error) {
+			if calls.Add(1) == 1 {
+				return ch1, errCh1, nil
+			}
+			return ch2, errCh2, nil
+		},
+		func() ([]*entity.Feed, error) {
+			return []*entity.Feed{
+				{
+					ID:         entity.ID(1),
+					Title:      "Feed A",
+					FeedURL:    "http://a.com/feed.xml",
+					Subscribed: yesterday,
+					LastPulled: yesterday,
+					Updated:    &yesterday,
+					Entries: map[entity.ID]*entity.Entry{
+						3: {ID: 3, FeedID: 1, Title: "Entry A3"},
+					},
+				},
+			}, nil
+		},
+	)
+
+	errCh1 <- fmt.Errorf("stream fail")
+	close(ch1)
+
+	r.Eventually(
+		func() bool { return strings.Contains(eventsText(dsp), "stream fail") },
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	// Entries sent while resubscribing are loaded with the feeds.
+	r.Eventually(
+		onApp(dsp, func() bool {
+			feed := dsp.feedsPane.store.items[1]
+			return feed != nil && feed.NumEntriesUnread() == 1
+		}),
+		2*time.Second,
+		50*time.Millisecond,
+	)
+
+	ch2 <- &entity.Entry{ID: 4, FeedID: 1, Title: "Entry A4"}
+	a.Eventually(
+		onApp(dsp, func() bool { return dsp.feedsPane.store.items[1].NumEntriesUnread() == 2 }),
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	a.Equal(int32(2), calls.Load())
+}
+
 func TestToggleAboutPopup(t *testing.T) {
 	t.Parallel()
 
@@ -284,6 +661,85 @@ func TestToggleAboutPopup(t *testing.T) {
 	a.Contains(c2.GetText(true), bn2)
 }
 
+func TestToggleFeedsGrouping(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	groupTexts := func() []string {
+		texts := make([]string, 0)
+		for _, gnode := range dsp.feedsPane.GetRoot().GetChildren() {
+			texts = append(texts, gnode.GetText())
+		}
+		return texts
+	}
+
+	draw()
+
+	opr.PopulateFeedsPane(
+		dsp,
+		func() ([]*entity.Feed, error) {
+			return []*entity.Feed{
+				{ID: 1, Title: "Feed A", Updated: &now, FolderID: pointer(entity.ID(2))},
+				{ID: 2, Title: "Feed B", Updated: &now, FolderID: pointer(entity.ID(1))},
+				{ID: 3, Title: "Feed C", Updated: &twoWeeksAgo},
+			}, nil
+		},
+	)
+	r.Eventually(
+		onApp(dsp, func() bool { return len(dsp.feedsPane.store.items) == 3 }),
+		2*time.Second,
+		50*time.Millisecond,
+	)
+
+	folders := []*entity.Folder{
+		{ID: 1, Name: "News"},
+		{ID: 2, Name: "Tech", ParentID: pointer(entity.ID(1))},
+		{ID: 3, Name: "Empty"},
+	}
+	opr.ToggleFeedsGrouping(dsp, func() ([]*entity.Folder, error) { return folders, nil })
+	a.Eventually(
+		onApp(dsp, func() bool {
+			texts := groupTexts()
+			return len(texts) == 3 &&
+				texts[0] == "News" && texts[1] == "News / Tech" && texts[2] == "No folder"
+		}),
+		2*time.Second,
+		50*time.Millisecond,
+	)
+
+	opr.ToggleFeedsGrouping(dsp, func() ([]*entity.Folder, error) { return nil, fmt.Errorf("no") })
+	a.Eventually(
+		onApp(dsp, func() bool {
+			texts := groupTexts()
+			return len(texts) == 2 && texts[0] == "Updated today" && texts[1] == "Updated this month"
+		}),
+		2*time.Second,
+		50*time.Millisecond,
+	)
+}
+
+func TestToggleFeedsGroupingErr(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	draw, opr, dsp := setupDisplayOperatorTest(t)
+
+	draw()
+
+	opr.ToggleFeedsGrouping(dsp, func() ([]*entity.Folder, error) { return nil, fmt.Errorf("nope") })
+
+	r.Eventually(
+		func() bool { return strings.Contains(eventsText(dsp), "nope") },
+		2*time.Second,
+		50*time.Millisecond,
+	)
+	a.False(onApp(dsp, dsp.feedsPane.isGroupedByFolder)())
+}
+
 func TestToggleHelpPopup(t *testing.T) {
 	t.Parallel()
 
@@ -308,6 +764,29 @@ func TestToggleHelpPopup(t *testing.T) {
 	r.Equal(dsp.mainPage, item)
 }
 
+func TestSetKeymapHelp(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	dsp, err := NewDisplay(tcell.NewSimulationScreen("UTF-8"), "dark", "")
+	r.NoError(err)
+
+	help := keysHelpText(dsp.keymap)
+	a.Contains(help, "[yellow]H,?[-]     : Toggle this help\n")
+	a.Contains(help, "[yellow]z[-]  : Expand / collapse current group\n")
+	a.Contains(help, "[yellow]g[-]  : Go to top\n")
+
+	km, err := keymap.Load("vim", "")
+	r.NoError(err)
+	dsp.SetKeymap(km)
+
+	help = keysHelpText(dsp.keymap)
+	a.Contains(help, "[yellow]z a[-]: Expand / collapse current group\n")
+	a.Regexp(`\[yellow\]q,: q,Ctrl-C\[-\] *: Quit reader`, help)
+}
+
 func TestToggleStatsPopup(t *testing.T) {
 	t.Parallel()
 
@@ -438,16 +917,36 @@ func setupDisplayOperatorTest(t *testing.T) (
 	return drawf, NewDisplayOperator(), dsp
 }
 
+// onApp returns a function that evaluates the given condition in the application goroutine, which
+// is where the display state is changed once the display has started.
+func onApp(dsp *Display, cond func() bool) func() bool {
+	return func() bool {
+		var ok bool
+		dsp.inner.QueueUpdate(func() { ok = cond() })
+		return ok
+	}
+}
+
+// eventsText returns the text of the events widget of the status bar. Events are written to the
+// widget outside of the application goroutine, so it is read while holding the widget lock.
+func eventsText(dsp *Display) string {
+	dsp.bar.eventsWidget.Lock()
+	defer dsp.bar.eventsWidget.Unlock()
+
+	return dsp.bar.eventsWidget.GetText(true)
+}
+
 func newTestDisplay(t *testing.T, screen tcell.Screen) *Display {
 	t.Helper()
 
 	r := require.New(t)
-	dsp, err := NewDisplay(screen, "dark")
+	dsp, err := NewDisplay(screen, "dark", "")
 	r.NoError(err)
 	r.NotNil(dsp)
 	dsp.SetHandlers(
 		func(ek *tcell.EventKey) *tcell.EventKey { return ek },
 		func(ek *tcell.EventKey) *tcell.EventKey { return ek },
+		func(ek *tcell.EventKey) *tcell.EventKey { return ek },
 	)
 	return dsp
 }
@@ -21,6 +21,7 @@ type entriesPane struct {
 	store *entriesStore
 
 	readingPane *readingPane
+	openedFunc  func(*entity.Entry)
 }
 
 func newEntriesPane(theme *Theme, lang *Lang, rp *readingPane) *entriesPane {
@@ -53,19 +54,52 @@ func (ep *entriesPane) refreshEntries() {
 
 	ep.Clear()
 	for i, entry := range ep.store.all() {
+		ep.setRow(i, entry, rowf)
+		ep.ScrollToBeginning()
+	}
+}
 
-		colIdx := 0
-		addCell := func(cell *tview.TableCell) {
-			ep.SetCell(i, colIdx, cell)
-			colIdx++
-		}
+// updateEntry replaces the shown entry that has the same ID as the given entry, keeping the
+// current selection. It returns false if no such entry is shown.
+func (ep *entriesPane) updateEntry(entry *entity.Entry) bool {
+	i := ep.store.replace(entry)
+	if i < 0 {
+		return false
+	}
+	ep.setRow(i, entry, ep.makeRowFuncs())
+	return true
+}
 
-		for _, cell := range rowf(entry) {
-			cell.SetReference(entry)
-			addCell(cell)
-		}
+func (ep *entriesPane) setRow(
+	row int,
+	entry *entity.Entry,
+	rowf func(*entity.Entry) []*tview.TableCell,
+) {
+	for col, cell := range rowf(entry) {
+		cell.SetReference(entry)
+		ep.SetCell(row, col, cell)
+	}
+}
 
-		ep.ScrollToBeginning()
+// setOpenedFunc sets the function called after an entry is opened in the reading pane.
+func (ep *entriesPane) setOpenedFunc(f func(*entity.Entry)) {
+	ep.openedFunc = f
+}
+
+func (ep *entriesPane) getCurrentEntry() *entity.Entry {
+	row, column := ep.GetSelection()
+	entry, ok := ep.GetCell(row, column).GetReference().(*entity.Entry)
+	if !ok {
+		return nil
+	}
+	return entry
+}
+
+func (ep *entriesPane) refreshColors() {
+	ep.SetBackgroundColor(ep.theme.bg)
+	rowf := ep.makeRowFuncs()
+	for i, entry := range ep.store.all() {
+		ep.setRow(i, entry, rowf)
 	}
 }
 
@@ -77,6 +111,9 @@ func (ep *entriesPane) initTable() {
 			entry, ok := table.GetCell(row, column).GetReference().(*entity.Entry)
 			if ok {
 				ep.readingPane.setEntry(entry)
+				if ep.openedFunc != nil {
+					ep.openedFunc(entry)
+				}
 			}
 		},
 	)
@@ -85,6 +122,8 @@ func (ep *entriesPane) initTable() {
 
 var year = time.Now().Year()
 
+const bookmarkMarker = "★"
+
 func (ep *entriesPane) makeRowFuncs() func(*entity.Entry) []*tview.TableCell {
 	var (
 		_, _, w, _      = ep.GetInnerRect()
@@ -104,9 +143,19 @@ func (ep *entriesPane) makeRowFuncs() func(*entity.Entry) []*tview.TableCell {
 
 	return func(entry *entity.Entry) []*tview.TableCell {
 
-		titleCol := tview.NewTableCell(fmt.Sprintf("%-*s", titleW, entry.Title)).
+		color := ep.theme.entryRowUnread
+		if entry.IsRead {
+			color = ep.theme.entryRow
+		}
+
+		title := entry.Title
+		if entry.IsBookmarked {
+			title = fmt.Sprintf("%s %s", bookmarkMarker, title)
+		}
+		titleCol := tview.NewTableCell(fmt.Sprintf("%-*s", titleW, title)).
 			SetAlign(tview.AlignLeft).
-			SetMaxWidth(titleW)
+			SetMaxWidth(titleW).
+			SetTextColor(color)
 
 		pubTS := ""
 		if pubTime := entry.Published; pubTime != nil {
@@ -118,7 +167,8 @@ func (ep *entriesPane) makeRowFuncs() func(*entity.Entry) []*tview.TableCell {
 		}
 		pubDateCol := tview.NewTableCell(fmt.Sprintf("%*s", timeW, pubTS)).
 			SetAlign(tview.AlignRight).
-			SetMaxWidth(timeW)
+			SetMaxWidth(timeW).
+			SetTextColor(color)
 
 		return []*tview.TableCell{titleCol, pubDateCol}
 	}
@@ -184,3 +234,18 @@ func (les *entriesStore) set(entries []*entity.Entry) {
 func (les *entriesStore) all() []*entity.Entry {
 	return les.items
 }
+
+// replace swaps the stored entry that has the same ID as the given entry with the given entry. It
+// returns the index of the swapped entry, or -1 if no stored entry has the ID.
+func (les *entriesStore) replace(entry *entity.Entry) int {
+	if entry == nil {
+		return -1
+	}
+	for i, item := range les.items {
+		if item.ID == entry.ID {
+			les.items[i] = entry
+			return i
+		}
+	}
+	return -1
+}
@@ -20,8 +20,14 @@ type feedsPane struct {
 	theme *Theme
 	lang  *Lang
 
-	incoming <-chan *entity.Feed
-	store    *feedStore
+	incoming        <-chan *entity.Feed
+	incomingEntries <-chan *entity.Entry
+	pullFails       <-chan entity.PullResult
+	store           *feedStore
+	updateFunc      func(func())
+
+	grouping feedGrouping
+	folders  []*entity.Folder
 
 	entriesPane *entriesPane
 }
@@ -30,6 +36,8 @@ func newFeedsPane(
 	theme *Theme,
 	lang *Lang,
 	incoming <-chan *entity.Feed,
+	incomingEntries <-chan *entity.Entry,
+	pullFails <-chan entity.PullResult,
 	ep *entriesPane,
 ) *feedsPane {
 
@@ -37,8 +45,10 @@ func newFeedsPane(
 		theme: theme,
 		lang:  lang,
 
-		incoming: incoming,
-		store:    newFeedStore(),
+		incoming:        incoming,
+		incomingEntries: incomingEntries,
+		pullFails:       pullFails,
+		store:           newFeedStore(),
 
 		entriesPane: ep,
 	}
@@ -68,10 +78,9 @@ func newFeedsPane(
 
 func (fp *feedsPane) startPoll() (stop func()) {
 	done := make(chan struct{})
-	stop = func() {
-		defer close(done)
-		done <- struct{}{}
-	}
+	// Closing the channel does not wait for the poll to stop, since the poll may be waiting for
+	// an update to be applied by the application, which has stopped by then.
+	stop = func() { close(done) }
 
 	go func() {
 		for {
@@ -79,8 +88,23 @@ func (fp *feedsPane) startPoll() (stop func()) {
 			case <-done:
 				return
 			case feed := <-fp.incoming:
-				fp.store.upsert(feed)
-				fp.refreshFeeds()
+				fp.update(func() {
+					fp.store.upsert(feed)
+					fp.refreshFeeds()
+				})
+			case entry := <-fp.incomingEntries:
+				fp.update(func() {
+					if fp.store.upsertEntry(entry) {
+						fp.entriesPane.updateEntry(entry)
+						fp.refreshFeeds()
+					}
+				})
+			case pr := <-fp.pullFails:
+				fp.update(func() {
+					if fp.store.markPullFailed(pr) {
+						fp.refreshFeeds()
+					}
+				})
 			}
 		}
 	}()
@@ -88,6 +112,20 @@ func (fp *feedsPane) startPoll() (stop func()) {
 	return stop
 }
 
+// setUpdateFunc sets the function through which changes from feeds and entries received outside
+// of user actions are applied, so that they run on the goroutine of the application.
+func (fp *feedsPane) setUpdateFunc(f func(func())) {
+	fp.updateFunc = f
+}
+
+func (fp *feedsPane) update(f func()) {
+	if fp.updateFunc == nil {
+		f()
+		return
+	}
+	fp.updateFunc(f)
+}
+
 func (fp *feedsPane) refreshFeeds() {
 	root := fp.GetRoot()
 
@@ -95,12 +133,31 @@ func (fp *feedsPane) refreshFeeds() {
 	if currentFeed := fp.getCurrentFeed(); currentFeed != nil {
 		currentFeedID = &currentFeed.ID
 	}
+	currentGroup := groupOf(fp.GetCurrentNode())
+
+	collapsed := make(map[feedGroupLabel]bool)
+	for _, gnode := range root.GetChildren() {
+		if group := groupOf(gnode); group != nil && !gnode.IsExpanded() {
+			collapsed[group] = true
+		}
+	}
 
 	root.ClearChildren()
 
-	for _, group := range fp.store.feedsByPeriod() {
+	var groups []feedGroup[feedGroupLabel]
+	switch fp.grouping {
+	case groupByFolder:
+		groups = fp.store.feedsByFolder(fp.folders)
+	default:
+		groups = fp.store.feedsByPeriod()
+	}
+
+	for _, group := range groups {
 		gnode := groupNode(group.label, fp.theme, fp.lang)
 		root.AddChild(gnode)
+		if currentGroup != nil && group.label == currentGroup {
+			fp.SetCurrentNode(gnode)
+		}
 
 		for _, feed := range group.feedsSlice() {
 			fnode := feedNode(feed, fp.theme)
@@ -111,9 +168,32 @@ func (fp *feedsPane) refreshFeeds() {
 				fp.SetCurrentNode(fnode)
 			}
 		}
+
+		if collapsed[group.label] {
+			gnode.Collapse()
+			setGroupNodeDisplay(gnode, fp.lang)
+		}
 	}
 }
 
+// groupByPeriod groups the feeds by when they were last updated.
+func (fp *feedsPane) groupByPeriod() {
+	fp.grouping = groupByPeriod
+	fp.folders = nil
+	fp.refreshFeeds()
+}
+
+// groupByFolder groups the feeds by the given folders.
+func (fp *feedsPane) groupByFolder(folders []*entity.Folder) {
+	fp.grouping = groupByFolder
+	fp.folders = folders
+	fp.refreshFeeds()
+}
+
+func (fp *feedsPane) isGroupedByFolder() bool {
+	return fp.grouping == groupByFolder
+}
+
 func (fp *feedsPane) initTree() {
 
 	root := tview.NewTreeNode("")
@@ -152,14 +232,15 @@ func (fp *feedsPane) getCurrentGroupNode() *tview.TreeNode {
 	if current == nil {
 		return nil
 	}
-	switch t := current.GetReference().(type) {
-	case feedUpdatePeriod:
+	if groupOf(current) != nil {
 		return current
-	case *entity.Feed:
-		targetGroup := whenUpdated(t)
+	}
+	if feed := feedOf(current); feed != nil {
 		for _, gnode := range root.GetChildren() {
-			if group := periodOf(gnode); group != nil && targetGroup == *group {
-				return gnode
+			for _, fnode := range gnode.GetChildren() {
+				if feedOf(fnode) == feed {
+					return gnode
+				}
 			}
 		}
 	}
@@ -170,6 +251,24 @@ func (fp *feedsPane) getCurrentFeed() *entity.Feed {
 	return feedOf(fp.GetCurrentNode())
 }
 
+// getCurrentFeeds returns the current feed, or all feeds in the current group.
+func (fp *feedsPane) getCurrentFeeds() []*entity.Feed {
+	current := fp.GetCurrentNode()
+	if feed := feedOf(current); feed != nil {
+		return []*entity.Feed{feed}
+	}
+	if groupOf(current) == nil {
+		return nil
+	}
+	feeds := make([]*entity.Feed, 0)
+	for _, fnode := range current.GetChildren() {
+		if feed := feedOf(fnode); feed != nil {
+			feeds = append(feeds, feed)
+		}
+	}
+	return feeds
+}
+
 func (fp *feedsPane) getFoldState() foldState {
 	root := fp.GetRoot()
 	if root == nil {
@@ -208,22 +307,16 @@ func (fp *feedsPane) toggleAllFeedsFold() {
 
 	case foldMixed, foldAllCollapsed:
 		for _, gnode := range root.GetChildren() {
-			if period := periodOf(gnode); period != nil {
-				gnode.SetText(period.Text(fp.lang))
-			}
 			gnode.Expand()
+			setGroupNodeDisplay(gnode, fp.lang)
 		}
 		return
 
 	case foldAllExpanded:
 		current := fp.getCurrentGroupNode()
 		for _, gnode := range root.GetChildren() {
-			if unread := countGroupUnread(gnode); unread > 0 {
-				if period := periodOf(gnode); period != nil {
-					gnode.SetText(fmt.Sprintf("%s (%d)", period.Text(fp.lang), unread))
-				}
-			}
 			gnode.Collapse()
+			setGroupNodeDisplay(gnode, fp.lang)
 		}
 		// Set selection to nearest group prior to collapsing.
 		fp.SetCurrentNode(current)
@@ -245,18 +338,11 @@ func (fp *feedsPane) toggleCurrentFeedFold() {
 	}
 	if gnode := fp.getCurrentGroupNode(); gnode != nil {
 		if gnode.IsExpanded() {
-			if unread := countGroupUnread(gnode); unread > 0 {
-				if period := periodOf(gnode); period != nil {
-					gnode.SetText(fmt.Sprintf("%s (%d)", period.Text(fp.lang), unread))
-				}
-			}
 			gnode.Collapse()
 		} else {
-			if period := periodOf(gnode); period != nil {
-				gnode.SetText(period.Text(fp.lang))
-			}
 			gnode.Expand()
 		}
+		setGroupNodeDisplay(gnode, fp.lang)
 		fp.SetCurrentNode(gnode)
 		return
 	}
@@ -307,6 +393,7 @@ func (fp *feedsPane) makeDrawFuncs() (focusf, unfocusf drawFunc) {
 }
 
 func (fp *feedsPane) refreshColors() {
+	fp.SetBackgroundColor(fp.theme.bg)
 	for _, gnode := range fp.GetRoot().GetChildren() {
 		gnode.SetColor(fp.theme.feedGroupNode)
 		for _, fnode := range gnode.GetChildren() {
@@ -351,6 +438,33 @@ func (period feedUpdatePeriod) Text(lang *Lang) string {
 	}
 }
 
+// feedGrouping is how feeds are grouped in the feeds pane.
+type feedGrouping uint8
+
+const (
+	groupByPeriod feedGrouping = iota
+	groupByFolder
+)
+
+// feedGroupLabel is the label of a group of feeds, referenced by its node in the feeds pane.
+type feedGroupLabel interface {
+	Text(lang *Lang) string
+}
+
+// feedFolder is the label of the feeds in a folder. Feeds outside of any folder are labeled
+// with a zero ID.
+type feedFolder struct {
+	id   entity.ID
+	path string
+}
+
+func (folder feedFolder) Text(lang *Lang) string {
+	if folder.id == 0 {
+		return lang.noFolderText
+	}
+	return folder.path
+}
+
 func feedNode(feed *entity.Feed, theme *Theme) *tview.TreeNode {
 	node := tview.NewTreeNode("").
 		SetReference(feed).
@@ -371,19 +485,34 @@ func setFeedNodeDisplay(fnode *tview.TreeNode, theme *Theme) {
 		fnode.SetText(feed.Title).
 			SetColor(theme.feedNode)
 	}
+	if feed.IsFailing() {
+		fnode.SetColor(theme.feedNodeFailing)
+	}
 }
 
-func groupNode(period feedUpdatePeriod, theme *Theme, lang *Lang) *tview.TreeNode {
-	return tview.NewTreeNode(period.Text(lang)).
-		SetReference(period).
+func groupNode(label feedGroupLabel, theme *Theme, lang *Lang) *tview.TreeNode {
+	return tview.NewTreeNode(label.Text(lang)).
+		SetReference(label).
 		SetColor(theme.feedGroupNode).
 		SetSelectable(true)
 }
 
+// setGroupNodeDisplay shows the number of unread entries in the group if it is collapsed.
+func setGroupNodeDisplay(gnode *tview.TreeNode, lang *Lang) {
+	label := groupOf(gnode)
+	if label == nil {
+		return
+	}
+	if unread := countGroupUnread(gnode); unread > 0 && !gnode.IsExpanded() {
+		gnode.SetText(fmt.Sprintf("%s (%d)", label.Text(lang), unread))
+	} else {
+		gnode.SetText(label.Text(lang))
+	}
+}
+
 func countGroupUnread(gnode *tview.TreeNode) int {
 	var unread int
-	period := periodOf(gnode)
-	if period == nil {
+	if groupOf(gnode) == nil {
 		return 0
 	}
 	for _, fnode := range gnode.GetChildren() {
@@ -432,15 +561,15 @@ func feedOf(node *tview.TreeNode) *entity.Feed {
 	return feed
 }
 
-func periodOf(node *tview.TreeNode) *feedUpdatePeriod {
+func groupOf(node *tview.TreeNode) feedGroupLabel {
 	if node == nil {
 		return nil
 	}
-	period, ok := node.GetReference().(feedUpdatePeriod)
+	label, ok := node.GetReference().(feedGroupLabel)
 	if !ok {
 		return nil
 	}
-	return &period
+	return label
 }
 
 type feedStore struct {
@@ -452,21 +581,64 @@ func newFeedStore() *feedStore {
 	return &lfs
 }
 
-func (lfs *feedStore) feedsByPeriod() []feedGroup[feedUpdatePeriod] {
+func (lfs *feedStore) feedsByPeriod() []feedGroup[feedGroupLabel] {
 	m := make(map[feedUpdatePeriod][]*entity.Feed)
 	for _, feed := range lfs.items {
 		key := whenUpdated(feed)
 		m[key] = append(m[key], feed)
 	}
 
-	groups := make([]feedGroup[feedUpdatePeriod], 0)
+	groups := make([]feedGroup[feedGroupLabel], 0)
 	for i := uint8(0); i < uint8(updatedUnknown); i++ {
 		period := feedUpdatePeriod(i)
 		feeds, hasFeeds := m[period]
 		if !hasFeeds {
 			continue
 		}
-		groups = append(groups, newFeedGroup(period, feeds))
+		groups = append(groups, newFeedGroup[feedGroupLabel](period, feeds))
+	}
+
+	return groups
+}
+
+// feedsByFolder groups the feeds by the given folders, with subfolders following their parents.
+// Folders without feeds are left out, and feeds outside of the given folders come last.
+func (lfs *feedStore) feedsByFolder(folders []*entity.Folder) []feedGroup[feedGroupLabel] {
+	m := make(map[entity.ID][]*entity.Feed)
+	for _, feed := range lfs.items {
+		var key entity.ID
+		if feed.FolderID != nil {
+			key = *feed.FolderID
+		}
+		m[key] = append(m[key], feed)
+	}
+
+	groups := make([]feedGroup[feedGroupLabel], 0)
+	entity.WalkFolders(
+		folders,
+		"",
+		func(folder *entity.Folder, parentPath string) string {
+			path := folder.Name
+			if parentPath != "" {
+				path = parentPath + folderPathSep + folder.Name
+			}
+			if feeds, hasFeeds := m[folder.ID]; hasFeeds {
+				groups = append(
+					groups,
+					newFeedGroup[feedGroupLabel](feedFolder{id: folder.ID, path: path}, feeds),
+				)
+				delete(m, folder.ID)
+			}
+			return path
+		},
+	)
+
+	unfiled := make([]*entity.Feed, 0)
+	for _, feeds := range m {
+		unfiled = append(unfiled, feeds...)
+	}
+	if len(unfiled) > 0 {
+		groups = append(groups, newFeedGroup[feedGroupLabel](feedFolder{}, unfiled))
 	}
 
 	return groups
@@ -485,6 +657,41 @@ func (lfs *feedStore) upsert(incoming *entity.Feed) {
 	lfs.merge(existing, incoming)
 }
 
+// markPullFailed records the failed pull of the given result on the feed with the same URL. It
+// returns false if no such feed is in the store.
+func (lfs *feedStore) markPullFailed(pr entity.PullResult) bool {
+	perr := pr.Error()
+	if perr == nil {
+		return false
+	}
+	for _, feed := range lfs.items {
+		if feed.FeedURL == pr.URL() {
+			msg := perr.Error()
+			feed.PullFailures++
+			feed.LastPullError = &msg
+			return true
+		}
+	}
+	return false
+}
+
+// upsertEntry adds the given entry to its feed, or replaces the entry if the feed already has
+// it. It returns false if the feed of the entry is not in the store.
+func (lfs *feedStore) upsertEntry(entry *entity.Entry) bool {
+	if entry == nil {
+		return false
+	}
+	feed, exists := lfs.items[entry.FeedID]
+	if !exists {
+		return false
+	}
+	if feed.Entries == nil {
+		feed.Entries = make(map[entity.ID]*entity.Entry)
+	}
+	feed.Entries[entry.ID] = entry
+	return true
+}
+
 func (lfs *feedStore) merge(existing, incoming *entity.Feed) {
 	existing.Title = incoming.Title
 	existing.Description = incoming.Description
@@ -495,12 +702,20 @@ func (lfs *feedStore) merge(existing, incoming *entity.Feed) {
 	existing.Updated = incoming.Updated
 	existing.IsStarred = incoming.IsStarred
 	existing.Tags = incoming.Tags
+	existing.FolderID = incoming.FolderID
+	existing.FetchContent = incoming.FetchContent
+	existing.PullFailures = incoming.PullFailures
+	existing.LastPullError = incoming.LastPullError
+	existing.NextPullTime = incoming.NextPullTime
 
 	for eid, e := range incoming.Entries {
 		existing.Entries[eid] = e
 	}
 }
 
+// folderPathSep separates the names of folders and their subfolders in folder group labels.
+const folderPathSep = " / "
+
 type feedGroup[T any] struct {
 	label T
 	items []*entity.Feed
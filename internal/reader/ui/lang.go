@@ -8,16 +8,23 @@ type Lang struct {
 	entriesPaneTitle string
 	readingPaneTitle string
 
-	aboutPopupTitle string
-	helpPopupTitle  string
-	statsPopupTitle string
-	introPopupTitle string
+	aboutPopupTitle  string
+	helpPopupTitle   string
+	statsPopupTitle  string
+	introPopupTitle  string
+	searchPopupTitle string
 
 	updatedTodayText     string
 	updatedThisWeekText  string
 	updatedThisMonthText string
 	updatedEarlierText   string
 	updatedUnknownText   string
+
+	noFolderText string
+
+	entryAuthorsLabel   string
+	entryTagsLabel      string
+	entryEnclosureLabel string
 }
 
 var langEN = &Lang{
@@ -25,14 +32,21 @@ var langEN = &Lang{
 	entriesPaneTitle: "Entries",
 	readingPaneTitle: "",
 
-	aboutPopupTitle: "About",
-	helpPopupTitle:  "Keys",
-	statsPopupTitle: "Stats",
-	introPopupTitle: "Welcome",
+	aboutPopupTitle:  "About",
+	helpPopupTitle:   "Keys",
+	statsPopupTitle:  "Stats",
+	introPopupTitle:  "Welcome",
+	searchPopupTitle: "Search entries",
 
 	updatedTodayText:     "Updated today",
 	updatedThisWeekText:  "Updated this week",
 	updatedThisMonthText: "Updated this month",
 	updatedEarlierText:   "Updated earlier",
 	updatedUnknownText:   "Unknown",
+
+	noFolderText: "No folder",
+
+	entryAuthorsLabel:   "Authors",
+	entryTagsLabel:      "Tags",
+	entryEnclosureLabel: "Attachment",
 }
@@ -8,19 +8,30 @@ import "github.com/bow/neon/internal/entity"
 // Operator describes high-level UI operations.
 type Operator interface {
 	ClearStatusBar(*Display)
+	EditEntries(*Display, func() ([]*entity.Entry, error))
 	FocusFeedsPane(*Display)
 	FocusEntriesPane(*Display)
 	FocusNextPane(*Display)
 	FocusPreviousPane(*Display)
 	FocusReadingPane(*Display)
+	GetCurrentEntry(*Display) *entity.Entry
 	GetCurrentFeed(*Display) *entity.Feed
+	GetCurrentFeeds(*Display) []*entity.Feed
 	PopulateFeedsPane(*Display, func() ([]*entity.Feed, error))
 	RefreshFeeds(*Display, func() (<-chan entity.PullResult, error), *entity.Feed)
 	RefreshStats(*Display, func() (*entity.Stats, error))
+	ReloadTheme(*Display)
 	ShowIntroPopup(*Display)
+	ShowSearchPopup(*Display, func(string) ([]*entity.SearchResult, error))
+	StreamEntries(
+		*Display,
+		func() (<-chan *entity.Entry, <-chan error, error),
+		func() ([]*entity.Feed, error),
+	)
 	ToggleAboutPopup(*Display, string)
 	ToggleAllFeedsFold(*Display)
 	ToggleCurrentFeedFold(*Display)
+	ToggleFeedsGrouping(*Display, func() ([]*entity.Folder, error))
 	ToggleHelpPopup(*Display)
 	ToggleStatsPopup(*Display, func() (*entity.Stats, error))
 	ToggleStatusBar(*Display)
@@ -34,6 +34,15 @@ func (p *popup) setContent(prim tview.Primitive) {
 	p.content = prim
 }
 
+func (p *popup) refreshColors(theme *Theme) {
+	p.frame.SetTitleColor(theme.popupTitleFG).
+		SetBorderColor(theme.popupBorderFG).
+		SetBackgroundColor(theme.bg)
+	if content, ok := p.content.(*tview.TextView); ok {
+		content.SetTextColor(theme.textFG).SetBackgroundColor(theme.bg)
+	}
+}
+
 func (p *popup) setWidth(w int) {
 	p.Grid.SetColumns(0, w, 0)
 }
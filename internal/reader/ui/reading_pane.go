@@ -4,7 +4,11 @@
 package ui
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/bow/neon/internal/entity"
+	"github.com/bow/neon/internal/render"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -16,6 +20,10 @@ type readingPane struct {
 	lang  *Lang
 
 	narrowBranchPoint int
+
+	entry *entity.Entry
+	// textWidth is the width to which entry text was last rendered.
+	textWidth int
 }
 
 func newReadingPane(theme *Theme, lang *Lang, narrowBranchPoint int) *readingPane {
@@ -26,7 +34,7 @@ func newReadingPane(theme *Theme, lang *Lang, narrowBranchPoint int) *readingPan
 		narrowBranchPoint: narrowBranchPoint,
 	}
 
-	rp.TextView = tview.NewTextView()
+	rp.TextView = tview.NewTextView().SetDynamicColors(true)
 
 	focusf, unfocusf := rp.makeDrawFuncs()
 	rp.SetDrawFunc(unfocusf)
@@ -36,16 +44,59 @@ func newReadingPane(theme *Theme, lang *Lang, narrowBranchPoint int) *readingPan
 	return &rp
 }
 
+func (rp *readingPane) refreshColors() {
+	rp.SetTextColor(rp.theme.textFG).SetBackgroundColor(rp.theme.bg)
+}
+
 func (rp *readingPane) setEntry(entry *entity.Entry) {
-	if content := entry.Content; content != nil {
-		rp.SetText(*content)
+	rp.entry = entry
+	rp.renderEntry()
+}
+
+// renderEntry sets the text of the pane to the current entry, wrapped to the last known pane
+// width.
+func (rp *readingPane) renderEntry() {
+	entry := rp.entry
+	if entry == nil {
 		return
 	}
-	if url := entry.URL; url != nil {
-		rp.SetText(*url)
-		return
+
+	var body string
+	switch {
+	case entry.Content != nil:
+		var err error
+		if body, err = render.Render(*entry.Content, rp.textWidth, render.FormatTview); err != nil {
+			body = tview.Escape(*entry.Content)
+		}
+	case entry.URL != nil:
+		body = tview.Escape(*entry.URL)
+	default:
+		body = "<no-content>"
 	}
-	rp.SetText("<no-content>")
+	if header := rp.entryHeader(entry); header != "" {
+		body = tview.Escape(header) + "\n" + body
+	}
+	rp.SetText(body)
+}
+
+// entryHeader returns the metadata lines shown above the entry content, or an empty string if
+// the entry has no metadata.
+func (rp *readingPane) entryHeader(entry *entity.Entry) string {
+	var sb strings.Builder
+	if len(entry.Authors) > 0 {
+		fmt.Fprintf(&sb, "%s: %s\n", rp.lang.entryAuthorsLabel, strings.Join(entry.Authors, ", "))
+	}
+	if len(entry.Tags) > 0 {
+		fmt.Fprintf(&sb, "%s: %s\n", rp.lang.entryTagsLabel, strings.Join(entry.Tags, ", "))
+	}
+	for _, enc := range entry.Enclosures {
+		fmt.Fprintf(&sb, "%s: %s", rp.lang.entryEnclosureLabel, enc.URL)
+		if enc.Type != "" {
+			fmt.Fprintf(&sb, " (%s)", enc.Type)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
 }
 
 func (rp *readingPane) makeDrawFuncs() (focusf, unfocusf drawFunc) {
@@ -86,6 +137,12 @@ func (rp *readingPane) makeDrawFuncs() (focusf, unfocusf drawFunc) {
 				rp.theme.titleFG,
 			)
 
+			// Re-render the entry when the pane is resized, so that its text is wrapped to fit.
+			if textWidth := width - 2; textWidth != rp.textWidth {
+				rp.textWidth = textWidth
+				rp.renderEntry()
+			}
+
 			return x + 1, y + 1, width - 2, height - 1
 		}
 	}
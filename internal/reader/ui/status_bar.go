@@ -72,6 +72,10 @@ func (b *statusBar) setStats(stats *entity.Stats) {
 }
 
 func (b *statusBar) refreshColors() {
+	b.SetBackgroundColor(b.theme.bg)
+	b.eventsWidget.SetBackgroundColor(b.theme.bg)
+	b.readStatusWidget.SetBackgroundColor(b.theme.bg)
+	b.lastPullWidget.SetBackgroundColor(b.theme.bg)
 	b.eventsWidget.refreshColors()
 	b.readStatusWidget.SetTextColor(b.theme.statusBarFG)
 	b.lastPullWidget.SetTextColor(b.theme.statusBarFG)
@@ -4,14 +4,23 @@
 package ui
 
 import (
+	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/gdamore/tcell/v2"
+	"github.com/pelletier/go-toml/v2"
 	"github.com/rivo/tview"
 )
 
 type Theme struct {
-	bg tcell.Color
+	bg     tcell.Color
+	textFG tcell.Color
 
 	lineFG       tcell.Color
 	lineNormalFG tcell.Color
@@ -29,10 +38,22 @@ type Theme struct {
 	feedNodeUnreadNormal tcell.Color
 	feedNodeUnreadDim    tcell.Color
 
+	feedNodeFailing       tcell.Color
+	feedNodeFailingNormal tcell.Color
+	feedNodeFailingDim    tcell.Color
+
 	feedGroupNode       tcell.Color
 	feedGroupNodeNormal tcell.Color
 	feedGroupNodeDim    tcell.Color
 
+	entryRow       tcell.Color
+	entryRowNormal tcell.Color
+	entryRowDim    tcell.Color
+
+	entryRowUnread       tcell.Color
+	entryRowUnreadNormal tcell.Color
+	entryRowUnreadDim    tcell.Color
+
 	statusBarFG       tcell.Color
 	statusBarNormalFG tcell.Color
 	statusBarDimFG    tcell.Color
@@ -66,7 +87,11 @@ func (t *Theme) dim() {
 
 	t.feedNode = t.feedNodeDim
 	t.feedNodeUnread = t.feedNodeUnreadDim
+	t.feedNodeFailing = t.feedNodeFailingDim
 	t.feedGroupNode = t.feedGroupNodeDim
+
+	t.entryRow = t.entryRowDim
+	t.entryRowUnread = t.entryRowUnreadDim
 }
 
 func (t *Theme) normalize() {
@@ -80,7 +105,11 @@ func (t *Theme) normalize() {
 
 	t.feedNode = t.feedNodeNormal
 	t.feedNodeUnread = t.feedNodeUnreadNormal
+	t.feedNodeFailing = t.feedNodeFailingNormal
 	t.feedGroupNode = t.feedGroupNodeNormal
+
+	t.entryRow = t.entryRowNormal
+	t.entryRowUnread = t.entryRowUnreadNormal
 }
 
 func (t *Theme) lineStyle() tcell.Style {
@@ -89,17 +118,214 @@ func (t *Theme) lineStyle() tcell.Style {
 		Foreground(t.lineFG)
 }
 
-func loadTheme(name string) (*Theme, error) {
-	if name == "dark" {
-		return DarkTheme, nil
+// applyStyles sets the default colors of new tview primitives to the theme colors.
+func (t *Theme) applyStyles() {
+	tview.Styles.PrimitiveBackgroundColor = t.bg
+	tview.Styles.PrimaryTextColor = t.textFG
+	tview.Styles.BorderColor = t.popupBorderFG
+	tview.Styles.TitleColor = t.popupTitleFG
+}
+
+// colors returns pointers to the colors of the theme that are set by theme files, by the keys
+// of their settings.
+func (t *Theme) colors() map[string]*tcell.Color {
+	return map[string]*tcell.Color{
+		"background": &t.bg,
+		"text":       &t.textFG,
+
+		"line.normal":  &t.lineNormalFG,
+		"line.dim":     &t.lineDimFG,
+		"title.normal": &t.titleNormalFG,
+		"title.dim":    &t.titleDimFG,
+
+		"feed.normal":         &t.feedNodeNormal,
+		"feed.dim":            &t.feedNodeDim,
+		"feed-unread.normal":  &t.feedNodeUnreadNormal,
+		"feed-unread.dim":     &t.feedNodeUnreadDim,
+		"feed-failing.normal": &t.feedNodeFailingNormal,
+		"feed-failing.dim":    &t.feedNodeFailingDim,
+		"feed-group.normal":   &t.feedGroupNodeNormal,
+		"feed-group.dim":      &t.feedGroupNodeDim,
+
+		"entry.normal":        &t.entryRowNormal,
+		"entry.dim":           &t.entryRowDim,
+		"entry-unread.normal": &t.entryRowUnreadNormal,
+		"entry-unread.dim":    &t.entryRowUnreadDim,
+
+		"status-bar.normal":  &t.statusBarNormalFG,
+		"status-bar.dim":     &t.statusBarDimFG,
+		"event-info.normal":  &t.eventInfoNormalFG,
+		"event-info.dim":     &t.eventInfoDimFG,
+		"event-warn.normal":  &t.eventWarnNormalFG,
+		"event-warn.dim":     &t.eventWarnDimFG,
+		"event-error.normal": &t.eventErrNormalFG,
+		"event-error.dim":    &t.eventErrDimFG,
+
+		"popup.title":  &t.popupTitleFG,
+		"popup.border": &t.popupBorderFG,
+	}
+}
+
+const (
+	defaultThemeName = "dark"
+
+	themeBaseKey             = "base"
+	themeWideViewMinWidthKey = "wide-view-min-width"
+)
+
+//go:embed themes/*.toml
+var builtinThemeFiles embed.FS
+
+// BuiltinThemes returns the names of the themes that ship with the reader.
+func BuiltinThemes() []string {
+	names := []string{defaultThemeName}
+	entries, err := fs.ReadDir(builtinThemeFiles, "themes")
+	if err != nil {
+		panic(err)
+	}
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".toml"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadTheme loads the theme with the given name, or from the given file if the name is a path to
+// a TOML file. Built-in themes take precedence over the themes in the given directory.
+//
+// Theme files set colors on top of their base theme, which is the dark theme unless set
+// otherwise with the base setting.
+func loadTheme(name string, dir string) (*Theme, error) {
+	return loadThemeFrom(name, dir, nil)
+}
+
+func loadThemeFrom(name string, dir string, children []string) (*Theme, error) {
+	for _, child := range children {
+		if child == name {
+			return nil, fmt.Errorf("theme %q inherits from itself", name)
+		}
+	}
+
+	if name == defaultThemeName {
+		theme := *DarkTheme
+		return &theme, nil
+	}
+
+	raw, err := readThemeFile(name, dir)
+	if err != nil {
+		return nil, err
+	}
+	var settings map[string]any
+	if err = toml.Unmarshal(raw, &settings); err != nil {
+		return nil, fmt.Errorf("invalid theme %q: %w", name, err)
+	}
+
+	base := defaultThemeName
+	if value, exists := settings[themeBaseKey]; exists {
+		if base, _ = value.(string); base == "" {
+			return nil, fmt.Errorf("invalid theme %q: base must be a theme name", name)
+		}
+		delete(settings, themeBaseKey)
+	}
+	theme, err := loadThemeFrom(base, dir, append(children, name))
+	if err != nil {
+		return nil, err
+	}
+	if err = theme.set(settings); err != nil {
+		return nil, fmt.Errorf("invalid theme %q: %w", name, err)
+	}
+	theme.normalize()
+
+	return theme, nil
+}
+
+func readThemeFile(name string, dir string) ([]byte, error) {
+	if strings.HasSuffix(name, ".toml") || strings.ContainsRune(name, filepath.Separator) {
+		return os.ReadFile(name)
 	}
+
+	raw, err := builtinThemeFiles.ReadFile(fmt.Sprintf("themes/%s.toml", name))
+	if err == nil {
+		return raw, nil
+	}
+
+	if dir != "" {
+		raw, err = os.ReadFile(filepath.Join(dir, name+".toml"))
+		if err == nil {
+			return raw, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
 	return nil, fmt.Errorf("theme %q does not exist", name)
 }
 
+// set sets the theme colors from the given theme file settings.
+func (t *Theme) set(settings map[string]any) error {
+	values := make(map[string]any)
+	for key, value := range settings {
+		if table, ok := value.(map[string]any); ok {
+			for subKey, subValue := range table {
+				values[key+"."+subKey] = subValue
+			}
+		} else {
+			values[key] = value
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	colors := t.colors()
+	for _, key := range keys {
+		value := values[key]
+		if key == themeWideViewMinWidthKey {
+			width, ok := value.(int64)
+			if !ok || width < 0 {
+				return fmt.Errorf("%s must be a non-negative integer", key)
+			}
+			t.wideViewMinWidth = int(width)
+			continue
+		}
+		color, exists := colors[key]
+		if !exists {
+			return fmt.Errorf("unknown setting %q", key)
+		}
+		name, _ := value.(string)
+		parsed, err := parseColor(name)
+		if err != nil {
+			return fmt.Errorf("invalid color of %s: %w", key, err)
+		}
+		*color = parsed
+	}
+
+	return nil
+}
+
+// parseColor parses a W3C color name, a hex color in the #rrggbb form, or 'default' for the
+// default color of the terminal.
+func parseColor(name string) (tcell.Color, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "default" {
+		return tcell.ColorDefault, nil
+	}
+	color := tcell.GetColor(name)
+	if color == tcell.ColorDefault {
+		return color, fmt.Errorf("unknown color %q", name)
+	}
+	return color, nil
+}
+
 const darkForegroundDim = tcell.ColorDimGray
 
 var DarkTheme = &Theme{
-	bg: tcell.ColorBlack,
+	bg:     tcell.ColorBlack,
+	textFG: tcell.ColorWhite,
 
 	lineFG:       tcell.ColorWhite,
 	lineNormalFG: tcell.ColorWhite,
@@ -117,10 +343,22 @@ var DarkTheme = &Theme{
 	feedNodeUnreadNormal: tcell.ColorWhite,
 	feedNodeUnreadDim:    darkForegroundDim,
 
+	feedNodeFailing:       tcell.ColorTomato,
+	feedNodeFailingNormal: tcell.ColorTomato,
+	feedNodeFailingDim:    darkForegroundDim,
+
 	feedGroupNode:       tcell.ColorGrey,
 	feedGroupNodeNormal: tcell.ColorGrey,
 	feedGroupNodeDim:    darkForegroundDim,
 
+	entryRow:       tcell.ColorGrey,
+	entryRowNormal: tcell.ColorGrey,
+	entryRowDim:    darkForegroundDim,
+
+	entryRowUnread:       tcell.ColorWhite,
+	entryRowUnreadNormal: tcell.ColorWhite,
+	entryRowUnreadDim:    darkForegroundDim,
+
 	statusBarFG:       tcell.ColorGray,
 	statusBarNormalFG: tcell.ColorGray,
 	statusBarDimFG:    darkForegroundDim,
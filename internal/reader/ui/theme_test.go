@@ -0,0 +1,118 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestTheme(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestLoadThemeBuiltinOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	a.Equal([]string{"ansi16", "dark", "light", "mono"}, BuiltinThemes())
+	for _, name := range BuiltinThemes() {
+		theme, err := loadTheme(name, "")
+		r.NoError(err, name)
+		r.NotNil(theme, name)
+	}
+
+	light, err := loadTheme("light", "")
+	r.NoError(err)
+	a.Equal(tcell.ColorWhite, light.bg)
+	a.Equal(tcell.ColorBlack, light.lineFG)
+	a.Equal(DarkTheme.wideViewMinWidth, light.wideViewMinWidth)
+
+	mono, err := loadTheme("mono", "")
+	r.NoError(err)
+	for key, color := range mono.colors() {
+		a.Equal(tcell.ColorDefault, *color, key)
+	}
+}
+
+func TestLoadThemeCopyOk(t *testing.T) {
+	t.Parallel()
+
+	theme, err := loadTheme("dark", "")
+	require.NoError(t, err)
+
+	theme.dim()
+
+	assert.NotSame(t, DarkTheme, theme)
+	assert.Equal(t, tcell.ColorWhite, DarkTheme.lineFG)
+}
+
+func TestLoadThemeFileOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	dir := t.TempDir()
+	writeTestTheme(t, dir, "paper.toml", `
+base = "light"
+wide-view-min-width = 120
+
+[title]
+normal = "#336699"
+`)
+	path := writeTestTheme(t, dir, "sepia.toml", `
+base = "paper"
+background = "Wheat"
+`)
+
+	theme, err := loadTheme("paper", dir)
+	r.NoError(err)
+	a.Equal(tcell.NewHexColor(0x336699), theme.titleFG)
+	a.Equal(tcell.ColorSilver, theme.titleDimFG)
+	a.Equal(tcell.ColorWhite, theme.bg)
+	a.Equal(120, theme.wideViewMinWidth)
+
+	theme, err = loadTheme(path, dir)
+	r.NoError(err)
+	a.Equal(tcell.ColorWheat, theme.bg)
+	a.Equal(tcell.NewHexColor(0x336699), theme.titleFG)
+}
+
+func TestLoadThemeErr(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestTheme(t, dir, "key.toml", "[feed]\nnormal = \"white\"\nbold = true\n")
+	writeTestTheme(t, dir, "color.toml", "[line]\ndim = \"greyish\"\n")
+	writeTestTheme(t, dir, "width.toml", "wide-view-min-width = \"wide\"\n")
+	writeTestTheme(t, dir, "loop-a.toml", "base = \"loop-b\"\n")
+	writeTestTheme(t, dir, "loop-b.toml", "base = \"loop-a\"\n")
+	writeTestTheme(t, dir, "syntax.toml", "[line\n")
+
+	for name, msg := range map[string]string{
+		"nope":   `theme "nope" does not exist`,
+		"key":    `invalid theme "key": unknown setting "feed.bold"`,
+		"color":  `invalid theme "color": invalid color of line.dim: unknown color "greyish"`,
+		"width":  `invalid theme "width": wide-view-min-width must be a non-negative integer`,
+		"loop-a": `theme "loop-a" inherits from itself`,
+		"syntax": `invalid theme "syntax"`,
+	} {
+		theme, err := loadTheme(name, dir)
+		assert.Nil(t, theme, name)
+		assert.ErrorContains(t, err, msg, name)
+	}
+}
@@ -12,3 +12,5 @@ var (
 	threeDaysAgo = now.Add(-3 * day)
 	twoWeeksAgo  = now.Add(-14 * day)
 )
+
+func pointer[T any](value T) *T { return &value }
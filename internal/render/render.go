@@ -0,0 +1,589 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package render converts entry HTML content into text for display in terminals.
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/rivo/tview"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Format is the output format of rendered text.
+type Format uint8
+
+const (
+	// FormatTview produces text with tview style tags, for text views with dynamic colors.
+	FormatTview Format = iota + 1
+	// FormatANSI produces text styled with ANSI escape sequences.
+	FormatANSI
+	// FormatPlain produces unstyled text.
+	FormatPlain
+)
+
+// Render converts the given HTML content into text of the given format, with words wrapped to
+// the given width. Links are numbered and listed after the text. Content without any HTML tags
+// is treated as plain text, with blank lines separating paragraphs. A width of zero or less
+// disables wrapping.
+func Render(content string, width int, format Format) (string, error) {
+	r := renderer{width: width, format: format, linkNums: make(map[string]int)}
+
+	if !strings.Contains(content, "<") {
+		for _, par := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+			r.addText(par)
+			r.endBlock(true)
+		}
+	} else {
+		doc, err := html.Parse(strings.NewReader(content))
+		if err != nil {
+			return "", err
+		}
+		r.render(doc)
+		r.endBlock(true)
+	}
+	r.renderLinks()
+
+	return strings.Join(r.lines, "\n"), nil
+}
+
+type style struct {
+	bold, italic, underline, strike, dim, code bool
+}
+
+// word is a piece of inline text. Words not preceded by a space are kept together when wrapping.
+type word struct {
+	text  string
+	style style
+	space bool
+}
+
+// indent is a line prefix applied to all lines of a block, such as a list bullet or a quote
+// bar. The first line of the block uses first and subsequent lines use rest.
+type indent struct {
+	first, rest string
+	style       style
+	used        bool
+}
+
+type list struct {
+	ordered bool
+	next    int
+}
+
+type renderer struct {
+	width  int
+	format Format
+
+	lines   []string
+	words   []word
+	style   style
+	indents []*indent
+	lists   []*list
+
+	// space is whether the next word is preceded by whitespace.
+	space bool
+	// blank is whether a blank line precedes the next line.
+	blank bool
+
+	links    []string
+	linkNums map[string]int
+}
+
+func (r *renderer) render(node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		r.addText(node.Data)
+	case html.ElementNode:
+		r.renderElement(node)
+	case html.DocumentNode:
+		r.renderChildren(node)
+	case html.ErrorNode, html.CommentNode, html.DoctypeNode, html.RawNode:
+	}
+}
+
+func (r *renderer) renderChildren(node *html.Node) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		r.render(child)
+	}
+}
+
+func (r *renderer) renderElement(node *html.Node) {
+	switch node.DataAtom {
+
+	case atom.Head, atom.Script, atom.Style, atom.Noscript, atom.Template, atom.Iframe:
+		return
+
+	case atom.P, atom.Figure, atom.Dl, atom.Address, atom.Details:
+		r.endBlock(true)
+		r.renderChildren(node)
+		r.endBlock(true)
+
+	case atom.Div, atom.Section, atom.Article, atom.Header, atom.Footer, atom.Main, atom.Aside,
+		atom.Nav, atom.Figcaption, atom.Dt, atom.Summary:
+		r.endBlock(false)
+		r.renderChildren(node)
+		r.endBlock(false)
+
+	case atom.Dd:
+		r.endBlock(false)
+		r.withIndent(&indent{first: "  ", rest: "  "}, func() { r.renderChildren(node) })
+
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(node.Data[1] - '0')
+		r.endBlock(true)
+		r.withStyle(func(s *style) { s.bold, s.underline = true, s.underline || level <= 2 },
+			func() {
+				if r.format == FormatPlain {
+					r.addText(strings.Repeat("#", level) + " ")
+				}
+				r.renderChildren(node)
+			},
+		)
+		r.endBlock(true)
+
+	case atom.Br:
+		if len(r.words) == 0 {
+			r.blank = true
+		}
+		r.endBlock(false)
+
+	case atom.Hr:
+		r.endBlock(true)
+		width := r.availWidth()
+		if width <= 0 {
+			width = 20
+		}
+		r.emit([]word{{text: strings.Repeat("─", width), style: style{dim: true}}})
+		r.blank = true
+
+	case atom.B, atom.Strong:
+		r.withStyle(func(s *style) { s.bold = true }, func() { r.renderChildren(node) })
+
+	case atom.I, atom.Em, atom.Cite, atom.Var, atom.Dfn:
+		r.withStyle(func(s *style) { s.italic = true }, func() { r.renderChildren(node) })
+
+	case atom.U, atom.Ins:
+		r.withStyle(func(s *style) { s.underline = true }, func() { r.renderChildren(node) })
+
+	case atom.S, atom.Del, atom.Strike:
+		r.withStyle(func(s *style) { s.strike = true }, func() { r.renderChildren(node) })
+
+	case atom.Code, atom.Kbd, atom.Samp, atom.Tt:
+		r.withStyle(func(s *style) { s.code = true }, func() { r.renderChildren(node) })
+
+	case atom.A:
+		r.renderChildren(node)
+		r.addLink(attr(node, "href"))
+
+	case atom.Img:
+		text := "[image]"
+		if alt := strings.TrimSpace(attr(node, "alt")); alt != "" {
+			text = fmt.Sprintf("[image: %s]", alt)
+		}
+		r.withStyle(func(s *style) { s.dim = true }, func() { r.addText(text) })
+
+	case atom.Ul, atom.Ol:
+		r.renderList(node)
+
+	case atom.Li:
+		r.renderListItem(node)
+
+	case atom.Blockquote:
+		r.endBlock(true)
+		r.withIndent(
+			&indent{first: "│ ", rest: "│ ", style: style{dim: true}},
+			func() { r.renderChildren(node) },
+		)
+		r.blank = true
+
+	case atom.Pre:
+		r.renderPre(node)
+
+	case atom.Table:
+		r.renderTable(node)
+
+	default:
+		r.renderChildren(node)
+	}
+}
+
+func (r *renderer) renderList(node *html.Node) {
+	nested := len(r.lists) > 0
+	r.endBlock(!nested)
+
+	l := list{ordered: node.DataAtom == atom.Ol, next: 1}
+	if start, err := strconv.Atoi(attr(node, "start")); err == nil {
+		l.next = start
+	}
+	r.lists = append(r.lists, &l)
+	r.renderChildren(node)
+	r.lists = r.lists[:len(r.lists)-1]
+
+	r.endBlock(!nested)
+}
+
+func (r *renderer) renderListItem(node *html.Node) {
+	r.endBlock(false)
+
+	bullet := "• "
+	if n := len(r.lists); n > 0 {
+		l := r.lists[n-1]
+		switch {
+		case l.ordered:
+			bullet = fmt.Sprintf("%d. ", l.next)
+			l.next++
+		case n == 2:
+			bullet = "◦ "
+		case n > 2:
+			bullet = "▪ "
+		}
+	}
+	r.withIndent(
+		&indent{first: bullet, rest: strings.Repeat(" ", runewidth.StringWidth(bullet))},
+		func() { r.renderChildren(node) },
+	)
+}
+
+func (r *renderer) renderPre(node *html.Node) {
+	r.endBlock(true)
+
+	text := strings.ReplaceAll(textContent(node), "\t", "    ")
+	text = strings.TrimPrefix(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	text = strings.TrimRight(text, "\n ")
+
+	r.withIndent(&indent{first: "  ", rest: "  "}, func() {
+		for _, line := range strings.Split(text, "\n") {
+			r.emit([]word{{text: line, style: style{code: true}}})
+		}
+	})
+	r.blank = true
+}
+
+func (r *renderer) renderTable(node *html.Node) {
+	r.endBlock(true)
+
+	var (
+		rows    [][]string
+		headers []bool
+	)
+	var walk func(*html.Node, bool)
+	walk = func(n *html.Node, inHead bool) {
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode {
+				continue
+			}
+			switch child.DataAtom {
+			case atom.Thead:
+				walk(child, true)
+			case atom.Tbody, atom.Tfoot:
+				walk(child, false)
+			case atom.Tr:
+				var (
+					row    []string
+					header = true
+				)
+				for cell := child.FirstChild; cell != nil; cell = cell.NextSibling {
+					if cell.DataAtom != atom.Td && cell.DataAtom != atom.Th {
+						continue
+					}
+					header = header && cell.DataAtom == atom.Th
+					row = append(row, strings.Join(strings.Fields(textContent(cell)), " "))
+				}
+				if len(row) > 0 {
+					rows = append(rows, row)
+					headers = append(headers, inHead || header)
+				}
+			case atom.Caption:
+			default:
+				walk(child, inHead)
+			}
+		}
+	}
+	walk(node, false)
+
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], runewidth.StringWidth(cell))
+		}
+	}
+
+	sep := word{text: " │ ", style: style{dim: true}}
+	for i, row := range rows {
+		var line []word
+		for j, width := range widths {
+			if j > 0 {
+				line = append(line, sep)
+			}
+			var cell string
+			if j < len(row) {
+				cell = row[j]
+			}
+			if j < len(widths)-1 {
+				cell = runewidth.FillRight(cell, width)
+			}
+			line = append(line, word{
+				text:  cell,
+				style: style{bold: headers[i]},
+			})
+		}
+		r.emit(line)
+
+		if headers[i] && (i+1 >= len(headers) || !headers[i+1]) {
+			rule := make([]string, len(widths))
+			for j, width := range widths {
+				rule[j] = strings.Repeat("─", width)
+			}
+			r.emit([]word{{text: strings.Join(rule, "─┼─"), style: style{dim: true}}})
+		}
+	}
+	r.blank = true
+}
+
+// renderLinks lists the links numbered in the text.
+func (r *renderer) renderLinks() {
+	if len(r.links) == 0 {
+		return
+	}
+	r.blank = true
+	for i, link := range r.links {
+		r.emit([]word{
+			{text: fmt.Sprintf("[%d]", i+1), style: style{dim: true}},
+			{text: " " + link},
+		})
+	}
+}
+
+func (r *renderer) addText(text string) {
+	if text == "" {
+		return
+	}
+	if isSpace(text[0]) {
+		r.space = true
+	}
+	for _, field := range strings.Fields(text) {
+		r.words = append(r.words, word{text: field, style: r.style, space: r.space})
+		r.space = true
+	}
+	if !isSpace(text[len(text)-1]) {
+		r.space = false
+	}
+}
+
+// addLink adds the reference number of the given link to the text.
+func (r *renderer) addLink(href string) {
+	href = strings.TrimSpace(href)
+	if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(href, "javascript:") {
+		return
+	}
+	num, exists := r.linkNums[href]
+	if !exists {
+		r.links = append(r.links, href)
+		num = len(r.links)
+		r.linkNums[href] = num
+	}
+	r.words = append(r.words, word{text: fmt.Sprintf("[%d]", num), style: style{dim: true}})
+	r.space = false
+}
+
+func (r *renderer) withStyle(update func(*style), render func()) {
+	prev := r.style
+	update(&r.style)
+	render()
+	r.style = prev
+}
+
+func (r *renderer) withIndent(ind *indent, render func()) {
+	r.indents = append(r.indents, ind)
+	render()
+	r.endBlock(false)
+	r.indents = r.indents[:len(r.indents)-1]
+}
+
+// endBlock wraps the pending words into lines, optionally separating the next block with a
+// blank line.
+func (r *renderer) endBlock(separate bool) {
+	r.flush()
+	if separate {
+		r.blank = true
+	}
+}
+
+func (r *renderer) flush() {
+	words := r.words
+	r.words, r.space = nil, false
+	if len(words) == 0 {
+		return
+	}
+
+	var (
+		avail     = r.availWidth()
+		line      []word
+		lineWidth = 0
+	)
+	for len(words) > 0 {
+		// Words not separated by spaces are wrapped as one.
+		n := 1
+		for n < len(words) && !words[n].space {
+			n++
+		}
+		chunk, chunkWidth := words[:n], 0
+		for _, w := range chunk {
+			chunkWidth += runewidth.StringWidth(w.text)
+		}
+		words = words[n:]
+
+		if lineWidth == 0 {
+			line, lineWidth = append(line, chunk...), chunkWidth
+			continue
+		}
+		if avail > 0 && lineWidth+1+chunkWidth > avail {
+			r.emit(line)
+			line, lineWidth = append([]word(nil), chunk...), chunkWidth
+			continue
+		}
+
+		space := word{text: " "}
+		if chunk[0].style == line[len(line)-1].style {
+			space.style = chunk[0].style
+		}
+		line = append(line, space)
+		line = append(line, chunk...)
+		lineWidth += 1 + chunkWidth
+	}
+	r.emit(line)
+}
+
+// availWidth returns the width available for text after the current indentation, or zero if
+// wrapping is disabled.
+func (r *renderer) availWidth() int {
+	if r.width <= 0 {
+		return 0
+	}
+	avail := r.width
+	for _, ind := range r.indents {
+		avail -= runewidth.StringWidth(ind.rest)
+	}
+	return max(avail, 1)
+}
+
+// emit adds a line of the given words, prefixed with the current indentation.
+func (r *renderer) emit(words []word) {
+	if r.blank && len(r.lines) > 0 {
+		// Blank lines only continue the indentation of blocks that have started.
+		var sb strings.Builder
+		for _, ind := range r.indents {
+			if ind.used {
+				sb.WriteString(r.styled(strings.TrimRight(ind.rest, " "), ind.style))
+			}
+		}
+		r.lines = append(r.lines, sb.String())
+	}
+	r.blank = false
+
+	var sb strings.Builder
+	for _, ind := range r.indents {
+		prefix := ind.rest
+		if !ind.used {
+			prefix, ind.used = ind.first, true
+		}
+		sb.WriteString(r.styled(prefix, ind.style))
+	}
+
+	// Words of the same style are styled together, to keep the output compact.
+	for i := 0; i < len(words); {
+		j, text := i+1, words[i].text
+		for j < len(words) && words[j].style == words[i].style {
+			text += words[j].text
+			j++
+		}
+		sb.WriteString(r.styled(text, words[i].style))
+		i = j
+	}
+
+	r.lines = append(r.lines, sb.String())
+}
+
+func (r *renderer) styled(text string, s style) string {
+	switch r.format {
+	case FormatTview:
+		text = tview.Escape(text)
+		if s == (style{}) || text == "" {
+			return text
+		}
+		var fg, attrs string
+		if s.code {
+			fg = "teal"
+		}
+		for _, item := range []struct {
+			on   bool
+			attr string
+		}{{s.bold, "b"}, {s.italic, "i"}, {s.underline, "u"}, {s.strike, "s"}, {s.dim, "d"}} {
+			if item.on {
+				attrs += item.attr
+			}
+		}
+		return fmt.Sprintf("[%s::%s]%s[-:-:-]", fg, attrs, text)
+
+	case FormatANSI:
+		if s == (style{}) || text == "" {
+			return text
+		}
+		var codes []string
+		for _, item := range []struct {
+			on   bool
+			code string
+		}{
+			{s.bold, "1"}, {s.dim, "2"}, {s.italic, "3"}, {s.underline, "4"}, {s.strike, "9"},
+			{s.code, "36"},
+		} {
+			if item.on {
+				codes = append(codes, item.code)
+			}
+		}
+		return fmt.Sprintf("\x1b[%sm%s\x1b[0m", strings.Join(codes, ";"), text)
+
+	default:
+		return text
+	}
+}
+
+func attr(node *html.Node, key string) string {
+	for _, a := range node.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func textContent(node *html.Node) string {
+	var sb strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+		if n.DataAtom == atom.Br {
+			sb.WriteString("\n")
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(node)
+	return sb.String()
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
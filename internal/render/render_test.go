@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPlain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		width   int
+		want    []string
+	}{
+		{
+			name:    "plain text",
+			content: "First paragraph\nstill first.\n\nSecond paragraph.",
+			want:    []string{"First paragraph still first.", "", "Second paragraph."},
+		},
+		{
+			name:    "inline elements",
+			content: "<p>A <b>bold</b>, <em>italic</em> and <code>code</code>word.</p>",
+			want:    []string{"A bold, italic and codeword."},
+		},
+		{
+			name:    "wrapping",
+			content: "<p>The quick brown fox jumps over the lazy dog.</p>",
+			width:   15,
+			want:    []string{"The quick brown", "fox jumps over", "the lazy dog."},
+		},
+		{
+			name:    "headings",
+			content: "<h1>Title</h1><p>Text</p><h3>Sub</h3>",
+			want:    []string{"# Title", "", "Text", "", "### Sub"},
+		},
+		{
+			name: "links",
+			content: `<p><a href="https://a.com">A</a>, <a href="https://b.com">B</a>` +
+				` and <a href="https://a.com">A again</a>.</p>`,
+			want: []string{
+				"A[1], B[2] and A again[1].",
+				"",
+				"[1] https://a.com",
+				"[2] https://b.com",
+			},
+		},
+		{
+			name:    "images",
+			content: `<p><img src="a.png" alt="A cat"> and <img src="b.png"></p>`,
+			want:    []string{"[image: A cat] and [image]"},
+		},
+		{
+			name: "lists",
+			content: "<ul><li>One two three</li><li>Four<ol start=\"2\"><li>Five</li>" +
+				"<li>Six</li></ol></li></ul>",
+			width: 10,
+			want:  []string{"• One two", "  three", "• Four", "  2. Five", "  3. Six"},
+		},
+		{
+			name:    "blockquote",
+			content: "<p>Before</p><blockquote><p>Quoted one</p><p>Quoted two</p></blockquote>",
+			want:    []string{"Before", "", "│ Quoted one", "│", "│ Quoted two"},
+		},
+		{
+			name:    "code block",
+			content: "<pre><code>if x {\n\treturn\n}\n</code></pre>",
+			width:   5,
+			want:    []string{"  if x {", "      return", "  }"},
+		},
+		{
+			name: "table",
+			content: "<table><tr><th>Name</th><th>Size</th></tr>" +
+				"<tr><td>a</td><td>1</td></tr><tr><td>long name</td><td>22</td></tr></table>",
+			want: []string{
+				"Name      │ Size",
+				"──────────┼─────",
+				"a         │ 1",
+				"long name │ 22",
+			},
+		},
+		{
+			name:    "line breaks",
+			content: "<p>One<br>Two</p><script>alert(1)</script>",
+			want:    []string{"One", "Two"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Render(test.content, test.width, FormatPlain)
+			require.NoError(t, err)
+			assert.Equal(t, strings.Join(test.want, "\n"), got)
+		})
+	}
+}
+
+func TestRenderTview(t *testing.T) {
+	t.Parallel()
+
+	got, err := Render(
+		`<p><b>Bold</b> [red] text <a href="https://a.com">link</a></p>`,
+		0,
+		FormatTview,
+	)
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		"[::b]Bold[-:-:-] [red[] text link[::d][1[][-:-:-]\n\n[::d][1[][-:-:-] https://a.com",
+		got,
+	)
+}
+
+func TestRenderANSI(t *testing.T) {
+	t.Parallel()
+
+	got, err := Render(`<p><i>Hi</i> <code>x</code></p>`, 0, FormatANSI)
+	require.NoError(t, err)
+	assert.Equal(t, "\x1b[3mHi\x1b[0m \x1b[36mx\x1b[0m", got)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bow/neon/api"
+	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
+)
+
+// methodScopes are the token scopes required for calling the service methods. Methods not listed
+// here require the admin scope.
+var methodScopes = map[string]entity.TokenScope{
+	api.Neon_DiscoverFeeds_FullMethodName:         entity.TokenScopeRead,
+	api.Neon_ListFeeds_FullMethodName:             entity.TokenScopeRead,
+	api.Neon_StreamEntries_FullMethodName:         entity.TokenScopeRead,
+	api.Neon_ListEntries_FullMethodName:           entity.TokenScopeRead,
+	api.Neon_GetEntry_FullMethodName:              entity.TokenScopeRead,
+	api.Neon_SearchEntries_FullMethodName:         entity.TokenScopeRead,
+	api.Neon_ExportOPML_FullMethodName:            entity.TokenScopeRead,
+	api.Neon_GetStats_FullMethodName:              entity.TokenScopeRead,
+	api.Neon_GetInfo_FullMethodName:               entity.TokenScopeRead,
+	api.Neon_ListFolders_FullMethodName:           entity.TokenScopeRead,
+	api.Neon_ListRules_FullMethodName:             entity.TokenScopeRead,
+	api.Neon_MatchRule_FullMethodName:             entity.TokenScopeRead,
+	api.Neon_ListRetentionPolicies_FullMethodName: entity.TokenScopeRead,
+	api.Neon_AddFeed_FullMethodName:               entity.TokenScopeWrite,
+	api.Neon_EditFeeds_FullMethodName:             entity.TokenScopeWrite,
+	api.Neon_PullFeeds_FullMethodName:             entity.TokenScopeWrite,
+	api.Neon_EditEntries_FullMethodName:           entity.TokenScopeWrite,
+	api.Neon_FetchEntryContent_FullMethodName:     entity.TokenScopeWrite,
+	api.Neon_CreateFolder_FullMethodName:          entity.TokenScopeWrite,
+	api.Neon_EditFolders_FullMethodName:           entity.TokenScopeWrite,
+	api.Neon_DeleteFolders_FullMethodName:         entity.TokenScopeWrite,
+	api.Neon_CreateRule_FullMethodName:            entity.TokenScopeWrite,
+	api.Neon_DeleteRules_FullMethodName:           entity.TokenScopeWrite,
+	api.Neon_SetRetentionPolicy_FullMethodName:    entity.TokenScopeWrite,
+	api.Neon_DeleteRetentionPolicy_FullMethodName: entity.TokenScopeWrite,
+}
+
+// authenticator checks that calls to the service carry bearer tokens allowing the called
+// methods. Calls to other services, such as health checks, are not checked.
+type authenticator struct {
+	ds datastore.Datastore
+}
+
+func (au *authenticator) unaryServerInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if err := au.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (au *authenticator) streamServerInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := au.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (au *authenticator) authorize(ctx context.Context, fullMethod string) error {
+	if !strings.HasPrefix(fullMethod, "/"+api.Neon_ServiceDesc.ServiceName+"/") {
+		return nil
+	}
+
+	secret, err := bearerToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	token, err := au.ds.AuthenticateToken(ctx, secret)
+	if err != nil {
+		if errors.As(err, &entity.InvalidTokenError{}) {
+			return status.Error(codes.Unauthenticated, "invalid token")
+		}
+		return err
+	}
+
+	required, ok := methodScopes[fullMethod]
+	if !ok {
+		required = entity.TokenScopeAdmin
+	}
+	if !token.Scope.Allows(required) {
+		return status.Errorf(
+			codes.PermissionDenied,
+			"token %q with %s scope can not call %s",
+			token.Name,
+			token.Scope,
+			fullMethod,
+		)
+	}
+
+	return nil
+}
+
+// bearerToken returns the bearer token in the authorization metadata of the given context.
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return "", status.Error(codes.Unauthenticated, "malformed bearer token")
+	}
+	return strings.TrimSpace(token), nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bow/neon/api"
+	"github.com/bow/neon/internal/entity"
+)
+
+// setupAuthServerTest is a shortcut for creating server tests with authentication required.
+func setupAuthServerTest(t *testing.T) (api.NeonClient, *MockDatastore) {
+	t.Helper()
+
+	ds := NewMockDatastore(gomock.NewController(t))
+	client := newTestClientBuilder(t).ServerDatastore(ds).ServerRequireAuth().Build()
+
+	return client, ds
+}
+
+func withToken(secret string) context.Context {
+	return metadata.AppendToOutgoingContext(
+		context.Background(),
+		"authorization",
+		"Bearer "+secret,
+	)
+}
+
+func TestAuthOkReadScope(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	client, ds := setupAuthServerTest(t)
+
+	ds.EXPECT().
+		AuthenticateToken(gomock.Any(), "neon_reader").
+		Return(&entity.Token{ID: 1, Name: "reader", Scope: entity.TokenScopeRead}, nil)
+
+	rsp, err := client.GetInfo(withToken("neon_reader"), &api.GetInfoRequest{})
+	r.NoError(err)
+	r.NotNil(rsp)
+}
+
+func TestAuthOkAdminScope(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	client, ds := setupAuthServerTest(t)
+
+	ds.EXPECT().
+		AuthenticateToken(gomock.Any(), "neon_admin").
+		Return(&entity.Token{ID: 1, Name: "admin", Scope: entity.TokenScopeAdmin}, nil)
+	ds.EXPECT().
+		DeleteFeeds(gomock.Any(), []entity.ID{2}).
+		Return(nil)
+
+	req := api.DeleteFeedsRequest{FeedIds: []uint32{2}}
+	rsp, err := client.DeleteFeeds(withToken("neon_admin"), &req)
+	r.NoError(err)
+	r.NotNil(rsp)
+}
+
+func TestAuthErrMissingToken(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	client, _ := setupAuthServerTest(t)
+
+	rsp, err := client.GetInfo(context.Background(), &api.GetInfoRequest{})
+	a.Nil(rsp)
+	a.EqualError(err, "rpc error: code = Unauthenticated desc = missing bearer token")
+}
+
+func TestAuthErrMalformedToken(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	client, _ := setupAuthServerTest(t)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "neon_x")
+	rsp, err := client.GetInfo(ctx, &api.GetInfoRequest{})
+	a.Nil(rsp)
+	a.EqualError(err, "rpc error: code = Unauthenticated desc = malformed bearer token")
+}
+
+func TestAuthErrInvalidToken(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	client, ds := setupAuthServerTest(t)
+
+	ds.EXPECT().
+		AuthenticateToken(gomock.Any(), "neon_revoked").
+		Return(nil, fmt.Errorf("SQLite.AuthenticateToken: %w", entity.InvalidTokenError{}))
+
+	rsp, err := client.GetInfo(withToken("neon_revoked"), &api.GetInfoRequest{})
+	a.Nil(rsp)
+	a.EqualError(err, "rpc error: code = Unauthenticated desc = invalid token")
+}
+
+func TestAuthErrInsufficientScope(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	client, ds := setupAuthServerTest(t)
+
+	ds.EXPECT().
+		AuthenticateToken(gomock.Any(), "neon_writer").
+		Return(&entity.Token{ID: 2, Name: "writer", Scope: entity.TokenScopeWrite}, nil)
+
+	req := api.ImportOPMLRequest{Payload: []byte("<opml/>")}
+	rsp, err := client.ImportOPML(withToken("neon_writer"), &req)
+	a.Nil(rsp)
+	a.EqualError(
+		err,
+		"rpc error: code = PermissionDenied desc = token \"writer\" with write scope"+
+			" can not call /neon.Neon/ImportOPML",
+	)
+}
+
+func TestAuthErrStreamMissingToken(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, _ := setupAuthServerTest(t)
+
+	stream, err := client.StreamEntries(context.Background(), &api.StreamEntriesRequest{})
+	r.NoError(err)
+
+	rsp, err := stream.Recv()
+	a.Nil(rsp)
+	a.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthorizeOtherServices(t *testing.T) {
+	t.Parallel()
+
+	au := authenticator{ds: NewMockDatastore(gomock.NewController(t))}
+
+	err := au.authorize(context.Background(), "/grpc.health.v1.Health/Check")
+	assert.NoError(t, err)
+}
@@ -58,6 +58,67 @@ func (mr *MockDatastoreMockRecorder) AddFeed(ctx, feedURL, title, desc, tags, is
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFeed", reflect.TypeOf((*MockDatastore)(nil).AddFeed), ctx, feedURL, title, desc, tags, isStarred, pullTimeout)
 }
 
+// AuthenticateToken mocks base method.
+func (m *MockDatastore) AuthenticateToken(ctx context.Context, secret string) (*entity.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateToken", ctx, secret)
+	ret0, _ := ret[0].(*entity.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateToken indicates an expected call of AuthenticateToken.
+func (mr *MockDatastoreMockRecorder) AuthenticateToken(ctx, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateToken", reflect.TypeOf((*MockDatastore)(nil).AuthenticateToken), ctx, secret)
+}
+
+// CreateFolder mocks base method.
+func (m *MockDatastore) CreateFolder(ctx context.Context, name string, parentID *entity.ID) (*entity.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", ctx, name, parentID)
+	ret0, _ := ret[0].(*entity.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockDatastoreMockRecorder) CreateFolder(ctx, name, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockDatastore)(nil).CreateFolder), ctx, name, parentID)
+}
+
+// CreateRule mocks base method.
+func (m *MockDatastore) CreateRule(ctx context.Context, rule *entity.Rule) (*entity.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", ctx, rule)
+	ret0, _ := ret[0].(*entity.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockDatastoreMockRecorder) CreateRule(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockDatastore)(nil).CreateRule), ctx, rule)
+}
+
+// CreateToken mocks base method.
+func (m *MockDatastore) CreateToken(ctx context.Context, name string, scope entity.TokenScope) (*entity.Token, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", ctx, name, scope)
+	ret0, _ := ret[0].(*entity.Token)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockDatastoreMockRecorder) CreateToken(ctx, name, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockDatastore)(nil).CreateToken), ctx, name, scope)
+}
+
 // DeleteFeeds mocks base method.
 func (m *MockDatastore) DeleteFeeds(ctx context.Context, ids []entity.ID) error {
 	m.ctrl.T.Helper()
@@ -72,6 +133,63 @@ func (mr *MockDatastoreMockRecorder) DeleteFeeds(ctx, ids any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeds", reflect.TypeOf((*MockDatastore)(nil).DeleteFeeds), ctx, ids)
 }
 
+// DeleteFolders mocks base method.
+func (m *MockDatastore) DeleteFolders(ctx context.Context, ids []entity.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolders", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFolders indicates an expected call of DeleteFolders.
+func (mr *MockDatastoreMockRecorder) DeleteFolders(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolders", reflect.TypeOf((*MockDatastore)(nil).DeleteFolders), ctx, ids)
+}
+
+// DeleteRetentionPolicy mocks base method.
+func (m *MockDatastore) DeleteRetentionPolicy(ctx context.Context, feedID *entity.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetentionPolicy", ctx, feedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRetentionPolicy indicates an expected call of DeleteRetentionPolicy.
+func (mr *MockDatastoreMockRecorder) DeleteRetentionPolicy(ctx, feedID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetentionPolicy", reflect.TypeOf((*MockDatastore)(nil).DeleteRetentionPolicy), ctx, feedID)
+}
+
+// DeleteRules mocks base method.
+func (m *MockDatastore) DeleteRules(ctx context.Context, ids []entity.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRules", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRules indicates an expected call of DeleteRules.
+func (mr *MockDatastoreMockRecorder) DeleteRules(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRules", reflect.TypeOf((*MockDatastore)(nil).DeleteRules), ctx, ids)
+}
+
+// DiscoverFeeds mocks base method.
+func (m *MockDatastore) DiscoverFeeds(ctx context.Context, pageURL string, timeout *time.Duration) ([]*entity.FeedCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverFeeds", ctx, pageURL, timeout)
+	ret0, _ := ret[0].([]*entity.FeedCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverFeeds indicates an expected call of DiscoverFeeds.
+func (mr *MockDatastoreMockRecorder) DiscoverFeeds(ctx, pageURL, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverFeeds", reflect.TypeOf((*MockDatastore)(nil).DiscoverFeeds), ctx, pageURL, timeout)
+}
+
 // EditEntries mocks base method.
 func (m *MockDatastore) EditEntries(ctx context.Context, ops []*entity.EntryEditOp) ([]*entity.Entry, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +220,21 @@ func (mr *MockDatastoreMockRecorder) EditFeeds(ctx, ops any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFeeds", reflect.TypeOf((*MockDatastore)(nil).EditFeeds), ctx, ops)
 }
 
+// EditFolders mocks base method.
+func (m *MockDatastore) EditFolders(ctx context.Context, ops []*entity.FolderEditOp) ([]*entity.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditFolders", ctx, ops)
+	ret0, _ := ret[0].([]*entity.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditFolders indicates an expected call of EditFolders.
+func (mr *MockDatastoreMockRecorder) EditFolders(ctx, ops any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFolders", reflect.TypeOf((*MockDatastore)(nil).EditFolders), ctx, ops)
+}
+
 // ExportSubscription mocks base method.
 func (m *MockDatastore) ExportSubscription(ctx context.Context, title *string) (*entity.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +250,21 @@ func (mr *MockDatastoreMockRecorder) ExportSubscription(ctx, title any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSubscription", reflect.TypeOf((*MockDatastore)(nil).ExportSubscription), ctx, title)
 }
 
+// FetchEntryContent mocks base method.
+func (m *MockDatastore) FetchEntryContent(ctx context.Context, id entity.ID, timeout *time.Duration) (*entity.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchEntryContent", ctx, id, timeout)
+	ret0, _ := ret[0].(*entity.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchEntryContent indicates an expected call of FetchEntryContent.
+func (mr *MockDatastoreMockRecorder) FetchEntryContent(ctx, id, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchEntryContent", reflect.TypeOf((*MockDatastore)(nil).FetchEntryContent), ctx, id, timeout)
+}
+
 // GetEntry mocks base method.
 func (m *MockDatastore) GetEntry(ctx context.Context, id entity.ID) (*entity.Entry, error) {
 	m.ctrl.T.Helper()
@@ -164,47 +312,196 @@ func (mr *MockDatastoreMockRecorder) ImportSubscription(ctx, sub any) *gomock.Ca
 }
 
 // ListEntries mocks base method.
-func (m *MockDatastore) ListEntries(ctx context.Context, feedIDs []entity.ID, isBookmarked *bool) ([]*entity.Entry, error) {
+func (m *MockDatastore) ListEntries(ctx context.Context, filter *entity.EntryFilter, order entity.EntrySortOrder, page *entity.Page) ([]*entity.Entry, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", ctx, feedIDs, isBookmarked)
+	ret := m.ctrl.Call(m, "ListEntries", ctx, filter, order, page)
 	ret0, _ := ret[0].([]*entity.Entry)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ListEntries indicates an expected call of ListEntries.
-func (mr *MockDatastoreMockRecorder) ListEntries(ctx, feedIDs, isBookmarked any) *gomock.Call {
+func (mr *MockDatastoreMockRecorder) ListEntries(ctx, filter, order, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockDatastore)(nil).ListEntries), ctx, feedIDs, isBookmarked)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockDatastore)(nil).ListEntries), ctx, filter, order, page)
 }
 
 // ListFeeds mocks base method.
-func (m *MockDatastore) ListFeeds(ctx context.Context, maxEntriesPerFeed *uint32) ([]*entity.Feed, error) {
+func (m *MockDatastore) ListFeeds(ctx context.Context, maxEntriesPerFeed *uint32, filter *entity.FeedFilter, order entity.FeedSortOrder, page *entity.Page) ([]*entity.Feed, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListFeeds", ctx, maxEntriesPerFeed)
+	ret := m.ctrl.Call(m, "ListFeeds", ctx, maxEntriesPerFeed, filter, order, page)
 	ret0, _ := ret[0].([]*entity.Feed)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFeeds indicates an expected call of ListFeeds.
+func (mr *MockDatastoreMockRecorder) ListFeeds(ctx, maxEntriesPerFeed, filter, order, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeds", reflect.TypeOf((*MockDatastore)(nil).ListFeeds), ctx, maxEntriesPerFeed, filter, order, page)
+}
+
+// ListFolders mocks base method.
+func (m *MockDatastore) ListFolders(ctx context.Context) ([]*entity.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFolders", ctx)
+	ret0, _ := ret[0].([]*entity.Folder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFeeds indicates an expected call of ListFeeds.
-func (mr *MockDatastoreMockRecorder) ListFeeds(ctx, maxEntriesPerFeed any) *gomock.Call {
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockDatastoreMockRecorder) ListFolders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockDatastore)(nil).ListFolders), ctx)
+}
+
+// ListRetentionPolicies mocks base method.
+func (m *MockDatastore) ListRetentionPolicies(ctx context.Context) ([]*entity.RetentionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRetentionPolicies", ctx)
+	ret0, _ := ret[0].([]*entity.RetentionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRetentionPolicies indicates an expected call of ListRetentionPolicies.
+func (mr *MockDatastoreMockRecorder) ListRetentionPolicies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRetentionPolicies", reflect.TypeOf((*MockDatastore)(nil).ListRetentionPolicies), ctx)
+}
+
+// ListRules mocks base method.
+func (m *MockDatastore) ListRules(ctx context.Context) ([]*entity.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx)
+	ret0, _ := ret[0].([]*entity.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockDatastoreMockRecorder) ListRules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockDatastore)(nil).ListRules), ctx)
+}
+
+// ListTokens mocks base method.
+func (m *MockDatastore) ListTokens(ctx context.Context) ([]*entity.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTokens", ctx)
+	ret0, _ := ret[0].([]*entity.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTokens indicates an expected call of ListTokens.
+func (mr *MockDatastoreMockRecorder) ListTokens(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokens", reflect.TypeOf((*MockDatastore)(nil).ListTokens), ctx)
+}
+
+// MatchRule mocks base method.
+func (m *MockDatastore) MatchRule(ctx context.Context, rule *entity.Rule) ([]*entity.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchRule", ctx, rule)
+	ret0, _ := ret[0].([]*entity.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchRule indicates an expected call of MatchRule.
+func (mr *MockDatastoreMockRecorder) MatchRule(ctx, rule any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeds", reflect.TypeOf((*MockDatastore)(nil).ListFeeds), ctx, maxEntriesPerFeed)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchRule", reflect.TypeOf((*MockDatastore)(nil).MatchRule), ctx, rule)
+}
+
+// Optimize mocks base method.
+func (m *MockDatastore) Optimize(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Optimize", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Optimize indicates an expected call of Optimize.
+func (mr *MockDatastoreMockRecorder) Optimize(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Optimize", reflect.TypeOf((*MockDatastore)(nil).Optimize), ctx)
+}
+
+// PruneEntries mocks base method.
+func (m *MockDatastore) PruneEntries(ctx context.Context, feedIDs []entity.ID, dryRun bool) ([]*entity.PruneResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneEntries", ctx, feedIDs, dryRun)
+	ret0, _ := ret[0].([]*entity.PruneResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneEntries indicates an expected call of PruneEntries.
+func (mr *MockDatastoreMockRecorder) PruneEntries(ctx, feedIDs, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneEntries", reflect.TypeOf((*MockDatastore)(nil).PruneEntries), ctx, feedIDs, dryRun)
 }
 
 // PullFeeds mocks base method.
-func (m *MockDatastore) PullFeeds(ctx context.Context, ids []entity.ID, entryReadStatus *bool, maxEntriesPerFeed *uint32, timeoutPerFeed *time.Duration) <-chan entity.PullResult {
+func (m *MockDatastore) PullFeeds(ctx context.Context, ids []entity.ID, entryReadStatus *bool, maxEntriesPerFeed *uint32, timeoutPerFeed *time.Duration, force bool) <-chan entity.PullResult {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PullFeeds", ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed)
+	ret := m.ctrl.Call(m, "PullFeeds", ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed, force)
 	ret0, _ := ret[0].(<-chan entity.PullResult)
 	return ret0
 }
 
 // PullFeeds indicates an expected call of PullFeeds.
-func (mr *MockDatastoreMockRecorder) PullFeeds(ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed any) *gomock.Call {
+func (mr *MockDatastoreMockRecorder) PullFeeds(ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed, force any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFeeds", reflect.TypeOf((*MockDatastore)(nil).PullFeeds), ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed, force)
+}
+
+// RevokeToken mocks base method.
+func (m *MockDatastore) RevokeToken(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockDatastoreMockRecorder) RevokeToken(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockDatastore)(nil).RevokeToken), ctx, name)
+}
+
+// SearchEntries mocks base method.
+func (m *MockDatastore) SearchEntries(ctx context.Context, query string, feedIDs []entity.ID, maxResults *uint32) ([]*entity.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEntries", ctx, query, feedIDs, maxResults)
+	ret0, _ := ret[0].([]*entity.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEntries indicates an expected call of SearchEntries.
+func (mr *MockDatastoreMockRecorder) SearchEntries(ctx, query, feedIDs, maxResults any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEntries", reflect.TypeOf((*MockDatastore)(nil).SearchEntries), ctx, query, feedIDs, maxResults)
+}
+
+// SetRetentionPolicy mocks base method.
+func (m *MockDatastore) SetRetentionPolicy(ctx context.Context, policy *entity.RetentionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockDatastoreMockRecorder) SetRetentionPolicy(ctx, policy any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullFeeds", reflect.TypeOf((*MockDatastore)(nil).PullFeeds), ctx, ids, entryReadStatus, maxEntriesPerFeed, timeoutPerFeed)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockDatastore)(nil).SetRetentionPolicy), ctx, policy)
 }
 
 // MockeditableTable is a mock of editableTable interface.
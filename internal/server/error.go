@@ -39,10 +39,18 @@ func unwrapError(err error) (codes.Code, error) {
 		return codes.Unknown, nil
 	}
 	switch cerr := err.(type) {
-	case entity.FeedNotFoundError, entity.EntryNotFoundError:
+	case entity.FeedNotFoundError,
+		entity.EntryNotFoundError,
+		entity.FolderNotFoundError,
+		entity.RuleNotFoundError,
+		entity.TokenNotFoundError:
 		return codes.NotFound, cerr
-	case xml.UnmarshalError, *xml.SyntaxError:
+	case entity.InvalidArgumentError, xml.UnmarshalError, *xml.SyntaxError:
 		return codes.InvalidArgument, cerr
+	case entity.AmbiguousFeedURLError:
+		return codes.FailedPrecondition, cerr
+	case entity.InvalidTokenError:
+		return codes.Unauthenticated, cerr
 	default:
 		var (
 			ierr  error
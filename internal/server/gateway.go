@@ -0,0 +1,119 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/bow/neon/api"
+)
+
+const (
+	// openAPIPath is the HTTP path the OpenAPI document is served at.
+	openAPIPath = "/openapi.json"
+
+	// gatewayPipeSize is the buffer size of the in-memory connection between the gateway and
+	// its gRPC server.
+	gatewayPipeSize = 1024 * 1024
+
+	gatewayReadHeaderTimeout = 10 * time.Second
+)
+
+// gateway serves the HTTP/JSON interface of the service. It translates HTTP requests into calls
+// to its own gRPC server, which is reachable only through an in-memory connection and so does
+// not need the transport credentials of the main server.
+type gateway struct {
+	lis        net.Listener
+	httpServer *http.Server
+	grpcServer *grpc.Server
+	pipe       *bufconn.Listener
+	conn       *grpc.ClientConn
+}
+
+func newGateway(
+	ctx context.Context,
+	lis net.Listener,
+	tlsConfig *tls.Config,
+	sopts ...grpc.ServerOption,
+) (*gateway, error) {
+
+	pipe := bufconn.Listen(gatewayPipeSize)
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return pipe.DialContext(ctx)
+	}
+	conn, err := grpc.NewClient(
+		"passthrough:///gateway",
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	gwmux := runtime.NewServeMux()
+	if err = api.RegisterNeonHandler(ctx, gwmux, conn); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gwmux)
+	mux.HandleFunc("GET "+openAPIPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(api.OpenAPI())
+	})
+
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+
+	gw := gateway{
+		lis: lis,
+		httpServer: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: gatewayReadHeaderTimeout,
+		},
+		grpcServer: grpc.NewServer(sopts...),
+		pipe:       pipe,
+		conn:       conn,
+	}
+
+	return &gw, nil
+}
+
+// start serves the gateway in the background.
+func (gw *gateway) start() {
+	go func() {
+		if err := gw.grpcServer.Serve(gw.pipe); err != nil {
+			pkgLogger.Error().Err(err).Msg("gateway gRPC server failed")
+		}
+	}()
+	go func() {
+		err := gw.httpServer.Serve(gw.lis)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			pkgLogger.Error().Err(err).Msg("gateway server failed")
+		}
+	}()
+	pkgLogger.Info().Str("addr", gw.lis.Addr().String()).Msgf("gateway listening")
+}
+
+// stop closes the gateway, including any ongoing streaming calls.
+func (gw *gateway) stop() {
+	if err := gw.httpServer.Close(); err != nil {
+		pkgLogger.Error().Err(err).Msg("failed to stop gateway server")
+	}
+	if err := gw.conn.Close(); err != nil {
+		pkgLogger.Error().Err(err).Msg("failed to close gateway connection")
+	}
+	gw.grpcServer.Stop()
+}
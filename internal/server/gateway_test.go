@@ -0,0 +1,217 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// setupGatewayTest creates a server with the gateway enabled and returns the base URL of the
+// gateway together with the mocked datastore.
+func setupGatewayTest(t *testing.T, requireAuth bool) (string, *MockDatastore) {
+	t.Helper()
+
+	ds := NewMockDatastore(gomock.NewController(t))
+	b := defaultTestServerBuilder(t).
+		Datastore(ds).
+		RequireAuth(requireAuth).
+		GatewayAddress("127.0.0.1:0")
+	srv := newTestServer(t, b)
+	t.Cleanup(srv.Stop)
+
+	return fmt.Sprintf("http://%s", srv.GatewayAddr()), ds
+}
+
+// gatewayCall sends an HTTP request to the gateway and returns the response status code and
+// body.
+func gatewayCall(t *testing.T, method, url, token, body string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rsp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+
+	raw, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+
+	return rsp.StatusCode, string(raw)
+}
+
+func TestServerGatewayDisabled(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, defaultTestServerBuilder(t))
+	t.Cleanup(srv.Stop)
+
+	assert.Nil(t, srv.GatewayAddr())
+}
+
+func TestGatewayListFeedsOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	baseURL, ds := setupGatewayTest(t, false)
+
+	ds.EXPECT().
+		ListFeeds(
+			gomock.Any(),
+			nil,
+			&entity.FeedFilter{IDs: []entity.ID{2, 3}, Tags: []string{"news"}, IsStarred: pointer(true)},
+			entity.FeedSortTitleAsc,
+			&entity.Page{Size: 1, Token: "abc"},
+		).
+		Return([]*entity.Feed{{ID: 3, Title: "Feed X"}}, "def", nil)
+
+	code, body := gatewayCall(
+		t,
+		http.MethodGet,
+		baseURL+"/v1/feeds?feedIds=2&feedIds=3&tags=news&isStarred=true"+
+			"&sortOrder=SORT_ORDER_TITLE_ASC&pageSize=1&pageToken=abc",
+		"",
+		"",
+	)
+	r.Equal(http.StatusOK, code, body)
+
+	var rsp struct {
+		Feeds []struct {
+			ID    uint32 `json:"id"`
+			Title string `json:"title"`
+		} `json:"feeds"`
+		NextPageToken string `json:"nextPageToken"`
+	}
+	r.NoError(json.Unmarshal([]byte(body), &rsp))
+	r.Len(rsp.Feeds, 1)
+	a.Equal(uint32(3), rsp.Feeds[0].ID)
+	a.Equal("Feed X", rsp.Feeds[0].Title)
+	a.Equal("def", rsp.NextPageToken)
+}
+
+func TestGatewayGetEntryErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	baseURL, ds := setupGatewayTest(t, false)
+
+	ds.EXPECT().
+		GetEntry(gomock.Any(), entity.ID(9)).
+		Return(nil, entity.EntryNotFoundError{ID: entity.ID(9)})
+
+	code, body := gatewayCall(t, http.MethodGet, baseURL+"/v1/entries/9", "", "")
+	a.Equal(http.StatusNotFound, code)
+	a.Contains(body, "entry with ID=9 not found")
+}
+
+func TestGatewayPullFeedsOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	baseURL, ds := setupGatewayTest(t, false)
+
+	prs := []entity.PullResult{
+		entity.NewPullResultFromFeed(
+			pointer("http://a.com/feed.xml"),
+			&entity.Feed{ID: 1, Title: "Feed A", FeedURL: "http://a.com/feed.xml"},
+		),
+		entity.NewPullResultFromFeed(
+			pointer("http://b.com/feed.xml"),
+			&entity.Feed{ID: 2, Title: "Feed B", FeedURL: "http://b.com/feed.xml"},
+		),
+	}
+	ch := make(chan entity.PullResult, len(prs))
+	for _, pr := range prs {
+		ch <- pr
+	}
+	close(ch)
+
+	ds.EXPECT().
+		PullFeeds(gomock.Any(), []entity.ID{1, 2}, nil, nil, nil, true).
+		Return(ch)
+
+	code, body := gatewayCall(
+		t,
+		http.MethodPost,
+		baseURL+"/v1/feeds:pull",
+		"",
+		`{"feedIds": [1, 2], "force": true}`,
+	)
+	r.Equal(http.StatusOK, code, body)
+
+	// Streamed responses are sent as newline-delimited JSON objects.
+	urls := make([]string, 0)
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		var line struct {
+			Result struct {
+				URL string `json:"url"`
+			} `json:"result"`
+		}
+		r.NoError(json.Unmarshal(scanner.Bytes(), &line))
+		urls = append(urls, line.Result.URL)
+	}
+	r.NoError(scanner.Err())
+	a.Equal([]string{"http://a.com/feed.xml", "http://b.com/feed.xml"}, urls)
+}
+
+func TestGatewayAuthOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	baseURL, ds := setupGatewayTest(t, true)
+
+	ds.EXPECT().
+		AuthenticateToken(gomock.Any(), "neon_reader").
+		Return(&entity.Token{ID: 1, Name: "reader", Scope: entity.TokenScopeRead}, nil)
+
+	code, body := gatewayCall(t, http.MethodGet, baseURL+"/v1/info", "neon_reader", "")
+	a.Equal(http.StatusOK, code)
+	a.Contains(body, `"name":"neon"`)
+}
+
+func TestGatewayAuthErrNoToken(t *testing.T) {
+	t.Parallel()
+
+	baseURL, _ := setupGatewayTest(t, true)
+
+	code, _ := gatewayCall(t, http.MethodGet, baseURL+"/v1/info", "", "")
+	assert.Equal(t, http.StatusUnauthorized, code)
+}
+
+func TestGatewayOpenAPIOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	baseURL, _ := setupGatewayTest(t, false)
+
+	code, body := gatewayCall(t, http.MethodGet, baseURL+openAPIPath, "", "")
+	r.Equal(http.StatusOK, code)
+
+	var doc struct {
+		Swagger string                    `json:"swagger"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	r.NoError(json.Unmarshal([]byte(body), &doc))
+	a.Equal("2.0", doc.Swagger)
+	a.Contains(doc.Paths, "/v1/feeds")
+	a.Contains(doc.Paths["/v1/feeds:pull"], "post")
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+// subscriptionBufferSize is the number of entries a subscriber may lag behind before it is
+// dropped.
+const subscriptionBufferSize = 256
+
+var errSubscriberTooSlow = status.Error(
+	codes.ResourceExhausted,
+	"entry stream dropped: client is not keeping up",
+)
+
+// hub fans out newly added or updated entries to subscribed entry streams.
+type hub struct {
+	mu     sync.Mutex
+	subs   map[*subscription]struct{}
+	closed bool
+}
+
+func newHub() *hub {
+	return &hub{subs: make(map[*subscription]struct{})}
+}
+
+// subscriptionFilter selects the entries sent to a subscription. Empty fields do not restrict.
+type subscriptionFilter struct {
+	feedIDs    []entity.ID
+	tags       []string
+	unreadOnly bool
+}
+
+type subscription struct {
+	filter subscriptionFilter
+	ch     chan *entity.Entry
+	// err is set before ch is closed when the subscription was dropped by the hub.
+	err error
+}
+
+// entries returns the channel of matching entries. It is closed when the subscription ends.
+func (sub *subscription) entries() <-chan *entity.Entry {
+	return sub.ch
+}
+
+// matchesFeed checks whether entries of the given feed may be sent to the subscription.
+func (sub *subscription) matchesFeed(feed *entity.Feed) bool {
+	f := sub.filter
+	if len(f.feedIDs) > 0 && !slices.Contains(f.feedIDs, feed.ID) {
+		return false
+	}
+	if len(f.tags) > 0 {
+		for _, tag := range feed.Tags {
+			if slices.Contains(f.tags, tag) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// subscribe registers a new subscription. Subscriptions to a closed hub end immediately.
+func (h *hub) subscribe(filter subscriptionFilter) *subscription {
+	sub := subscription{filter: filter, ch: make(chan *entity.Entry, subscriptionBufferSize)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(sub.ch)
+		return &sub
+	}
+	h.subs[&sub] = struct{}{}
+
+	return &sub
+}
+
+// unsubscribe removes the given subscription and closes its channel.
+func (h *hub) unsubscribe(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.drop(sub, nil)
+}
+
+// publish sends the given entries of the given feed to all matching subscriptions. It never
+// blocks; subscriptions whose buffers are full are dropped.
+func (h *hub) publish(feed *entity.Feed, entries []*entity.Entry) {
+	if h == nil || feed == nil || len(entries) == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		if !sub.matchesFeed(feed) {
+			continue
+		}
+		for _, entry := range entries {
+			if sub.filter.unreadOnly && entry.IsRead {
+				continue
+			}
+			select {
+			case sub.ch <- entry:
+			default:
+				h.drop(sub, errSubscriberTooSlow)
+			}
+			if sub.err != nil {
+				break
+			}
+		}
+	}
+}
+
+// close ends all subscriptions. It must be called before the gRPC server is stopped
+// gracefully, as open entry streams would otherwise block the stop.
+func (h *hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		h.drop(sub, nil)
+	}
+	h.closed = true
+}
+
+// drop removes the given subscription. It must be called with the lock held.
+func (h *hub) drop(sub *subscription, err error) {
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	sub.err = err
+	close(sub.ch)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestHubPublishFilters(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	h := newHub()
+
+	var (
+		all    = h.subscribe(subscriptionFilter{})
+		byID   = h.subscribe(subscriptionFilter{feedIDs: []entity.ID{2}})
+		byTag  = h.subscribe(subscriptionFilter{tags: []string{"news", "tech"}})
+		unread = h.subscribe(subscriptionFilter{unreadOnly: true})
+	)
+
+	h.publish(
+		&entity.Feed{ID: 1, Tags: []string{"tech"}},
+		[]*entity.Entry{{ID: 10, FeedID: 1}, {ID: 11, FeedID: 1, IsRead: true}},
+	)
+	h.publish(
+		&entity.Feed{ID: 2},
+		[]*entity.Entry{{ID: 20, FeedID: 2}},
+	)
+	h.close()
+
+	a.Equal([]entity.ID{10, 11, 20}, receivedIDs(all))
+	a.Equal([]entity.ID{20}, receivedIDs(byID))
+	a.Equal([]entity.ID{10, 11}, receivedIDs(byTag))
+	a.Equal([]entity.ID{10, 20}, receivedIDs(unread))
+
+	for _, sub := range []*subscription{all, byID, byTag, unread} {
+		a.NoError(sub.err)
+	}
+}
+
+func TestHubPublishDropsSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	h := newHub()
+
+	slow := h.subscribe(subscriptionFilter{})
+
+	entries := make([]*entity.Entry, subscriptionBufferSize+1)
+	for i := range entries {
+		entries[i] = &entity.Entry{ID: entity.ID(i), FeedID: 1}
+	}
+	h.publish(&entity.Feed{ID: 1}, entries)
+
+	a.Len(receivedIDs(slow), subscriptionBufferSize)
+	a.ErrorIs(slow.err, errSubscriberTooSlow)
+
+	// Publishing after the drop must not panic on the closed channel.
+	h.publish(&entity.Feed{ID: 1}, entries[:1])
+}
+
+func TestHubUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	h := newHub()
+
+	sub := h.subscribe(subscriptionFilter{})
+	h.unsubscribe(sub)
+	h.publish(&entity.Feed{ID: 1}, []*entity.Entry{{ID: 1, FeedID: 1}})
+
+	a.Empty(receivedIDs(sub))
+	a.NoError(sub.err)
+
+	// Unsubscribing twice and closing afterwards must not panic.
+	h.unsubscribe(sub)
+	h.close()
+}
+
+func TestHubSubscribeAfterClose(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	h := newHub()
+	h.close()
+
+	sub := h.subscribe(subscriptionFilter{})
+	_, ok := <-sub.entries()
+	r.False(ok)
+}
+
+func receivedIDs(sub *subscription) []entity.ID {
+	ids := make([]entity.ID, 0)
+	for entry := range sub.entries() {
+		ids = append(ids, entry.ID)
+	}
+	return ids
+}
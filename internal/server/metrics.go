@@ -0,0 +1,244 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
+)
+
+const (
+	metricsNamespace = "neon"
+
+	// metricsPath is the HTTP path the metrics are served at.
+	metricsPath = "/metrics"
+
+	// statsCollectTimeout is the maximum time spent querying the datastore statistics on each
+	// scrape.
+	statsCollectTimeout = 5 * time.Second
+)
+
+// metrics holds the Prometheus collectors of a server. A nil *metrics records nothing, so
+// callers do not need to check whether metrics are enabled.
+type metrics struct {
+	registry *prometheus.Registry
+
+	grpcRequests  *prometheus.CounterVec
+	grpcDurations *prometheus.HistogramVec
+	pulls         *prometheus.CounterVec
+	pullDurations *prometheus.HistogramVec
+	txDurations   *prometheus.HistogramVec
+}
+
+func newMetrics(ds datastore.Datastore) *metrics {
+	m := metrics{
+		registry: prometheus.NewRegistry(),
+		grpcRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "grpc",
+				Name:      "requests_total",
+				Help:      "Number of completed gRPC calls.",
+			},
+			[]string{"method", "code"},
+		),
+		grpcDurations: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "grpc",
+				Name:      "request_duration_seconds",
+				Help:      "Duration of completed gRPC calls.",
+				Buckets:   prometheus.DefBuckets,
+			},
+			[]string{"method"},
+		),
+		pulls: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "feed",
+				Name:      "pulls_total",
+				Help:      "Number of feed pulls, by outcome.",
+			},
+			[]string{"feed_id", "status"},
+		),
+		pullDurations: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "feed",
+				Name:      "pull_duration_seconds",
+				Help:      "Duration of feed pulls.",
+				Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+			},
+			[]string{"feed_id"},
+		),
+		txDurations: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Subsystem: "datastore",
+				Name:      "transaction_duration_seconds",
+				Help:      "Duration of datastore transactions, by outcome.",
+				Buckets:   prometheus.DefBuckets,
+			},
+			[]string{"outcome"},
+		),
+	}
+
+	m.registry.MustRegister(
+		m.grpcRequests,
+		m.grpcDurations,
+		m.pulls,
+		m.pullDurations,
+		m.txDurations,
+		newStatsCollector(ds),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return &m
+}
+
+// handler returns the HTTP handler serving the metrics.
+func (m *metrics) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	return mux
+}
+
+func (m *metrics) unaryServerInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	start := time.Now()
+	rsp, err := handler(ctx, req)
+	m.observeCall(info.FullMethod, time.Since(start), err)
+	return rsp, err
+}
+
+func (m *metrics) streamServerInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observeCall(info.FullMethod, time.Since(start), err)
+	return err
+}
+
+func (m *metrics) observeCall(method string, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.grpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.grpcDurations.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// observePull records the outcome of a single feed pull. Results not tied to a feed are ignored.
+func (m *metrics) observePull(pr entity.PullResult) {
+	if m == nil {
+		return
+	}
+	id := pr.FeedID()
+	if id == 0 {
+		return
+	}
+	// Feed IDs are used instead of URLs, which change when feeds move.
+	label := strconv.FormatUint(uint64(id), 10)
+	st := pr.Status()
+	m.pulls.WithLabelValues(label, strings.ReplaceAll(st.String(), " ", "_")).Inc()
+	if st != entity.PullSkipped {
+		m.pullDurations.WithLabelValues(label).Observe(pr.Duration().Seconds())
+	}
+}
+
+func (m *metrics) observeTx(duration time.Duration, err error) {
+	outcome := "commit"
+	if err != nil {
+		outcome = "rollback"
+	}
+	m.txDurations.WithLabelValues(outcome).Observe(duration.Seconds())
+}
+
+// statsCollector exposes the global datastore statistics as gauges, queried on each scrape.
+type statsCollector struct {
+	ds datastore.Datastore
+
+	numFeeds             *prometheus.Desc
+	numEntries           *prometheus.Desc
+	numEntriesUnread     *prometheus.Desc
+	lastPullTime         *prometheus.Desc
+	mostRecentUpdateTime *prometheus.Desc
+}
+
+// Ensure statsCollector implements prometheus.Collector.
+var _ prometheus.Collector = new(statsCollector)
+
+func newStatsCollector(ds datastore.Datastore) *statsCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		fqName := prometheus.BuildFQName(metricsNamespace, "", name)
+		return prometheus.NewDesc(fqName, help, nil, nil)
+	}
+	return &statsCollector{
+		ds:               ds,
+		numFeeds:         desc("feeds", "Number of feeds."),
+		numEntries:       desc("entries", "Number of entries."),
+		numEntriesUnread: desc("entries_unread", "Number of unread entries."),
+		lastPullTime: desc(
+			"last_pull_timestamp_seconds",
+			"Time of the most recent feed pull.",
+		),
+		mostRecentUpdateTime: desc(
+			"most_recent_update_timestamp_seconds",
+			"Time of the most recent feed update.",
+		),
+	}
+}
+
+func (c *statsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.numFeeds
+	ch <- c.numEntries
+	ch <- c.numEntriesUnread
+	ch <- c.lastPullTime
+	ch <- c.mostRecentUpdateTime
+}
+
+func (c *statsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), statsCollectTimeout)
+	defer cancel()
+
+	stats, err := c.ds.GetGlobalStats(ctx)
+	if err != nil {
+		pkgLogger.Error().Err(err).Msg("failed to collect stats metrics")
+		ch <- prometheus.NewInvalidMetric(c.numFeeds, err)
+		return
+	}
+
+	gauge := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value)
+	}
+	gauge(c.numFeeds, float64(stats.NumFeeds))
+	gauge(c.numEntries, float64(stats.NumEntries))
+	gauge(c.numEntriesUnread, float64(stats.NumEntriesUnread))
+	if t := stats.LastPullTime; t != nil && !t.IsZero() {
+		gauge(c.lastPullTime, float64(t.Unix()))
+	}
+	if t := stats.MostRecentUpdateTime; t != nil && !t.IsZero() {
+		gauge(c.mostRecentUpdateTime, float64(t.Unix()))
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bow/neon/api"
+	"github.com/bow/neon/internal/entity"
+)
+
+// setupMetricsTest creates a server with metrics enabled and returns a client to it, the mocked
+// datastore, and a function fetching the exposed metrics.
+func setupMetricsTest(t *testing.T) (api.NeonClient, *MockDatastore, func() string) {
+	t.Helper()
+
+	ds := NewMockDatastore(gomock.NewController(t))
+	b := defaultTestServerBuilder(t).Datastore(ds).MetricsAddress("127.0.0.1:0")
+	srv := newTestServer(t, b)
+	client, conn := newTestClient(
+		t,
+		srv.Addr(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	t.Cleanup(
+		func() {
+			require.NoError(t, conn.Close())
+			srv.Stop()
+		},
+	)
+
+	scrape := func() string {
+		t.Helper()
+		url := fmt.Sprintf("http://%s%s", srv.MetricsAddr(), metricsPath)
+		rsp, err := http.Get(url) // #nosec: G107
+		require.NoError(t, err)
+		defer rsp.Body.Close()
+		require.Equal(t, http.StatusOK, rsp.StatusCode)
+		body, err := io.ReadAll(rsp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	return client, ds, scrape
+}
+
+func TestServerMetricsDisabled(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, defaultTestServerBuilder(t))
+	t.Cleanup(srv.Stop)
+
+	assert.Nil(t, srv.MetricsAddr())
+}
+
+func TestServerMetricsCallsOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds, scrape := setupMetricsTest(t)
+
+	ds.EXPECT().
+		GetGlobalStats(gomock.Any()).
+		Return(nil, errors.New("stats failed"))
+	_, err := client.GetStats(context.Background(), &api.GetStatsRequest{})
+	r.Error(err)
+
+	ds.EXPECT().
+		GetGlobalStats(gomock.Any()).
+		Return(
+			&entity.Stats{
+				NumFeeds:         3,
+				NumEntries:       10,
+				NumEntriesUnread: 4,
+				LastPullTime:     pointer(time.Unix(1700000000, 0)),
+			},
+			nil,
+		)
+	body := scrape()
+
+	a.Contains(
+		body,
+		`neon_grpc_requests_total{code="Unknown",method="/neon.Neon/GetStats"} 1`,
+	)
+	a.Contains(body, `neon_grpc_request_duration_seconds_count{method="/neon.Neon/GetStats"} 1`)
+	a.Contains(body, "neon_feeds 3\n")
+	a.Contains(body, "neon_entries 10\n")
+	a.Contains(body, "neon_entries_unread 4\n")
+	a.Contains(body, "neon_last_pull_timestamp_seconds 1.7e+09\n")
+	a.NotContains(body, "neon_most_recent_update_timestamp_seconds ")
+}
+
+func TestServerMetricsPullsOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds, scrape := setupMetricsTest(t)
+
+	okPull := entity.NewPullResultFromFeed(pointer("http://a.com/feed.xml"), nil)
+	okPull.SetFeedID(1)
+	okPull.SetDuration(2 * time.Second)
+	// Pulls of a feed that moved are counted with its earlier pulls.
+	movedPull := entity.NewPullResultFromFeed(pointer("https://a.com/feed.xml"), nil)
+	movedPull.SetFeedID(1)
+	movedPull.SetDuration(time.Second)
+	failPull := entity.NewPullResultFromError(
+		pointer("http://b.com/feed.xml"),
+		errors.New("timed out"),
+	)
+	failPull.SetFeedID(2)
+	failPull.SetDuration(30 * time.Second)
+	notModifiedPull := entity.NewPullResultNotModified(pointer("http://c.com/feed.xml"))
+	notModifiedPull.SetFeedID(3)
+	skippedPull := entity.NewPullResultSkipped(pointer("http://d.com/feed.xml"))
+	skippedPull.SetFeedID(4)
+	prs := []entity.PullResult{okPull, movedPull, failPull, notModifiedPull, skippedPull}
+
+	ch := make(chan entity.PullResult, len(prs))
+	for _, pr := range prs {
+		ch <- pr
+	}
+	close(ch)
+
+	ds.EXPECT().
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
+		Return(ch)
+	ds.EXPECT().
+		GetGlobalStats(gomock.Any()).
+		Return(&entity.Stats{}, nil)
+
+	stream, err := client.PullFeeds(context.Background(), &api.PullFeedsRequest{})
+	r.NoError(err)
+	for {
+		_, err = stream.Recv()
+		if err != nil {
+			break
+		}
+	}
+	r.ErrorIs(err, io.EOF)
+
+	body := scrape()
+
+	for _, line := range []string{
+		`neon_feed_pulls_total{feed_id="1",status="success"} 2`,
+		`neon_feed_pulls_total{feed_id="2",status="fail"} 1`,
+		`neon_feed_pulls_total{feed_id="3",status="not_modified"} 1`,
+		`neon_feed_pulls_total{feed_id="4",status="skipped"} 1`,
+		`neon_feed_pull_duration_seconds_sum{feed_id="1"} 3`,
+		`neon_feed_pull_duration_seconds_sum{feed_id="2"} 30`,
+		`neon_grpc_requests_total{code="OK",method="/neon.Neon/PullFeeds"} 1`,
+	} {
+		a.Contains(body, line)
+	}
+	a.NotContains(body, "feed_url")
+	a.NotContains(body, `neon_feed_pull_duration_seconds_count{feed_id="4"}`)
+}
+
+func TestMetricsObserveTx(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	m := newMetrics(NewMockDatastore(gomock.NewController(t)))
+
+	m.observeTx(time.Second, nil)
+	m.observeTx(time.Second, nil)
+	m.observeTx(time.Second, errors.New("constraint failed"))
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(m.txDurations)
+	families, err := reg.Gather()
+	r.NoError(err)
+	r.Len(families, 1)
+
+	counts := make(map[string]uint64)
+	for _, metric := range families[0].GetMetric() {
+		counts[metric.GetLabel()[0].GetValue()] = metric.GetHistogram().GetSampleCount()
+	}
+	r.Equal(map[string]uint64{"commit": 2, "rollback": 1}, counts)
+}
+
+func TestMetricsNilNoop(t *testing.T) {
+	t.Parallel()
+
+	var m *metrics
+	assert.NotPanics(t, func() {
+		m.observeCall("/neon.Neon/GetInfo", time.Second, nil)
+		m.observePull(entity.NewPullResultFromFeed(pointer("http://a.com/feed.xml"), nil))
+	})
+}
@@ -4,80 +4,147 @@
 package server
 
 import (
-	"time"
-
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bow/neon/api"
 	"github.com/bow/neon/internal/entity"
 )
 
-func toFeedPb(feed *entity.Feed) *api.Feed {
-	return &api.Feed{
-		Id:           feed.ID,
-		Title:        feed.Title,
-		FeedUrl:      feed.FeedURL,
-		SiteUrl:      feed.SiteURL,
-		Tags:         feed.Tags,
-		Description:  feed.Description,
-		IsStarred:    feed.IsStarred,
-		SubTime:      timestamppb.New(feed.Subscribed),
-		LastPullTime: timestamppb.New(feed.LastPulled),
-		UpdateTime:   toTimestampPb(feed.Updated),
-		Entries:      toEntryPbs(feed.EntriesSlice()),
+func fromFeedEditOpPb(pb *api.EditFeedsRequest_Op) *entity.FeedEditOp {
+	return &entity.FeedEditOp{
+		ID:           pb.Id,
+		Title:        pb.Fields.Title,
+		Description:  pb.Fields.Description,
+		Tags:         &pb.Fields.Tags,
+		IsStarred:    pb.Fields.IsStarred,
+		FolderID:     pb.Fields.FolderId,
+		FetchContent: pb.Fields.FetchContent,
 	}
 }
 
-func toFeedPbs(feeds []*entity.Feed) []*api.Feed {
-	pbs := make([]*api.Feed, len(feeds))
-	for i, feed := range feeds {
-		pbs[i] = toFeedPb(feed)
+func fromFeedEditOpPbs(pbs []*api.EditFeedsRequest_Op) []*entity.FeedEditOp {
+	ops := make([]*entity.FeedEditOp, len(pbs))
+	for i, pb := range pbs {
+		ops[i] = fromFeedEditOpPb(pb)
 	}
-	return pbs
+	return ops
 }
 
-func fromFeedEditOpPb(pb *api.EditFeedsRequest_Op) *entity.FeedEditOp {
-	return &entity.FeedEditOp{
-		ID:          pb.Id,
-		Title:       pb.Fields.Title,
-		Description: pb.Fields.Description,
-		Tags:        &pb.Fields.Tags,
-		IsStarred:   pb.Fields.IsStarred,
+func fromFolderEditOpPb(pb *api.EditFoldersRequest_Op) *entity.FolderEditOp {
+	return &entity.FolderEditOp{
+		ID:       pb.Id,
+		Name:     pb.Fields.Name,
+		ParentID: pb.Fields.ParentId,
 	}
 }
 
-func fromFeedEditOpPbs(pbs []*api.EditFeedsRequest_Op) []*entity.FeedEditOp {
-	ops := make([]*entity.FeedEditOp, len(pbs))
+func fromFolderEditOpPbs(pbs []*api.EditFoldersRequest_Op) []*entity.FolderEditOp {
+	ops := make([]*entity.FolderEditOp, len(pbs))
 	for i, pb := range pbs {
-		ops[i] = fromFeedEditOpPb(pb)
+		ops[i] = fromFolderEditOpPb(pb)
 	}
 	return ops
 }
 
-func toEntryPb(entry *entity.Entry) *api.Entry {
-	return &api.Entry{
-		Id:           entry.ID,
-		FeedId:       entry.FeedID,
-		Title:        entry.Title,
-		IsRead:       entry.IsRead,
-		IsBookmarked: entry.IsBookmarked,
-		ExtId:        entry.ExtID,
-		Description:  entry.Description,
-		Content:      entry.Content,
-		Url:          entry.URL,
-		PubTime:      toTimestampPb(entry.Published),
-		UpdateTime:   toTimestampPb(entry.Updated),
+func toRulePb(rule *entity.Rule) *api.Rule {
+	pb := api.Rule{
+		Id:         rule.ID,
+		FeedId:     rule.FeedID,
+		Pattern:    rule.Pattern,
+		CreateTime: timestamppb.New(rule.CreateTime),
+	}
+	if rule.Tag != "" {
+		pb.Tag = &rule.Tag
+	}
+
+	switch rule.Field {
+	case entity.RuleFieldTitle:
+		pb.Field = api.Rule_FIELD_TITLE
+	case entity.RuleFieldContent:
+		pb.Field = api.Rule_FIELD_CONTENT
+	case entity.RuleFieldAuthor:
+		pb.Field = api.Rule_FIELD_AUTHOR
+	case entity.RuleFieldURL:
+		pb.Field = api.Rule_FIELD_URL
+	}
+
+	switch rule.MatchType {
+	case entity.RuleMatchSubstring:
+		pb.MatchType = api.Rule_MATCH_TYPE_SUBSTRING
+	case entity.RuleMatchRegex:
+		pb.MatchType = api.Rule_MATCH_TYPE_REGEX
 	}
+
+	switch rule.Action {
+	case entity.RuleActionMarkRead:
+		pb.Action = api.Rule_ACTION_MARK_READ
+	case entity.RuleActionBookmark:
+		pb.Action = api.Rule_ACTION_BOOKMARK
+	case entity.RuleActionTag:
+		pb.Action = api.Rule_ACTION_TAG
+	case entity.RuleActionDrop:
+		pb.Action = api.Rule_ACTION_DROP
+	}
+
+	return &pb
 }
 
-func toEntryPbs(entries []*entity.Entry) []*api.Entry {
-	pbs := make([]*api.Entry, len(entries))
-	for i, entry := range entries {
-		pbs[i] = toEntryPb(entry)
+func toRulePbs(rules []*entity.Rule) []*api.Rule {
+	pbs := make([]*api.Rule, len(rules))
+	for i, rule := range rules {
+		pbs[i] = toRulePb(rule)
 	}
 	return pbs
 }
 
+func toPullStatusPb(status entity.PullStatus) api.PullFeedsResponse_PullStatus {
+	switch status {
+	case entity.PullSuccess:
+		return api.PullFeedsResponse_PULL_STATUS_SUCCESS
+	case entity.PullFail:
+		return api.PullFeedsResponse_PULL_STATUS_FAIL
+	case entity.PullNotModified:
+		return api.PullFeedsResponse_PULL_STATUS_NOT_MODIFIED
+	case entity.PullSkipped:
+		return api.PullFeedsResponse_PULL_STATUS_SKIPPED
+	default:
+		return api.PullFeedsResponse_PULL_STATUS_UNSPECIFIED
+	}
+}
+
+func fromListFeedsRequestPb(req *api.ListFeedsRequest) *entity.FeedFilter {
+	return &entity.FeedFilter{
+		IDs:       req.GetFeedIds(),
+		Tags:      req.GetTags(),
+		IsStarred: req.IsStarred,
+	}
+}
+
+func fromListEntriesRequestPb(req *api.ListEntriesRequest) *entity.EntryFilter {
+	return &entity.EntryFilter{
+		FeedIDs:         req.GetFeedIds(),
+		FeedTags:        req.GetFeedTags(),
+		IsFeedStarred:   req.IsFeedStarred,
+		IsRead:          req.IsRead,
+		IsBookmarked:    req.IsBookmarked,
+		PublishedAfter:  entity.FromTimestampPb(req.GetPublishedAfter()),
+		PublishedBefore: entity.FromTimestampPb(req.GetPublishedBefore()),
+		UpdatedAfter:    entity.FromTimestampPb(req.GetUpdatedAfter()),
+		UpdatedBefore:   entity.FromTimestampPb(req.GetUpdatedBefore()),
+		Authors:         req.GetAuthors(),
+		Tags:            req.GetTags(),
+		HasEnclosures:   req.HasEnclosures,
+	}
+}
+
+func fromStreamEntriesRequestPb(req *api.StreamEntriesRequest) subscriptionFilter {
+	return subscriptionFilter{
+		feedIDs:    req.GetFeedIds(),
+		tags:       req.GetTags(),
+		unreadOnly: req.GetUnreadOnly(),
+	}
+}
+
 func fromEntryEditOpPb(pb *api.EditEntriesRequest_Op) *entity.EntryEditOp {
 	return &entity.EntryEditOp{
 		ID:           pb.Id,
@@ -99,14 +166,28 @@ func toStatsPb(stats *entity.Stats) *api.GetStatsResponse_Stats {
 		NumFeeds:             stats.NumFeeds,
 		NumEntries:           stats.NumEntries,
 		NumEntriesUnread:     stats.NumEntriesUnread,
-		LastPullTime:         toTimestampPb(stats.LastPullTime),
-		MostRecentUpdateTime: toTimestampPb(stats.MostRecentUpdateTime),
+		LastPullTime:         entity.ToTimestampPb(stats.LastPullTime),
+		MostRecentUpdateTime: entity.ToTimestampPb(stats.MostRecentUpdateTime),
 	}
 }
 
-func toTimestampPb(v *time.Time) *timestamppb.Timestamp {
-	if v == nil {
-		return nil
+func toSchedulerStatsPb(stats *entity.SchedulerStats) *api.GetStatsResponse_Scheduler {
+	return &api.GetStatsResponse_Scheduler{
+		NumFeeds:       stats.NumFeeds,
+		NumPullsOk:     stats.NumPullsOK,
+		NumPullsFailed: stats.NumPullsFailed,
+		LastPullTime:   entity.ToTimestampPb(stats.LastPullTime),
+		NextPullTime:   entity.ToTimestampPb(stats.NextPullTime),
+		LastError:      stats.LastError,
 	}
-	return timestamppb.New(*v)
+}
+
+func toFeedCandidatePbs(
+	candidates []*entity.FeedCandidate,
+) []*api.DiscoverFeedsResponse_Candidate {
+	pbs := make([]*api.DiscoverFeedsResponse_Candidate, len(candidates))
+	for i, candidate := range candidates {
+		pbs[i] = &api.DiscoverFeedsResponse_Candidate{Url: candidate.URL, Title: candidate.Title}
+	}
+	return pbs
 }
@@ -0,0 +1,35 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+
+	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
+)
+
+// pruneAfterPull prunes entries of the given feeds, or of all feeds if none are given, according
+// to their retention policies. The datastore is optimized afterwards if any entries were pruned.
+// Errors are only logged, since they do not affect the preceding pull.
+func pruneAfterPull(ctx context.Context, ds datastore.Datastore, ids []entity.ID) {
+	results, err := ds.PruneEntries(ctx, ids, false)
+	if err != nil {
+		pkgLogger.Warn().Err(err).Msg("pruning after pull failed")
+		return
+	}
+
+	var numPruned uint32
+	for _, result := range results {
+		numPruned += result.NumPruned
+	}
+	if numPruned == 0 {
+		return
+	}
+	pkgLogger.Info().Uint32("num_pruned", numPruned).Msg("pruned entries after pull")
+
+	if err := ds.Optimize(ctx); err != nil {
+		pkgLogger.Warn().Err(err).Msg("optimizing datastore after pruning failed")
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func TestPruneAfterPullOptimizes(t *testing.T) {
+	t.Parallel()
+
+	ds := NewMockDatastore(gomock.NewController(t))
+
+	ds.EXPECT().
+		PruneEntries(gomock.Any(), []entity.ID{2, 3}, false).
+		Return(
+			[]*entity.PruneResult{
+				{FeedID: 2, FeedTitle: "Feed B", NumPruned: 0, NumKept: 3},
+				{FeedID: 3, FeedTitle: "Feed C", NumPruned: 4, NumKept: 1},
+			},
+			nil,
+		)
+	ds.EXPECT().
+		Optimize(gomock.Any()).
+		Return(nil)
+
+	pruneAfterPull(context.Background(), ds, []entity.ID{2, 3})
+}
+
+func TestPruneAfterPullNothingPruned(t *testing.T) {
+	t.Parallel()
+
+	ds := NewMockDatastore(gomock.NewController(t))
+
+	ds.EXPECT().
+		PruneEntries(gomock.Any(), nil, false).
+		Return([]*entity.PruneResult{{FeedID: 2, FeedTitle: "Feed B", NumKept: 3}}, nil)
+	ds.EXPECT().
+		Optimize(gomock.Any()).
+		Times(0)
+
+	pruneAfterPull(context.Background(), ds, nil)
+}
+
+func TestPruneAfterPullErrPrune(t *testing.T) {
+	t.Parallel()
+
+	ds := NewMockDatastore(gomock.NewController(t))
+
+	ds.EXPECT().
+		PruneEntries(gomock.Any(), []entity.ID{2}, false).
+		Return(nil, fmt.Errorf("database is locked"))
+	ds.EXPECT().
+		Optimize(gomock.Any()).
+		Times(0)
+
+	pruneAfterPull(context.Background(), ds, []entity.ID{2})
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
+)
+
+const (
+	// maxSchedulerTick is the longest period the scheduler waits before checking for due feeds.
+	maxSchedulerTick = time.Minute
+
+	// defaultMaxConcurrentPulls is the number of feeds pulled at the same time when no limit
+	// is set.
+	defaultMaxConcurrentPulls = 4
+)
+
+// scheduler periodically pulls feeds in the background.
+type scheduler struct {
+	ds            datastore.Datastore
+	hub           *hub
+	interval      time.Duration
+	feedIntervals map[entity.ID]time.Duration
+	jitter        time.Duration
+	maxConcurrent int
+	pullTimeout   *time.Duration
+	autoPrune     bool
+	metrics       *metrics
+
+	mu       sync.RWMutex
+	nextPull map[entity.ID]time.Time
+	inFlight map[entity.ID]struct{}
+	stats    entity.SchedulerStats
+
+	wg sync.WaitGroup
+}
+
+func newScheduler(
+	ds datastore.Datastore,
+	hub *hub,
+	interval time.Duration,
+	feedIntervals map[entity.ID]time.Duration,
+	jitter time.Duration,
+	maxConcurrent int,
+	pullTimeout *time.Duration,
+	autoPrune bool,
+) *scheduler {
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultMaxConcurrentPulls
+	}
+	if jitter < 0 {
+		jitter = 0
+	}
+	fis := make(map[entity.ID]time.Duration, len(feedIntervals))
+	for id, fi := range feedIntervals {
+		fis[id] = fi
+	}
+	return &scheduler{
+		ds:            ds,
+		hub:           hub,
+		interval:      interval,
+		feedIntervals: fis,
+		jitter:        jitter,
+		maxConcurrent: maxConcurrent,
+		pullTimeout:   pullTimeout,
+		autoPrune:     autoPrune,
+		nextPull:      make(map[entity.ID]time.Time),
+		inFlight:      make(map[entity.ID]struct{}),
+	}
+}
+
+// run checks for due feeds and pulls them until the given context is canceled. It blocks until
+// all pulls started by it have finished.
+func (s *scheduler) run(ctx context.Context) {
+	pkgLogger.Info().
+		Dur("interval", s.interval).
+		Dur("jitter", s.jitter).
+		Int("max_concurrent", s.maxConcurrent).
+		Msg("starting pull scheduler")
+
+	sem := make(chan struct{}, s.maxConcurrent)
+	ticker := time.NewTicker(s.tick())
+	defer ticker.Stop()
+
+	defer s.wg.Wait()
+
+	for {
+		s.dispatch(ctx, sem, time.Now())
+		select {
+		case <-ctx.Done():
+			pkgLogger.Info().Msg("stopping pull scheduler")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch starts pulls of all feeds that are due at the given time.
+func (s *scheduler) dispatch(ctx context.Context, sem chan struct{}, now time.Time) {
+	feeds, _, err := s.ds.ListFeeds(
+		ctx,
+		pointer(uint32(0)),
+		nil,
+		entity.FeedSortUpdateTimeDesc,
+		nil,
+	)
+	if err != nil {
+		if ctx.Err() == nil {
+			pkgLogger.Error().Err(err).Msg("scheduler failed to list feeds")
+		}
+		return
+	}
+
+	for _, feed := range s.dueFeeds(feeds, now) {
+		feed := feed
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				s.release(feed.ID, now)
+				return
+			}
+			defer func() { <-sem }()
+			s.pull(ctx, feed)
+		}()
+	}
+}
+
+// dueFeeds returns the feeds whose next pull time has passed and marks them as in-flight.
+// Feeds seen for the first time are due immediately.
+func (s *scheduler) dueFeeds(feeds []*entity.Feed, now time.Time) []*entity.Feed {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[entity.ID]struct{}, len(feeds))
+	due := make([]*entity.Feed, 0)
+	for _, feed := range feeds {
+		if s.feedInterval(feed.ID) <= 0 {
+			continue
+		}
+		seen[feed.ID] = struct{}{}
+		if _, running := s.inFlight[feed.ID]; running {
+			continue
+		}
+		if next, exists := s.nextPull[feed.ID]; exists && next.After(now) {
+			continue
+		}
+		s.inFlight[feed.ID] = struct{}{}
+		due = append(due, feed)
+	}
+
+	// Forget feeds that have been removed since the last check.
+	for id := range s.nextPull {
+		if _, exists := seen[id]; !exists {
+			delete(s.nextPull, id)
+		}
+	}
+	s.stats.NumFeeds = uint32(len(seen)) // #nosec: G115
+
+	return due
+}
+
+// pull pulls a single feed and records its outcome.
+func (s *scheduler) pull(ctx context.Context, feed *entity.Feed) {
+	start := time.Now()
+	ch := s.ds.PullFeeds(
+		ctx,
+		[]entity.ID{feed.ID},
+		nil,
+		pointer(uint32(0)),
+		s.pullTimeout,
+		false,
+	)
+
+	var (
+		perr   error
+		status = entity.PullSuccess
+	)
+	for pr := range ch {
+		status = pr.Status()
+		s.metrics.observePull(pr)
+		if err := pr.Error(); err != nil {
+			perr = err
+		}
+		s.hub.publish(pr.Feed(), pr.ChangedEntries())
+	}
+	end := time.Now()
+
+	if ctx.Err() != nil {
+		s.release(feed.ID, start)
+		return
+	}
+
+	logger := pkgLogger.With().
+		Uint32("feed.id", feed.ID).
+		Str("feed.url", feed.FeedURL).
+		Dur("duration", end.Sub(start)).
+		Logger()
+	if perr != nil {
+		logger.Warn().Err(perr).Msg("scheduled pull failed")
+	} else {
+		logger.Info().Str("status", status.String()).Msg("scheduled pull completed")
+	}
+	if perr == nil && status == entity.PullSuccess && s.autoPrune {
+		pruneAfterPull(ctx, s.ds, []entity.ID{feed.ID})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if perr != nil {
+		s.stats.NumPullsFailed++
+		s.stats.LastError = pointer(perr.Error())
+	} else if status != entity.PullSkipped {
+		s.stats.NumPullsOK++
+	}
+	s.stats.LastPullTime = &end
+	s.nextPull[feed.ID] = end.Add(s.feedInterval(feed.ID) + s.randJitter())
+	delete(s.inFlight, feed.ID)
+}
+
+// release unmarks the given feed as in-flight without recording any outcome.
+func (s *scheduler) release(id entity.ID, next time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.inFlight, id)
+	s.nextPull[id] = next
+}
+
+// Stats returns a snapshot of the scheduler statistics.
+func (s *scheduler) Stats() *entity.SchedulerStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := s.stats
+	for _, next := range s.nextPull {
+		next := next
+		if stats.NextPullTime == nil || next.Before(*stats.NextPullTime) {
+			stats.NextPullTime = &next
+		}
+	}
+
+	return &stats
+}
+
+// feedInterval returns the pull interval of the given feed. Non-positive values mean the feed
+// is not pulled by the scheduler.
+func (s *scheduler) feedInterval(id entity.ID) time.Duration {
+	if fi, exists := s.feedIntervals[id]; exists {
+		return fi
+	}
+	return s.interval
+}
+
+// tick returns how often the scheduler checks for due feeds.
+func (s *scheduler) tick() time.Duration {
+	tick := maxSchedulerTick
+	check := func(interval time.Duration) {
+		if interval > 0 && interval < tick {
+			tick = interval
+		}
+	}
+	check(s.interval)
+	for _, fi := range s.feedIntervals {
+		check(fi)
+	}
+	return tick
+}
+
+func (s *scheduler) randJitter() time.Duration {
+	if s.jitter <= 0 {
+		return 0
+	}
+	return rand.N(s.jitter) // #nosec: G404
+}
+
+func pointer[T any](value T) *T { return &value }
@@ -0,0 +1,172 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/bow/neon/internal/entity"
+)
+
+func pullResultCh(results ...entity.PullResult) <-chan entity.PullResult {
+	ch := make(chan entity.PullResult, len(results))
+	for _, result := range results {
+		ch <- result
+	}
+	close(ch)
+	return ch
+}
+
+func TestSchedulerRunOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	ds := NewMockDatastore(gomock.NewController(t))
+
+	feeds := []*entity.Feed{
+		{ID: 2, FeedURL: "https://a.com/feed.xml"},
+		{ID: 3, FeedURL: "https://b.com/feed.xml"},
+		{ID: 5, FeedURL: "https://c.com/feed.xml"},
+	}
+	ds.EXPECT().
+		ListFeeds(gomock.Any(), pointer(uint32(0)), nil, entity.FeedSortUpdateTimeDesc, nil).
+		Return(feeds, "", nil).
+		AnyTimes()
+
+	var npulls atomic.Int32
+	ds.EXPECT().
+		PullFeeds(gomock.Any(), gomock.Any(), nil, pointer(uint32(0)), nil, false).
+		DoAndReturn(
+			func(
+				_ context.Context,
+				ids []entity.ID,
+				_ *bool,
+				_ *uint32,
+				_ *time.Duration,
+				_ bool,
+			) <-chan entity.PullResult {
+				npulls.Add(1)
+				if ids[0] == 3 {
+					return pullResultCh(
+						entity.NewPullResultFromError(
+							pointer(feeds[1].FeedURL),
+							fmt.Errorf("timed out"),
+						),
+					)
+				}
+				return pullResultCh(entity.NewPullResultFromFeed(nil, nil))
+			},
+		).
+		Times(2)
+
+	// Feed 5 is excluded from scheduled pulls.
+	sched := newScheduler(
+		ds,
+		nil,
+		time.Hour,
+		map[entity.ID]time.Duration{5: 0},
+		0,
+		1,
+		nil,
+		false,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sched.run(ctx)
+	}()
+
+	r.Eventually(
+		func() bool {
+			stats := sched.Stats()
+			return stats.NumPullsOK+stats.NumPullsFailed == 2
+		},
+		5*time.Second,
+		10*time.Millisecond,
+	)
+	cancel()
+	<-done
+
+	stats := sched.Stats()
+	a.Equal(int32(2), npulls.Load())
+	a.Equal(uint32(2), stats.NumFeeds)
+	a.Equal(uint32(1), stats.NumPullsOK)
+	a.Equal(uint32(1), stats.NumPullsFailed)
+	a.Equal(pointer("timed out"), stats.LastError)
+	r.NotNil(stats.LastPullTime)
+	r.NotNil(stats.NextPullTime)
+	a.True(stats.NextPullTime.After(*stats.LastPullTime))
+}
+
+func TestSchedulerDueFeeds(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+
+	var (
+		now   = time.Now()
+		feed1 = &entity.Feed{ID: 1}
+		feed2 = &entity.Feed{ID: 2}
+		feed3 = &entity.Feed{ID: 3}
+	)
+	sched := newScheduler(nil, nil, 0, map[entity.ID]time.Duration{1: time.Hour, 2: time.Minute}, 0, 0, nil, false)
+	a.Equal(defaultMaxConcurrentPulls, sched.maxConcurrent)
+	a.Equal(time.Minute, sched.tick())
+
+	// Feed 3 has no interval and is never due.
+	a.Equal([]*entity.Feed{feed1, feed2}, sched.dueFeeds([]*entity.Feed{feed1, feed2, feed3}, now))
+
+	// In-flight feeds are not due.
+	a.Empty(sched.dueFeeds([]*entity.Feed{feed1, feed2}, now))
+
+	sched.release(feed1.ID, now.Add(time.Hour))
+	sched.release(feed2.ID, now.Add(time.Minute))
+	a.Empty(sched.dueFeeds([]*entity.Feed{feed1, feed2}, now))
+	a.Equal([]*entity.Feed{feed2}, sched.dueFeeds([]*entity.Feed{feed1, feed2}, now.Add(2*time.Minute)))
+}
+
+func TestGetStatsOkWithScheduler(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	ds := NewMockDatastore(gomock.NewController(t))
+
+	svc := service{ds: ds, sched: newScheduler(ds, nil, time.Hour, nil, 0, 0, nil, false)}
+	svc.sched.stats = entity.SchedulerStats{
+		NumFeeds:       3,
+		NumPullsOK:     10,
+		NumPullsFailed: 2,
+		LastPullTime:   pointer(mustTimeVV(t, "2023-11-04T05:13:12.805Z")),
+		LastError:      pointer("timed out"),
+	}
+	svc.sched.nextPull[2] = mustTimeVV(t, "2023-11-04T06:13:12.805Z")
+
+	ds.EXPECT().
+		GetGlobalStats(gomock.Any()).
+		Return(&entity.Stats{}, nil)
+
+	rsp, err := svc.GetStats(context.Background(), nil)
+	r.NoError(err)
+
+	ss := rsp.GetScheduler()
+	r.NotNil(ss)
+	a.Equal(uint32(3), ss.GetNumFeeds())
+	a.Equal(uint32(10), ss.GetNumPullsOk())
+	a.Equal(uint32(2), ss.GetNumPullsFailed())
+	a.Equal("timed out", ss.GetLastError())
+	a.Equal(int64(1699074792), ss.GetLastPullTime().GetSeconds())
+	a.Equal(int64(1699078392), ss.GetNextPullTime().GetSeconds())
+}
@@ -5,17 +5,21 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	healthapi "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
@@ -23,12 +27,15 @@ import (
 	"github.com/bow/neon/api"
 	"github.com/bow/neon/internal"
 	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/entity"
 )
 
 const (
 	tcpPrefix  = "tcp://"
 	unixPrefix = "unix://"
 	filePrefix = "file://"
+
+	metricsReadHeaderTimeout = 10 * time.Second
 )
 
 type Server struct {
@@ -36,14 +43,49 @@ type Server struct {
 	grpcServer *grpc.Server
 	stopf      func()
 	stoppedCh  chan struct{}
+	sched      *scheduler
+
+	metricsLis net.Listener
+	metricsSrv *http.Server
+
+	gw *gateway
 
 	healthSvc *health.Server
 }
 
-func newServer(lis net.Listener, grpcServer *grpc.Server, ds datastore.Datastore) *Server {
+func newServer(
+	lis net.Listener,
+	grpcServer *grpc.Server,
+	ds datastore.Datastore,
+	hub *hub,
+	sched *scheduler,
+	autoPrune bool,
+	pullTimeout *time.Duration,
+	metricsLis net.Listener,
+	m *metrics,
+	gw *gateway,
+) *Server {
+
+	svc := service{
+		ds:          ds,
+		hub:         hub,
+		sched:       sched,
+		autoPrune:   autoPrune,
+		pullTimeout: pullTimeout,
+		metrics:     m,
+	}
 
-	svc := service{ds: ds}
+	var metricsSrv *http.Server
+	if metricsLis != nil {
+		metricsSrv = &http.Server{
+			Handler:           m.handler(),
+			ReadHeaderTimeout: metricsReadHeaderTimeout,
+		}
+	}
 	api.RegisterNeonServer(grpcServer, &svc)
+	if gw != nil {
+		api.RegisterNeonServer(gw.grpcServer, &svc)
+	}
 
 	var (
 		funcCh    = make(chan struct{}, 1)
@@ -66,6 +108,15 @@ func newServer(lis net.Listener, grpcServer *grpc.Server, ds datastore.Datastore
 		}
 
 		pkgLogger.Debug().Msg("stopping server")
+		hub.close()
+		if metricsSrv != nil {
+			if err := metricsSrv.Close(); err != nil {
+				pkgLogger.Error().Err(err).Msg("failed to stop metrics server")
+			}
+		}
+		if gw != nil {
+			gw.stop()
+		}
 		grpcServer.GracefulStop()
 		pkgLogger.Info().Msgf("server stopped (%s)", reason)
 		stoppedCh <- struct{}{}
@@ -81,6 +132,10 @@ func newServer(lis net.Listener, grpcServer *grpc.Server, ds datastore.Datastore
 		grpcServer: grpcServer,
 		stopf:      func() { funcCh <- struct{}{} },
 		stoppedCh:  stoppedCh,
+		sched:      sched,
+		metricsLis: metricsLis,
+		metricsSrv: metricsSrv,
+		gw:         gw,
 		healthSvc:  healthSvc,
 	}
 
@@ -91,6 +146,23 @@ func (s *Server) Addr() net.Addr {
 	return s.lis.Addr()
 }
 
+// MetricsAddr returns the address of the metrics listener, or nil if metrics are disabled.
+func (s *Server) MetricsAddr() net.Addr {
+	if s.metricsLis == nil {
+		return nil
+	}
+	return s.metricsLis.Addr()
+}
+
+// GatewayAddr returns the address of the HTTP/JSON gateway listener, or nil if the gateway is
+// disabled.
+func (s *Server) GatewayAddr() net.Addr {
+	if s.gw == nil {
+		return nil
+	}
+	return s.gw.lis.Addr()
+}
+
 func (s *Server) ServiceName() string {
 	return api.Neon_ServiceDesc.ServiceName
 }
@@ -102,6 +174,19 @@ func (s *Server) Serve(ctx context.Context) error {
 
 	s.healthSvc.SetServingStatus(s.ServiceName(), healthapi.HealthCheckResponse_NOT_SERVING)
 
+	if s.sched != nil {
+		sctx, cancel := context.WithCancel(ctx)
+		schedDone := make(chan struct{})
+		go func() {
+			defer close(schedDone)
+			s.sched.run(sctx)
+		}()
+		defer func() {
+			cancel()
+			<-schedDone
+		}()
+	}
+
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -127,6 +212,22 @@ func (s *Server) start() <-chan error {
 	}()
 	pkgLogger.Info().Str("addr", s.lis.Addr().String()).Msgf("server listening")
 
+	if s.metricsSrv != nil {
+		go func() {
+			err := s.metricsSrv.Serve(s.metricsLis)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				pkgLogger.Error().Err(err).Msg("metrics server failed")
+			}
+		}()
+		pkgLogger.Info().
+			Str("addr", s.metricsLis.Addr().String()).
+			Msgf("metrics server listening")
+	}
+
+	if s.gw != nil {
+		s.gw.start()
+	}
+
 	return ch
 }
 
@@ -135,6 +236,17 @@ type Builder struct {
 	addr       string
 	ds         datastore.Datastore
 	sqlitePath string
+
+	pullInterval      time.Duration
+	feedPullIntervals map[entity.ID]time.Duration
+	pullJitter        time.Duration
+	maxPulls          int
+	pullTimeout       *time.Duration
+	pruneAfterPull    bool
+	tlsConfig         *tls.Config
+	requireAuth       bool
+	metricsAddr       string
+	gatewayAddr       string
 }
 
 func NewBuilder() *Builder {
@@ -164,6 +276,78 @@ func (b *Builder) Datastore(ds datastore.Datastore) *Builder {
 	return b
 }
 
+// PullInterval sets the interval between scheduled pulls of each feed. A non-positive value
+// disables scheduled pulls of feeds without an interval set via FeedPullIntervals.
+func (b *Builder) PullInterval(interval time.Duration) *Builder {
+	b.pullInterval = interval
+	return b
+}
+
+// FeedPullIntervals sets per-feed pull intervals that override the global pull interval. A
+// non-positive value disables scheduled pulls of the feed.
+func (b *Builder) FeedPullIntervals(intervals map[entity.ID]time.Duration) *Builder {
+	b.feedPullIntervals = intervals
+	return b
+}
+
+// PullJitter sets the maximum random delay added to each scheduled pull.
+func (b *Builder) PullJitter(jitter time.Duration) *Builder {
+	b.pullJitter = jitter
+	return b
+}
+
+// MaxConcurrentPulls sets the maximum number of feeds pulled at the same time by the scheduler.
+func (b *Builder) MaxConcurrentPulls(n int) *Builder {
+	b.maxPulls = n
+	return b
+}
+
+// PullTimeout sets the timeout of each scheduled feed pull.
+func (b *Builder) PullTimeout(timeout time.Duration) *Builder {
+	if timeout > 0 {
+		b.pullTimeout = &timeout
+	} else {
+		b.pullTimeout = nil
+	}
+	return b
+}
+
+// PruneAfterPull enables pruning of entries according to the retention policies after each pull,
+// followed by optimization of the datastore if any entries were pruned.
+func (b *Builder) PruneAfterPull(enabled bool) *Builder {
+	b.pruneAfterPull = enabled
+	return b
+}
+
+// TLSConfig sets the TLS configuration of the server. The server accepts only plaintext
+// connections if it is not set.
+func (b *Builder) TLSConfig(cfg *tls.Config) *Builder {
+	b.tlsConfig = cfg
+	return b
+}
+
+// RequireAuth requires all calls to the service to carry bearer tokens allowing the called
+// methods.
+func (b *Builder) RequireAuth(required bool) *Builder {
+	b.requireAuth = required
+	return b
+}
+
+// MetricsAddress sets the TCP address of the HTTP listener serving Prometheus metrics at
+// /metrics. Metrics are disabled if it is not set.
+func (b *Builder) MetricsAddress(addr string) *Builder {
+	b.metricsAddr = addr
+	return b
+}
+
+// GatewayAddress sets the TCP address of the HTTP listener serving the service as JSON, with
+// the OpenAPI document of the service at /openapi.json. The listener uses the TLS configuration
+// of the server, if any. The gateway is disabled if the address is not set.
+func (b *Builder) GatewayAddress(addr string) *Builder {
+	b.gatewayAddr = addr
+	return b
+}
+
 func (b *Builder) Build() (*Server, error) {
 
 	var netw string
@@ -199,21 +383,110 @@ func (b *Builder) Build() (*Server, error) {
 		Str("grpc.version", grpc.Version).
 		Logger()
 
-	grpcs := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			errorUnaryServerInterceptor,
-			logging.UnaryServerInterceptor(internal.InterceptorLogger(ilogger)),
-		),
-		grpc.ChainStreamInterceptor(
-			errorStreamServerInterceptor,
-			logging.StreamServerInterceptor(internal.InterceptorLogger(ilogger)),
-		),
+	var (
+		m          *metrics
+		metricsLis net.Listener
+	)
+	if b.metricsAddr != "" {
+		if metricsLis, err = lc.Listen(b.ctx, "tcp", b.metricsAddr); err != nil {
+			return nil, fmt.Errorf("server build: %w", err)
+		}
+		m = newMetrics(ds)
+		if sds, ok := ds.(*datastore.SQLite); ok {
+			sds.ObserveTx(m.observeTx)
+		}
+	}
+
+	unaryInterceptors := make([]grpc.UnaryServerInterceptor, 0)
+	streamInterceptors := make([]grpc.StreamServerInterceptor, 0)
+	if m != nil {
+		// Metrics come first so that they see the status codes set by the other interceptors.
+		unaryInterceptors = append(unaryInterceptors, m.unaryServerInterceptor)
+		streamInterceptors = append(streamInterceptors, m.streamServerInterceptor)
+	}
+	unaryInterceptors = append(unaryInterceptors, errorUnaryServerInterceptor)
+	streamInterceptors = append(streamInterceptors, errorStreamServerInterceptor)
+	if b.requireAuth {
+		au := authenticator{ds: ds}
+		unaryInterceptors = append(unaryInterceptors, au.unaryServerInterceptor)
+		streamInterceptors = append(streamInterceptors, au.streamServerInterceptor)
+	}
+	unaryInterceptors = append(
+		unaryInterceptors,
+		logging.UnaryServerInterceptor(internal.InterceptorLogger(ilogger)),
+	)
+	streamInterceptors = append(
+		streamInterceptors,
+		logging.StreamServerInterceptor(internal.InterceptorLogger(ilogger)),
+	)
+
+	sopts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+
+	var gw *gateway
+	if b.gatewayAddr != "" {
+		var gwLis net.Listener
+		if gwLis, err = lc.Listen(b.ctx, "tcp", b.gatewayAddr); err != nil {
+			return nil, fmt.Errorf("server build: %w", err)
+		}
+		if gw, err = newGateway(b.ctx, gwLis, b.tlsConfig, sopts...); err != nil {
+			return nil, fmt.Errorf("server build: %w", err)
+		}
+	}
+
+	if b.tlsConfig != nil {
+		sopts = append(sopts, grpc.Creds(credentials.NewTLS(b.tlsConfig)))
+	}
+
+	grpcs := grpc.NewServer(sopts...)
+
+	hub := newHub()
+
+	var sched *scheduler
+	if b.schedulerEnabled() {
+		sched = newScheduler(
+			ds,
+			hub,
+			b.pullInterval,
+			b.feedPullIntervals,
+			b.pullJitter,
+			b.maxPulls,
+			b.pullTimeout,
+			b.pruneAfterPull,
+		)
+		sched.metrics = m
+	}
+
+	s := newServer(
+		lis,
+		grpcs,
+		ds,
+		hub,
+		sched,
+		b.pruneAfterPull,
+		b.pullTimeout,
+		metricsLis,
+		m,
+		gw,
 	)
-	s := newServer(lis, grpcs, ds)
 
 	return s, nil
 }
 
+func (b *Builder) schedulerEnabled() bool {
+	if b.pullInterval > 0 {
+		return true
+	}
+	for _, interval := range b.feedPullIntervals {
+		if interval > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func isAddrF(prefix string) func(string) bool {
 	return func(addr string) bool {
 		return strings.HasPrefix(strings.ToLower(addr), prefix)
@@ -5,6 +5,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"testing"
 	"time"
@@ -14,11 +15,16 @@ import (
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 
 	"github.com/bow/neon/api"
 	"github.com/bow/neon/internal/datastore"
+	"github.com/bow/neon/internal/tlsutil"
+	"github.com/bow/neon/internal/tlsutil/tlstest"
 )
 
 func defaultTestServerBuilder(t *testing.T) *Builder {
@@ -33,6 +39,7 @@ type testClientBuilder struct {
 	t             *testing.T
 	serverBuilder *Builder
 	dialOpts      []grpc.DialOption
+	clientTLS     *tls.Config
 }
 
 func newTestClientBuilder(t *testing.T) *testClientBuilder {
@@ -50,6 +57,19 @@ func (tcb *testClientBuilder) ServerDatastore(ds datastore.Datastore) *testClien
 	return tcb
 }
 
+func (tcb *testClientBuilder) ServerRequireAuth() *testClientBuilder {
+	tcb.serverBuilder = tcb.serverBuilder.RequireAuth(true)
+	return tcb
+}
+
+// TLS sets the TLS configurations of the server and the client. The client connects without TLS
+// if it is not called.
+func (tcb *testClientBuilder) TLS(serverCfg, clientCfg *tls.Config) *testClientBuilder {
+	tcb.serverBuilder = tcb.serverBuilder.TLSConfig(serverCfg)
+	tcb.clientTLS = clientCfg
+	return tcb
+}
+
 func (tcb *testClientBuilder) Build() api.NeonClient {
 	tcb.t.Helper()
 
@@ -64,7 +84,11 @@ func (tcb *testClientBuilder) Build() api.NeonClient {
 	srv := newTestServer(t, b)
 
 	dialOpts := tcb.dialOpts
-	dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if tcb.clientTLS != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tcb.clientTLS)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
 	client, conn := newTestClient(t, srv.Addr(), dialOpts...)
 
 	t.Cleanup(
@@ -152,3 +176,89 @@ func TestServerBuilderErrInvalidAddr(t *testing.T) {
 	assert.Nil(t, srv)
 	assert.EqualError(t, err, "unexpected address type: invalid")
 }
+
+func TestServerTLSOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+
+	ca := tlstest.NewCA(t, "ca")
+	srvKP := ca.ServerKeyPair("server", "localhost")
+
+	serverCfg, err := tlsutil.ServerConfig(srvKP.CertFile, srvKP.KeyFile, "")
+	r.NoError(err)
+	clientCfg, err := tlsutil.ClientConfig(ca.CertFile, "", "", "localhost")
+	r.NoError(err)
+
+	client := newTestClientBuilder(t).TLS(serverCfg, clientCfg).Build()
+
+	rsp, err := client.GetInfo(context.Background(), &api.GetInfoRequest{})
+	r.NoError(err)
+	r.NotNil(rsp)
+}
+
+func TestServerTLSErrUnknownCA(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+
+	srvKP := tlstest.NewCA(t, "ca").ServerKeyPair("server", "localhost")
+	otherCA := tlstest.NewCA(t, "other-ca")
+
+	serverCfg, err := tlsutil.ServerConfig(srvKP.CertFile, srvKP.KeyFile, "")
+	r.NoError(err)
+	clientCfg, err := tlsutil.ClientConfig(otherCA.CertFile, "", "", "localhost")
+	r.NoError(err)
+
+	client := newTestClientBuilder(t).TLS(serverCfg, clientCfg).Build()
+
+	rsp, err := client.GetInfo(context.Background(), &api.GetInfoRequest{})
+	r.Nil(rsp)
+	r.Equal(codes.Unavailable, status.Code(err))
+}
+
+func TestServerMutualTLSOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+
+	ca := tlstest.NewCA(t, "ca")
+	srvKP := ca.ServerKeyPair("server", "localhost")
+	clientKP := ca.ClientKeyPair("client")
+
+	serverCfg, err := tlsutil.ServerConfig(srvKP.CertFile, srvKP.KeyFile, ca.CertFile)
+	r.NoError(err)
+	clientCfg, err := tlsutil.ClientConfig(
+		ca.CertFile,
+		clientKP.CertFile,
+		clientKP.KeyFile,
+		"localhost",
+	)
+	r.NoError(err)
+
+	client := newTestClientBuilder(t).TLS(serverCfg, clientCfg).Build()
+
+	rsp, err := client.GetInfo(context.Background(), &api.GetInfoRequest{})
+	r.NoError(err)
+	r.NotNil(rsp)
+}
+
+func TestServerMutualTLSErrNoClientCert(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+
+	ca := tlstest.NewCA(t, "ca")
+	srvKP := ca.ServerKeyPair("server", "localhost")
+
+	serverCfg, err := tlsutil.ServerConfig(srvKP.CertFile, srvKP.KeyFile, ca.CertFile)
+	r.NoError(err)
+	clientCfg, err := tlsutil.ClientConfig(ca.CertFile, "", "", "localhost")
+	r.NoError(err)
+
+	client := newTestClientBuilder(t).TLS(serverCfg, clientCfg).Build()
+
+	rsp, err := client.GetInfo(context.Background(), &api.GetInfoRequest{})
+	r.Nil(rsp)
+	r.Equal(codes.Unavailable, status.Code(err))
+}
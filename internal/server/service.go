@@ -6,8 +6,10 @@ package server
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/bow/neon/api"
@@ -20,7 +22,16 @@ import (
 type service struct {
 	api.UnimplementedNeonServer
 
-	ds datastore.Datastore
+	ds    datastore.Datastore
+	hub   *hub
+	sched *scheduler
+
+	// autoPrune enables pruning of entries after each pull.
+	autoPrune bool
+	// pullTimeout is the timeout of each feed pull, also applied to fetching entry content.
+	pullTimeout *time.Duration
+	// metrics records the pull outcomes. It is nil if metrics are disabled.
+	metrics *metrics
 }
 
 // AddFeed satisfies the service API.
@@ -41,8 +52,25 @@ func (svc *service) AddFeed(
 	if err != nil {
 		return nil, err
 	}
+	svc.hub.publish(record, record.EntriesSlice())
+
+	rsp := api.AddFeedResponse{Feed: entity.ToFeedPb(record), IsAdded: added}
+
+	return &rsp, nil
+}
+
+// DiscoverFeeds satisfies the service API.
+func (svc *service) DiscoverFeeds(
+	ctx context.Context,
+	req *api.DiscoverFeedsRequest,
+) (*api.DiscoverFeedsResponse, error) {
+
+	candidates, err := svc.ds.DiscoverFeeds(ctx, req.GetUrl(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	rsp := api.AddFeedResponse{Feed: toFeedPb(record), IsAdded: added}
+	rsp := api.DiscoverFeedsResponse{Candidates: toFeedCandidatePbs(candidates)}
 
 	return &rsp, nil
 }
@@ -53,11 +81,17 @@ func (svc *service) ListFeeds(
 	req *api.ListFeedsRequest,
 ) (*api.ListFeedsResponse, error) {
 
-	feeds, err := svc.ds.ListFeeds(ctx, req.MaxEntriesPerFeed)
+	feeds, nextToken, err := svc.ds.ListFeeds(
+		ctx,
+		req.MaxEntriesPerFeed,
+		fromListFeedsRequestPb(req),
+		entity.FromFeedSortOrderPb(req.GetSortOrder()),
+		&entity.Page{Size: req.GetPageSize(), Token: req.GetPageToken()},
+	)
 	if err != nil {
 		return nil, err
 	}
-	rsp := api.ListFeedsResponse{Feeds: toFeedPbs(feeds)}
+	rsp := api.ListFeedsResponse{Feeds: entity.ToFeedPbs(feeds), NextPageToken: nextToken}
 
 	return &rsp, nil
 }
@@ -74,7 +108,7 @@ func (svc *service) EditFeeds(
 		return nil, err
 	}
 
-	rsp := api.EditFeedsResponse{Feeds: toFeedPbs(feeds)}
+	rsp := api.EditFeedsResponse{Feeds: entity.ToFeedPbs(feeds)}
 
 	return &rsp, nil
 }
@@ -97,27 +131,171 @@ func (svc *service) DeleteFeeds(
 	return &rsp, err
 }
 
+// CreateFolder satisfies the service API.
+func (svc *service) CreateFolder(
+	ctx context.Context,
+	req *api.CreateFolderRequest,
+) (*api.CreateFolderResponse, error) {
+
+	folder, err := svc.ds.CreateFolder(ctx, req.GetName(), req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.CreateFolderResponse{Folder: entity.ToFolderPb(folder)}
+
+	return &rsp, nil
+}
+
+// ListFolders satisfies the service API.
+func (svc *service) ListFolders(
+	ctx context.Context,
+	_ *api.ListFoldersRequest,
+) (*api.ListFoldersResponse, error) {
+
+	folders, err := svc.ds.ListFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.ListFoldersResponse{Folders: entity.ToFolderPbs(folders)}
+
+	return &rsp, nil
+}
+
+// EditFolders satisfies the service API.
+func (svc *service) EditFolders(
+	ctx context.Context,
+	req *api.EditFoldersRequest,
+) (*api.EditFoldersResponse, error) {
+
+	for _, op := range req.GetOps() {
+		if op.GetFields() == nil {
+			msg := fmt.Sprintf("no fields to edit in folder with ID=%d", op.GetId())
+			return nil, status.Error(codes.InvalidArgument, msg)
+		}
+	}
+
+	ops := fromFolderEditOpPbs(req.GetOps())
+	folders, err := svc.ds.EditFolders(ctx, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.EditFoldersResponse{Folders: entity.ToFolderPbs(folders)}
+
+	return &rsp, nil
+}
+
+// DeleteFolders satisfies the service API.
+func (svc *service) DeleteFolders(
+	ctx context.Context,
+	req *api.DeleteFoldersRequest,
+) (*api.DeleteFoldersResponse, error) {
+
+	ids := make([]entity.ID, len(req.GetFolderIds()))
+	for i, id := range req.GetFolderIds() {
+		ids[i] = id
+	}
+
+	err := svc.ds.DeleteFolders(ctx, ids)
+
+	rsp := api.DeleteFoldersResponse{}
+
+	return &rsp, err
+}
+
+// CreateRule satisfies the service API.
+func (svc *service) CreateRule(
+	ctx context.Context,
+	req *api.CreateRuleRequest,
+) (*api.CreateRuleResponse, error) {
+
+	rule, err := svc.ds.CreateRule(ctx, entity.FromRulePb(req.GetRule()))
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.CreateRuleResponse{Rule: toRulePb(rule)}
+
+	return &rsp, nil
+}
+
+// ListRules satisfies the service API.
+func (svc *service) ListRules(
+	ctx context.Context,
+	_ *api.ListRulesRequest,
+) (*api.ListRulesResponse, error) {
+
+	rules, err := svc.ds.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.ListRulesResponse{Rules: toRulePbs(rules)}
+
+	return &rsp, nil
+}
+
+// DeleteRules satisfies the service API.
+func (svc *service) DeleteRules(
+	ctx context.Context,
+	req *api.DeleteRulesRequest,
+) (*api.DeleteRulesResponse, error) {
+
+	ids := make([]entity.ID, len(req.GetRuleIds()))
+	for i, id := range req.GetRuleIds() {
+		ids[i] = id
+	}
+
+	err := svc.ds.DeleteRules(ctx, ids)
+
+	rsp := api.DeleteRulesResponse{}
+
+	return &rsp, err
+}
+
+// MatchRule satisfies the service API.
+func (svc *service) MatchRule(
+	ctx context.Context,
+	req *api.MatchRuleRequest,
+) (*api.MatchRuleResponse, error) {
+
+	entries, err := svc.ds.MatchRule(ctx, entity.FromRulePb(req.GetRule()))
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.MatchRuleResponse{Entries: entity.ToEntryPbs(entries)}
+
+	return &rsp, nil
+}
+
 // PullFeeds satisfies the service API.
 func (svc *service) PullFeeds(
 	req *api.PullFeedsRequest,
 	stream api.Neon_PullFeedsServer,
 ) error {
 
+	// Results of every pulled feed are sent, so that clients also see feeds that were not
+	// modified or that were skipped. Failures without URLs end the stream.
 	convert := func(pr entity.PullResult) (*api.PullFeedsResponse, error) {
-		if err := pr.Error(); err != nil {
-			url := pr.URL()
-			if url == "" {
-				return nil, err
-			}
+		url := pr.URL()
+		err := pr.Error()
+		if err != nil && url == "" {
+			return nil, err
+		}
+		rsp := api.PullFeedsResponse{Url: url, Status: toPullStatusPb(pr.Status())}
+		if err != nil {
 			rspErr := err.Error()
-			rsp := api.PullFeedsResponse{Url: url, Error: &rspErr}
-			return &rsp, nil
+			rsp.Error = &rspErr
 		}
-		feed := pr.Feed()
-		if feed == nil {
-			return nil, nil
+		if newURL := pr.NewURL(); newURL != "" {
+			rsp.NewUrl = &newURL
+		}
+		if feed := pr.Feed(); feed != nil {
+			rsp.Feed = entity.ToFeedPb(feed)
 		}
-		rsp := api.PullFeedsResponse{Url: pr.URL(), Feed: toFeedPb(feed)}
 
 		return &rsp, nil
 	}
@@ -133,21 +311,25 @@ func (svc *service) PullFeeds(
 		nil,
 		req.MaxEntriesPerFeed,
 		nil,
+		req.GetForce(),
 	)
 
 	for pr := range ch {
+		svc.metrics.observePull(pr)
+		svc.hub.publish(pr.Feed(), pr.ChangedEntries())
 		payload, err := convert(pr)
 		if err != nil {
 			return err
 		}
-		if payload == nil {
-			continue
-		}
 		if err := stream.Send(payload); err != nil {
 			return err
 		}
 	}
 
+	if svc.autoPrune {
+		pruneAfterPull(stream.Context(), svc.ds, ids)
+	}
+
 	return nil
 }
 
@@ -157,12 +339,17 @@ func (svc *service) ListEntries(
 	req *api.ListEntriesRequest,
 ) (*api.ListEntriesResponse, error) {
 
-	entries, err := svc.ds.ListEntries(ctx, req.GetFeedIds(), req.IsBookmarked)
+	entries, nextToken, err := svc.ds.ListEntries(
+		ctx,
+		fromListEntriesRequestPb(req),
+		entity.FromEntrySortOrderPb(req.GetSortOrder()),
+		&entity.Page{Size: req.GetPageSize(), Token: req.GetPageToken()},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	rsp := api.ListEntriesResponse{Entries: toEntryPbs(entries)}
+	rsp := api.ListEntriesResponse{Entries: entity.ToEntryPbs(entries), NextPageToken: nextToken}
 
 	return &rsp, nil
 }
@@ -179,7 +366,7 @@ func (svc *service) EditEntries(
 		return nil, err
 	}
 
-	rsp := api.EditEntriesResponse{Entries: toEntryPbs(entries)}
+	rsp := api.EditEntriesResponse{Entries: entity.ToEntryPbs(entries)}
 
 	return &rsp, nil
 }
@@ -189,18 +376,29 @@ func (svc *service) StreamEntries(
 	req *api.StreamEntriesRequest,
 	stream api.Neon_StreamEntriesServer,
 ) error {
-	entries, err := svc.ds.ListEntries(stream.Context(), []entity.ID{req.GetFeedId()}, nil)
-	if err != nil {
+	sub := svc.hub.subscribe(fromStreamEntriesRequestPb(req))
+	defer svc.hub.unsubscribe(sub)
+
+	// Headers tell the client that the subscription is active.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
 		return err
 	}
-	for _, entry := range entries {
-		entry := entry
-		rsp := api.StreamEntriesResponse{Entry: toEntryPb(entry)}
-		if err := stream.Send(&rsp); err != nil {
-			return err
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case entry, ok := <-sub.entries():
+			if !ok {
+				return sub.err
+			}
+			rsp := api.StreamEntriesResponse{Entry: entity.ToEntryPb(entry)}
+			if err := stream.Send(&rsp); err != nil {
+				return err
+			}
 		}
 	}
-	return nil
 }
 
 // GetEntry satisfies the service API.
@@ -214,11 +412,101 @@ func (svc *service) GetEntry(
 		return nil, err
 	}
 
-	rsp := api.GetEntryResponse{Entry: toEntryPb(entry)}
+	rsp := api.GetEntryResponse{Entry: entity.ToEntryPb(entry)}
+
+	return &rsp, nil
+}
+
+// FetchEntryContent satisfies the service API.
+func (svc *service) FetchEntryContent(
+	ctx context.Context,
+	req *api.FetchEntryContentRequest,
+) (*api.FetchEntryContentResponse, error) {
+
+	entry, err := svc.ds.FetchEntryContent(ctx, req.GetId(), svc.pullTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.FetchEntryContentResponse{Entry: entity.ToEntryPb(entry)}
+
+	return &rsp, nil
+}
+
+// SearchEntries satisfies the service API.
+func (svc *service) SearchEntries(
+	ctx context.Context,
+	req *api.SearchEntriesRequest,
+) (*api.SearchEntriesResponse, error) {
+
+	results, err := svc.ds.SearchEntries(ctx, req.GetQuery(), req.GetFeedIds(), req.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.SearchEntriesResponse{Results: entity.ToSearchResultPbs(results)}
+
+	return &rsp, nil
+}
+
+// PruneEntries satisfies the service API.
+func (svc *service) PruneEntries(
+	ctx context.Context,
+	req *api.PruneEntriesRequest,
+) (*api.PruneEntriesResponse, error) {
+
+	results, err := svc.ds.PruneEntries(ctx, req.GetFeedIds(), req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.PruneEntriesResponse{Results: entity.ToPruneResultPbs(results)}
+
+	return &rsp, nil
+}
+
+// ListRetentionPolicies satisfies the service API.
+func (svc *service) ListRetentionPolicies(
+	ctx context.Context,
+	_ *api.ListRetentionPoliciesRequest,
+) (*api.ListRetentionPoliciesResponse, error) {
+
+	policies, err := svc.ds.ListRetentionPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := api.ListRetentionPoliciesResponse{Policies: entity.ToRetentionPolicyPbs(policies)}
 
 	return &rsp, nil
 }
 
+// SetRetentionPolicy satisfies the service API.
+func (svc *service) SetRetentionPolicy(
+	ctx context.Context,
+	req *api.SetRetentionPolicyRequest,
+) (*api.SetRetentionPolicyResponse, error) {
+
+	err := svc.ds.SetRetentionPolicy(ctx, entity.FromRetentionPolicyPb(req.GetPolicy()))
+
+	rsp := api.SetRetentionPolicyResponse{}
+
+	return &rsp, err
+}
+
+// DeleteRetentionPolicy satisfies the service API.
+func (svc *service) DeleteRetentionPolicy(
+	ctx context.Context,
+	req *api.DeleteRetentionPolicyRequest,
+) (*api.DeleteRetentionPolicyResponse, error) {
+
+	err := svc.ds.DeleteRetentionPolicy(ctx, req.FeedId)
+
+	rsp := api.DeleteRetentionPolicyResponse{}
+
+	return &rsp, err
+}
+
 // ExportOPML satisfies the service API.
 func (svc *service) ExportOPML(
 	ctx context.Context,
@@ -280,6 +568,9 @@ func (svc *service) GetStats(
 	}
 
 	rsp := api.GetStatsResponse{Global: toStatsPb(gstats)}
+	if svc.sched != nil {
+		rsp.Scheduler = toSchedulerStatsPb(svc.sched.Stats())
+	}
 
 	return &rsp, nil
 }
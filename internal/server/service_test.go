@@ -16,7 +16,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bow/neon/api"
 	"github.com/bow/neon/internal"
@@ -71,6 +74,58 @@ func TestAddFeedOk(t *testing.T) {
 	a.Equal(record.IsStarred, rsp.Feed.IsStarred)
 }
 
+func TestAddFeedErrAmbiguous(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		AddFeed(gomock.Any(), "https://a.com", nil, nil, nil, nil, nil).
+		Return(
+			nil,
+			false,
+			entity.AmbiguousFeedURLError{
+				URL: "https://a.com",
+				Candidates: []*entity.FeedCandidate{
+					{URL: "https://a.com/posts.xml"},
+					{URL: "https://a.com/comments.xml"},
+				},
+			},
+		)
+
+	rsp, err := client.AddFeed(context.Background(), &api.AddFeedRequest{Url: "https://a.com"})
+
+	r.Nil(rsp)
+	a.EqualError(
+		err,
+		"rpc error: code = FailedPrecondition desc = found 2 feeds at https://a.com",
+	)
+}
+
+func TestDiscoverFeedsOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	candidates := []*entity.FeedCandidate{
+		{URL: "https://a.com/posts.xml", Title: "Posts"},
+		{URL: "https://a.com/comments.xml"},
+	}
+	ds.EXPECT().
+		DiscoverFeeds(gomock.Any(), "https://a.com", nil).
+		Return(candidates, nil)
+
+	req := api.DiscoverFeedsRequest{Url: "https://a.com"}
+	rsp, err := client.DiscoverFeeds(context.Background(), &req)
+	r.NoError(err)
+
+	a.Equal(candidates, entity.FromFeedCandidatePbs(rsp.GetCandidates()))
+}
+
 func TestListFeedsOk(t *testing.T) {
 	t.Parallel()
 
@@ -99,14 +154,94 @@ func TestListFeedsOk(t *testing.T) {
 	}
 
 	ds.EXPECT().
-		ListFeeds(gomock.Any(), req.MaxEntriesPerFeed).
-		Return(feeds, nil)
+		ListFeeds(
+			gomock.Any(),
+			req.MaxEntriesPerFeed,
+			&entity.FeedFilter{},
+			entity.FeedSortUpdateTimeDesc,
+			&entity.Page{},
+		).
+		Return(feeds, "", nil)
 
 	rsp, err := client.ListFeeds(context.Background(), &req)
 	r.NoError(err)
 
 	// TODO: Expand test.
 	a.Len(rsp.GetFeeds(), 2)
+	a.Empty(rsp.GetNextPageToken())
+}
+
+func TestListFeedsOkPaginatedFiltered(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	req := api.ListFeedsRequest{
+		FeedIds:   []uint32{2, 3},
+		Tags:      []string{"news"},
+		IsStarred: pointer(true),
+		SortOrder: api.ListFeedsRequest_SORT_ORDER_TITLE_ASC,
+		PageSize:  1,
+		PageToken: "abc",
+	}
+
+	ds.EXPECT().
+		ListFeeds(
+			gomock.Any(),
+			nil,
+			&entity.FeedFilter{IDs: []entity.ID{2, 3}, Tags: []string{"news"}, IsStarred: pointer(true)},
+			entity.FeedSortTitleAsc,
+			&entity.Page{Size: 1, Token: "abc"},
+		).
+		Return([]*entity.Feed{{ID: 3, Title: "Feed X"}}, "def", nil)
+
+	rsp, err := client.ListFeeds(context.Background(), &req)
+	r.NoError(err)
+
+	r.Len(rsp.GetFeeds(), 1)
+	a.Equal(uint32(3), rsp.GetFeeds()[0].GetId())
+	a.Equal("def", rsp.GetNextPageToken())
+}
+
+func TestListFeedsOkPullHealth(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	nextPull := mustTimeVV(t, "2022-06-22T20:39:38.964+02:00")
+	ds.EXPECT().
+		ListFeeds(gomock.Any(), nil, &entity.FeedFilter{}, gomock.Any(), &entity.Page{}).
+		Return(
+			[]*entity.Feed{
+				{
+					ID:            2,
+					Title:         "Feed A",
+					PullFailures:  3,
+					LastPullError: pointer("status 503"),
+					NextPullTime:  &nextPull,
+				},
+				{ID: 3, Title: "Feed X"},
+			},
+			"",
+			nil,
+		)
+
+	rsp, err := client.ListFeeds(context.Background(), &api.ListFeedsRequest{})
+	r.NoError(err)
+
+	r.Len(rsp.GetFeeds(), 2)
+	failing := rsp.GetFeeds()[0]
+	a.Equal(uint32(3), failing.GetPullFailures())
+	a.Equal("status 503", failing.GetLastPullError())
+	a.True(nextPull.Equal(failing.GetNextPullTime().AsTime()))
+	healthy := rsp.GetFeeds()[1]
+	a.Zero(healthy.GetPullFailures())
+	a.Nil(healthy.LastPullError)
+	a.Nil(healthy.NextPullTime)
 }
 
 func TestEditFeedsOk(t *testing.T) {
@@ -219,6 +354,352 @@ func TestDeleteFeedsErrNotFound(t *testing.T) {
 	a.EqualError(err, "rpc error: code = NotFound desc = feed with ID=9 not found")
 }
 
+func TestCreateFolderOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		CreateFolder(gomock.Any(), "Tech", pointer(entity.ID(2))).
+		Return(&entity.Folder{ID: 5, Name: "Tech", ParentID: pointer(entity.ID(2))}, nil)
+
+	req := api.CreateFolderRequest{Name: "Tech", ParentId: pointer(uint32(2))}
+	rsp, err := client.CreateFolder(context.Background(), &req)
+	r.NoError(err)
+
+	a.True(
+		proto.Equal(
+			&api.CreateFolderResponse{
+				Folder: &api.Folder{Id: 5, Name: "Tech", ParentId: pointer(uint32(2))},
+			},
+			rsp,
+		),
+	)
+}
+
+func TestCreateFolderErrParentNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		CreateFolder(gomock.Any(), "Tech", pointer(entity.ID(2))).
+		Return(nil, fmt.Errorf("wrapped: %w", entity.FolderNotFoundError{ID: 2}))
+
+	req := api.CreateFolderRequest{Name: "Tech", ParentId: pointer(uint32(2))}
+	rsp, err := client.CreateFolder(context.Background(), &req)
+
+	r.Nil(rsp)
+	a.EqualError(err, "rpc error: code = NotFound desc = folder with ID=2 not found")
+}
+
+func TestListFoldersOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		ListFolders(gomock.Any()).
+		Return(
+			[]*entity.Folder{
+				{ID: 1, Name: "News"},
+				{ID: 2, Name: "Tech", ParentID: pointer(entity.ID(1))},
+			},
+			nil,
+		)
+
+	rsp, err := client.ListFolders(context.Background(), &api.ListFoldersRequest{})
+	r.NoError(err)
+
+	a.True(
+		proto.Equal(
+			&api.ListFoldersResponse{
+				Folders: []*api.Folder{
+					{Id: 1, Name: "News"},
+					{Id: 2, Name: "Tech", ParentId: pointer(uint32(1))},
+				},
+			},
+			rsp,
+		),
+	)
+}
+
+func TestEditFoldersOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ops := []*entity.FolderEditOp{
+		{ID: 2, Name: pointer("Technology"), ParentID: pointer(entity.ID(0))},
+	}
+	ds.EXPECT().
+		EditFolders(gomock.Any(), ops).
+		Return([]*entity.Folder{{ID: 2, Name: "Technology"}}, nil)
+
+	req := api.EditFoldersRequest{
+		Ops: []*api.EditFoldersRequest_Op{
+			{
+				Id: 2,
+				Fields: &api.EditFoldersRequest_Op_Fields{
+					Name:     pointer("Technology"),
+					ParentId: pointer(uint32(0)),
+				},
+			},
+		},
+	}
+	rsp, err := client.EditFolders(context.Background(), &req)
+	r.NoError(err)
+
+	a.True(
+		proto.Equal(
+			&api.EditFoldersResponse{Folders: []*api.Folder{{Id: 2, Name: "Technology"}}},
+			rsp,
+		),
+	)
+}
+
+func TestEditFoldersErrNoFields(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, _ := setupServerTest(t)
+
+	req := api.EditFoldersRequest{
+		Ops: []*api.EditFoldersRequest_Op{
+			{Id: 2, Fields: &api.EditFoldersRequest_Op_Fields{Name: pointer("Technology")}},
+			{Id: 3},
+		},
+	}
+	rsp, err := client.EditFolders(context.Background(), &req)
+
+	r.Nil(rsp)
+	a.EqualError(
+		err,
+		"rpc error: code = InvalidArgument desc = no fields to edit in folder with ID=3",
+	)
+}
+
+func TestDeleteFoldersOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		DeleteFolders(gomock.Any(), []entity.ID{3, 4}).
+		Return(nil)
+
+	req := api.DeleteFoldersRequest{FolderIds: []uint32{3, 4}}
+	rsp, err := client.DeleteFolders(context.Background(), &req)
+	r.NoError(err)
+
+	a.True(proto.Equal(&api.DeleteFoldersResponse{}, rsp))
+}
+
+func TestCreateRuleOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	createTime := mustTimeVV(t, "2024-10-23T09:00:00Z")
+	ds.EXPECT().
+		CreateRule(
+			gomock.Any(),
+			&entity.Rule{
+				FeedID:    pointer(entity.ID(2)),
+				Field:     entity.RuleFieldTitle,
+				MatchType: entity.RuleMatchRegex,
+				Pattern:   "^Ad",
+				Action:    entity.RuleActionTag,
+				Tag:       "ads",
+			},
+		).
+		Return(
+			&entity.Rule{
+				ID:         4,
+				FeedID:     pointer(entity.ID(2)),
+				Field:      entity.RuleFieldTitle,
+				MatchType:  entity.RuleMatchRegex,
+				Pattern:    "^Ad",
+				Action:     entity.RuleActionTag,
+				Tag:        "ads",
+				CreateTime: createTime,
+			},
+			nil,
+		)
+
+	req := api.CreateRuleRequest{
+		Rule: &api.Rule{
+			FeedId:    pointer(uint32(2)),
+			Field:     api.Rule_FIELD_TITLE,
+			MatchType: api.Rule_MATCH_TYPE_REGEX,
+			Pattern:   "^Ad",
+			Action:    api.Rule_ACTION_TAG,
+			Tag:       pointer("ads"),
+		},
+	}
+	rsp, err := client.CreateRule(context.Background(), &req)
+	r.NoError(err)
+
+	a.True(
+		proto.Equal(
+			&api.CreateRuleResponse{
+				Rule: &api.Rule{
+					Id:         4,
+					FeedId:     pointer(uint32(2)),
+					Field:      api.Rule_FIELD_TITLE,
+					MatchType:  api.Rule_MATCH_TYPE_REGEX,
+					Pattern:    "^Ad",
+					Action:     api.Rule_ACTION_TAG,
+					Tag:        pointer("ads"),
+					CreateTime: timestamppb.New(createTime),
+				},
+			},
+			rsp,
+		),
+	)
+}
+
+func TestCreateRuleErrInvalid(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		CreateRule(gomock.Any(), &entity.Rule{Pattern: "x"}).
+		Return(
+			nil,
+			fmt.Errorf("wrapped: %w", entity.InvalidArgumentError{Reason: "rule field is unknown"}),
+		)
+
+	req := api.CreateRuleRequest{Rule: &api.Rule{Pattern: "x"}}
+	rsp, err := client.CreateRule(context.Background(), &req)
+
+	r.Nil(rsp)
+	a.EqualError(
+		err,
+		"rpc error: code = InvalidArgument desc = invalid argument: rule field is unknown",
+	)
+}
+
+func TestListRulesOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	createTime := mustTimeVV(t, "2024-10-23T09:00:00Z")
+	ds.EXPECT().
+		ListRules(gomock.Any()).
+		Return(
+			[]*entity.Rule{
+				{
+					ID:         1,
+					Field:      entity.RuleFieldURL,
+					MatchType:  entity.RuleMatchSubstring,
+					Pattern:    "/ads/",
+					Action:     entity.RuleActionDrop,
+					CreateTime: createTime,
+				},
+			},
+			nil,
+		)
+
+	rsp, err := client.ListRules(context.Background(), &api.ListRulesRequest{})
+	r.NoError(err)
+
+	a.True(
+		proto.Equal(
+			&api.ListRulesResponse{
+				Rules: []*api.Rule{
+					{
+						Id:         1,
+						Field:      api.Rule_FIELD_URL,
+						MatchType:  api.Rule_MATCH_TYPE_SUBSTRING,
+						Pattern:    "/ads/",
+						Action:     api.Rule_ACTION_DROP,
+						CreateTime: timestamppb.New(createTime),
+					},
+				},
+			},
+			rsp,
+		),
+	)
+}
+
+func TestDeleteRulesErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		DeleteRules(gomock.Any(), []entity.ID{3}).
+		Return(fmt.Errorf("wrapped: %w", entity.RuleNotFoundError{ID: 3}))
+
+	req := api.DeleteRulesRequest{RuleIds: []uint32{3}}
+	rsp, err := client.DeleteRules(context.Background(), &req)
+
+	r.Nil(rsp)
+	a.EqualError(err, "rpc error: code = NotFound desc = rule with ID=3 not found")
+}
+
+func TestMatchRuleOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		MatchRule(
+			gomock.Any(),
+			&entity.Rule{
+				Field:     entity.RuleFieldAuthor,
+				MatchType: entity.RuleMatchSubstring,
+				Pattern:   "bot",
+				Action:    entity.RuleActionMarkRead,
+			},
+		).
+		Return([]*entity.Entry{{ID: 7, FeedID: 2, Title: "Digest", Tags: []string{"auto"}}}, nil)
+
+	req := api.MatchRuleRequest{
+		Rule: &api.Rule{
+			Field:     api.Rule_FIELD_AUTHOR,
+			MatchType: api.Rule_MATCH_TYPE_SUBSTRING,
+			Pattern:   "bot",
+			Action:    api.Rule_ACTION_MARK_READ,
+		},
+	}
+	rsp, err := client.MatchRule(context.Background(), &req)
+	r.NoError(err)
+
+	a.True(
+		proto.Equal(
+			&api.MatchRuleResponse{
+				Entries: []*api.Entry{{Id: 7, FeedId: 2, Title: "Digest", Tags: []string{"auto"}}},
+			},
+			rsp,
+		),
+	)
+}
+
 func TestPullFeedsAllOk(t *testing.T) {
 	t.Parallel()
 
@@ -274,7 +755,7 @@ func TestPullFeedsAllOk(t *testing.T) {
 	}()
 
 	ds.EXPECT().
-		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil).
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
 		Return(ch)
 
 	req := api.PullFeedsRequest{}
@@ -284,7 +765,7 @@ func TestPullFeedsAllOk(t *testing.T) {
 	var (
 		rsp       *api.PullFeedsResponse
 		errStream error
-		rsps      = make([]*api.PullFeedsResponse, 2)
+		rsps      = make([]*api.PullFeedsResponse, 3)
 	)
 
 	for i := 0; i < len(rsps); i++ {
@@ -307,11 +788,19 @@ func TestPullFeedsAllOk(t *testing.T) {
 	r.NotNil(rsp0.Feed)
 	a.Len(rsp0.GetFeed().GetEntries(), 2)
 
+	a.Equal(api.PullFeedsResponse_PULL_STATUS_SUCCESS, rsp0.GetStatus())
+
 	rsp1 := rsps[1]
 	r.Equal(prs[2].URL(), rsp1.GetUrl())
 	r.Nil(rsp1.Error)
 	r.NotNil(rsp0.Feed)
 	a.Len(rsp1.GetFeed().GetEntries(), 1)
+
+	rsp2 := rsps[2]
+	r.Equal(prs[1].URL(), rsp2.GetUrl())
+	r.Nil(rsp2.Error)
+	a.Nil(rsp2.Feed)
+	a.Equal(api.PullFeedsResponse_PULL_STATUS_SUCCESS, rsp2.GetStatus())
 }
 
 func TestPullFeedsSelectedAllOk(t *testing.T) {
@@ -355,7 +844,7 @@ func TestPullFeedsSelectedAllOk(t *testing.T) {
 	}()
 
 	ds.EXPECT().
-		PullFeeds(gomock.Any(), []entity.ID{2, 3}, gomock.Any(), gomock.Any(), nil).
+		PullFeeds(gomock.Any(), []entity.ID{2, 3}, gomock.Any(), gomock.Any(), nil, false).
 		Return(ch)
 
 	req := api.PullFeedsRequest{FeedIds: []uint32{2, 3}}
@@ -365,7 +854,7 @@ func TestPullFeedsSelectedAllOk(t *testing.T) {
 	var (
 		rsp       *api.PullFeedsResponse
 		errStream error
-		rsps      = make([]*api.PullFeedsResponse, 1)
+		rsps      = make([]*api.PullFeedsResponse, 2)
 	)
 
 	for i := 0; i < len(rsps); i++ {
@@ -387,6 +876,71 @@ func TestPullFeedsSelectedAllOk(t *testing.T) {
 	r.Nil(rsp0.Error)
 	r.NotNil(rsp0.Feed)
 	a.Len(rsp0.GetFeed().GetEntries(), 1)
+
+	rsp1 := rsps[1]
+	r.Equal(prs[0].URL(), rsp1.GetUrl())
+	a.Nil(rsp1.Feed)
+}
+
+func TestPullFeedsOkMoved(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	moved := entity.NewPullResultNotModified(pointer("http://a.com/feed.xml"))
+	moved.SetNewURL("https://a.com/feed.xml")
+	unchanged := entity.NewPullResultNotModified(pointer("http://b.com/feed.xml"))
+
+	ds.EXPECT().
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, true).
+		Return(pullResultCh(moved, unchanged))
+
+	stream, err := client.PullFeeds(context.Background(), &api.PullFeedsRequest{Force: true})
+	r.NoError(err)
+
+	rsp, err := stream.Recv()
+	r.NoError(err)
+	a.Equal("http://a.com/feed.xml", rsp.GetUrl())
+	a.Equal("https://a.com/feed.xml", rsp.GetNewUrl())
+	a.Nil(rsp.Feed)
+	a.Equal(api.PullFeedsResponse_PULL_STATUS_NOT_MODIFIED, rsp.GetStatus())
+
+	rsp, err = stream.Recv()
+	r.NoError(err)
+	a.Equal("http://b.com/feed.xml", rsp.GetUrl())
+	a.Nil(rsp.NewUrl)
+	a.Equal(api.PullFeedsResponse_PULL_STATUS_NOT_MODIFIED, rsp.GetStatus())
+
+	rsp, err = stream.Recv()
+	a.ErrorIs(err, io.EOF)
+	a.Nil(rsp)
+}
+
+func TestPullFeedsOkSkipped(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
+		Return(pullResultCh(entity.NewPullResultSkipped(pointer("http://a.com/feed.xml"))))
+
+	stream, err := client.PullFeeds(context.Background(), &api.PullFeedsRequest{})
+	r.NoError(err)
+
+	rsp, err := stream.Recv()
+	r.NoError(err)
+	a.Equal("http://a.com/feed.xml", rsp.GetUrl())
+	a.Nil(rsp.Error)
+	a.Equal(api.PullFeedsResponse_PULL_STATUS_SKIPPED, rsp.GetStatus())
+
+	rsp, err = stream.Recv()
+	a.ErrorIs(err, io.EOF)
+	a.Nil(rsp)
 }
 
 func TestPullFeedsErrSomeFeed(t *testing.T) {
@@ -445,7 +999,7 @@ func TestPullFeedsErrSomeFeed(t *testing.T) {
 	}()
 
 	ds.EXPECT().
-		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil).
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
 		Return(ch)
 
 	req := api.PullFeedsRequest{}
@@ -455,7 +1009,7 @@ func TestPullFeedsErrSomeFeed(t *testing.T) {
 	var (
 		rsp       *api.PullFeedsResponse
 		errStream error
-		rsps      = make([]*api.PullFeedsResponse, 3)
+		rsps      = make([]*api.PullFeedsResponse, 4)
 	)
 
 	for i := 0; i < len(rsps); i++ {
@@ -485,6 +1039,12 @@ func TestPullFeedsErrSomeFeed(t *testing.T) {
 	r.Equal(prs[1].URL(), rsp2.GetUrl())
 	a.Nil(rsp2.GetFeed())
 	a.Equal("timed out", rsp2.GetError())
+	a.Equal(api.PullFeedsResponse_PULL_STATUS_FAIL, rsp2.GetStatus())
+
+	rsp3 := rsps[3]
+	r.Equal(prs[2].URL(), rsp3.GetUrl())
+	a.Nil(rsp3.GetFeed())
+	a.Nil(rsp3.Error)
 }
 
 func TestPullFeedsErrNonFeed(t *testing.T) {
@@ -543,7 +1103,7 @@ func TestPullFeedsErrNonFeed(t *testing.T) {
 	}()
 
 	ds.EXPECT().
-		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil).
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
 		Return(ch)
 
 	req := api.PullFeedsRequest{}
@@ -568,48 +1128,173 @@ func TestPullFeedsErrNonFeed(t *testing.T) {
 		rsps = append(rsps, rsp)
 	}
 
-	a.LessOrEqual(len(rsps), 3)
-	a.EqualError(err, "rpc error: code = Unknown desc = tx error")
+	a.LessOrEqual(len(rsps), 3)
+	a.EqualError(err, "rpc error: code = Unknown desc = tx error")
+}
+
+func TestListEntriesOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	req := api.ListEntriesRequest{FeedIds: []entity.ID{2}, IsBookmarked: pointer(true)}
+	entries := []*entity.Entry{
+		{
+			Title:        "Entry 1",
+			IsRead:       false,
+			IsBookmarked: true,
+			Content:      pointer("Contents 1."),
+		},
+		{
+			Title:        "Entry 2",
+			IsRead:       false,
+			IsBookmarked: true,
+			Content:      pointer("Contents 2."),
+		},
+		{
+			Title:        "Entry 3",
+			IsRead:       true,
+			IsBookmarked: true,
+			Content:      pointer("Contents 3."),
+		},
+	}
+
+	ds.EXPECT().
+		ListEntries(
+			gomock.Any(),
+			&entity.EntryFilter{FeedIDs: req.GetFeedIds(), IsBookmarked: req.IsBookmarked},
+			entity.EntrySortUpdateTimeDesc,
+			&entity.Page{},
+		).
+		Return(entries, "", nil)
+
+	rsp, err := client.ListEntries(context.Background(), &req)
+	r.NoError(err)
+
+	// TODO: Expand test.
+	a.Len(rsp.GetEntries(), 3)
+	a.Empty(rsp.GetNextPageToken())
+}
+
+func TestListEntriesOkPaginatedFiltered(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	req := api.ListEntriesRequest{
+		FeedTags:       []string{"news"},
+		IsRead:         pointer(false),
+		IsFeedStarred:  pointer(true),
+		PublishedAfter: timestamppb.New(mustTimeVV(t, "2024-01-01T00:00:00Z")),
+		UpdatedBefore:  timestamppb.New(mustTimeVV(t, "2024-02-01T00:00:00Z")),
+		SortOrder:      api.ListEntriesRequest_SORT_ORDER_PUB_TIME_ASC,
+		PageSize:       2,
+		PageToken:      "abc",
+	}
+
+	ds.EXPECT().
+		ListEntries(
+			gomock.Any(),
+			&entity.EntryFilter{
+				FeedTags:       []string{"news"},
+				IsRead:         pointer(false),
+				IsFeedStarred:  pointer(true),
+				PublishedAfter: pointer(mustTimeVV(t, "2024-01-01T00:00:00Z")),
+				UpdatedBefore:  pointer(mustTimeVV(t, "2024-02-01T00:00:00Z")),
+			},
+			entity.EntrySortPubTimeAsc,
+			&entity.Page{Size: 2, Token: "abc"},
+		).
+		Return([]*entity.Entry{{ID: 5}, {ID: 8}}, "def", nil)
+
+	rsp, err := client.ListEntries(context.Background(), &req)
+	r.NoError(err)
+
+	r.Len(rsp.GetEntries(), 2)
+	a.Equal("def", rsp.GetNextPageToken())
 }
 
-func TestListEntriesOk(t *testing.T) {
+func TestListEntriesOkMetadata(t *testing.T) {
 	t.Parallel()
 
 	r := require.New(t)
 	a := assert.New(t)
 	client, ds := setupServerTest(t)
 
-	req := api.ListEntriesRequest{FeedIds: []entity.ID{2}, IsBookmarked: pointer(true)}
-	entries := []*entity.Entry{
-		{
-			Title:        "Entry 1",
-			IsRead:       false,
-			IsBookmarked: true,
-			Content:      pointer("Contents 1."),
-		},
-		{
-			Title:        "Entry 2",
-			IsRead:       false,
-			IsBookmarked: true,
-			Content:      pointer("Contents 2."),
-		},
-		{
-			Title:        "Entry 3",
-			IsRead:       true,
-			IsBookmarked: true,
-			Content:      pointer("Contents 3."),
-		},
+	req := api.ListEntriesRequest{
+		Authors:       []string{"Jane Doe"},
+		Tags:          []string{"podcast"},
+		HasEnclosures: pointer(true),
 	}
 
 	ds.EXPECT().
-		ListEntries(gomock.Any(), req.GetFeedIds(), req.IsBookmarked).
-		Return(entries, nil)
+		ListEntries(
+			gomock.Any(),
+			&entity.EntryFilter{
+				Authors:       []string{"Jane Doe"},
+				Tags:          []string{"podcast"},
+				HasEnclosures: pointer(true),
+			},
+			entity.EntrySortUpdateTimeDesc,
+			&entity.Page{},
+		).
+		Return(
+			[]*entity.Entry{
+				{
+					ID:      3,
+					Title:   "Episode 1",
+					Authors: []string{"Jane Doe"},
+					Tags:    []string{"podcast"},
+					Enclosures: []*entity.Enclosure{
+						{URL: "https://a.com/1.mp3", Type: "audio/mpeg", Length: 1024},
+					},
+				},
+			},
+			"",
+			nil,
+		)
 
 	rsp, err := client.ListEntries(context.Background(), &req)
 	r.NoError(err)
 
-	// TODO: Expand test.
-	a.Len(rsp.GetEntries(), 3)
+	a.True(
+		proto.Equal(
+			&api.ListEntriesResponse{
+				Entries: []*api.Entry{
+					{
+						Id:      3,
+						Title:   "Episode 1",
+						Authors: []string{"Jane Doe"},
+						Tags:    []string{"podcast"},
+						Enclosures: []*api.Entry_Enclosure{
+							{Url: "https://a.com/1.mp3", Type: "audio/mpeg", Length: 1024},
+						},
+					},
+				},
+			},
+			rsp,
+		),
+	)
+}
+
+func TestListEntriesErrPageToken(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		ListEntries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, "", entity.InvalidArgumentError{Reason: "invalid page token"})
+
+	rsp, err := client.ListEntries(context.Background(), &api.ListEntriesRequest{PageToken: "x"})
+	r.Nil(rsp)
+	a.EqualError(err, "rpc error: code = InvalidArgument desc = invalid argument: invalid page token")
 }
 
 func TestEditEntriesOk(t *testing.T) {
@@ -663,51 +1348,107 @@ func TestEditEntriesOk(t *testing.T) {
 	a.Equal(entries[1].IsBookmarked, entry1.IsBookmarked)
 }
 
-func TestStreamEntriesAllOk(t *testing.T) {
+func TestStreamEntriesPullOk(t *testing.T) {
 	t.Parallel()
 
 	r := require.New(t)
 	a := assert.New(t)
 	client, ds := setupServerTest(t)
 
-	req := api.StreamEntriesRequest{FeedId: uint32(8)}
-	entries := []*entity.Entry{
-		{Title: "Entry 1"},
-		{Title: "Entry 2"},
-		{Title: "Entry 3"},
-	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req := api.StreamEntriesRequest{FeedIds: []uint32{8}, UnreadOnly: true}
+	stream, err := client.StreamEntries(ctx, &req)
+	r.NoError(err)
+	_, err = stream.Header()
+	r.NoError(err)
+
+	pr8 := entity.NewPullResultFromFeed(pointer("https://a.com/feed.xml"), &entity.Feed{ID: 8})
+	pr8.SetChangedEntries([]*entity.Entry{
+		{ID: 1, FeedID: 8, Title: "Entry 1"},
+		{ID: 2, FeedID: 8, Title: "Entry 2", IsRead: true},
+		{ID: 3, FeedID: 8, Title: "Entry 3"},
+	})
+	pr9 := entity.NewPullResultFromFeed(pointer("https://b.com/feed.xml"), &entity.Feed{ID: 9})
+	pr9.SetChangedEntries([]*entity.Entry{{ID: 4, FeedID: 9, Title: "Entry 4"}})
+
+	ch := make(chan entity.PullResult, 2)
+	ch <- pr8
+	ch <- pr9
+	close(ch)
 
 	ds.EXPECT().
-		ListEntries(gomock.Any(), []entity.ID{8}, nil).
-		Return(entries, nil)
+		PullFeeds(gomock.Any(), []entity.ID{}, gomock.Any(), gomock.Any(), nil, false).
+		Return(ch)
 
-	stream, err := client.StreamEntries(context.Background(), &req)
+	pstream, err := client.PullFeeds(context.Background(), &api.PullFeedsRequest{})
 	r.NoError(err)
-
-	var (
-		rsp       *api.StreamEntriesResponse
-		errStream error
-		rsps      = make([]*api.StreamEntriesResponse, 3)
-	)
-	for i := 0; i < len(rsps); i++ {
-		rsp, errStream = stream.Recv()
-		a.NoError(errStream)
-		a.NotNil(rsp)
-		rsps[i] = rsp
+	for {
+		if _, perr := pstream.Recv(); perr != nil {
+			r.ErrorIs(perr, io.EOF)
+			break
+		}
 	}
 
-	rsp, errStream = stream.Recv()
-	a.ErrorIs(errStream, io.EOF)
+	rsp, err := stream.Recv()
+	r.NoError(err)
+	a.Equal("Entry 1", rsp.GetEntry().GetTitle())
+
+	rsp, err = stream.Recv()
+	r.NoError(err)
+	a.Equal("Entry 3", rsp.GetEntry().GetTitle())
+
+	cancel()
+	rsp, err = stream.Recv()
 	a.Nil(rsp)
+	a.Equal(codes.Canceled, status.Code(err))
+}
 
-	// Sort responses so tests are insensitive to input order.
-	sort.SliceStable(rsps, func(i, j int) bool {
-		return rsps[i].GetEntry().GetTitle() < rsps[j].GetEntry().GetTitle()
-	})
+func TestStreamEntriesAddFeedOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req := api.StreamEntriesRequest{Tags: []string{"news"}}
+	stream, err := client.StreamEntries(ctx, &req)
+	r.NoError(err)
+	_, err = stream.Header()
+	r.NoError(err)
+
+	ds.EXPECT().
+		AddFeed(gomock.Any(), "https://a.com/feed.xml", nil, nil, nil, nil, nil).
+		Return(
+			&entity.Feed{ID: 2, Tags: []string{"news"}, Entries: map[entity.ID]*entity.Entry{
+				5: {ID: 5, FeedID: 2, Title: "Entry 5"},
+			}},
+			true,
+			nil,
+		)
+	ds.EXPECT().
+		AddFeed(gomock.Any(), "https://b.com/feed.xml", nil, nil, nil, nil, nil).
+		Return(
+			&entity.Feed{ID: 3, Tags: []string{"misc"}, Entries: map[entity.ID]*entity.Entry{
+				6: {ID: 6, FeedID: 3, Title: "Entry 6"},
+			}},
+			true,
+			nil,
+		)
+
+	_, err = client.AddFeed(context.Background(), &api.AddFeedRequest{Url: "https://b.com/feed.xml"})
+	r.NoError(err)
+	_, err = client.AddFeed(context.Background(), &api.AddFeedRequest{Url: "https://a.com/feed.xml"})
+	r.NoError(err)
 
-	a.Equal("Entry 1", rsps[0].GetEntry().GetTitle())
-	a.Equal("Entry 2", rsps[1].GetEntry().GetTitle())
-	a.Equal("Entry 3", rsps[2].GetEntry().GetTitle())
+	rsp, err := stream.Recv()
+	r.NoError(err)
+	a.Equal(uint32(5), rsp.GetEntry().GetId())
+	a.Equal("Entry 5", rsp.GetEntry().GetTitle())
 }
 
 func TestGetEntryOk(t *testing.T) {
@@ -753,6 +1494,250 @@ func TestGetEntryOk(t *testing.T) {
 	// TODO: Also test timestamps.
 }
 
+func TestFetchEntryContentOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+
+	client, ds := setupServerTest(t)
+
+	entry := entity.Entry{
+		ID:      2,
+		FeedID:  3,
+		Title:   "Test Feed Entry",
+		Content: pointer("<p>Full article</p>"),
+		URL:     pointer("http://x.com/posts/test-feed-entry.html"),
+	}
+
+	ds.EXPECT().
+		FetchEntryContent(gomock.Any(), entity.ID(2), nil).
+		Return(&entry, nil)
+
+	req := api.FetchEntryContentRequest{Id: 2}
+
+	rsp, err := client.FetchEntryContent(context.Background(), &req)
+	r.NoError(err)
+
+	r.NotNil(rsp)
+	r.NotNil(rsp.Entry)
+	a.Equal(entry.ID, rsp.Entry.Id)
+	a.Equal(*entry.Content, rsp.Entry.GetContent())
+}
+
+func TestFetchEntryContentErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		FetchEntryContent(gomock.Any(), entity.ID(2), nil).
+		Return(nil, entity.EntryNotFoundError{ID: 2})
+
+	req := api.FetchEntryContentRequest{Id: 2}
+
+	rsp, err := client.FetchEntryContent(context.Background(), &req)
+	r.Nil(rsp)
+	r.Error(err)
+	a.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestSearchEntriesOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	results := []*entity.SearchResult{
+		{
+			Entry:          &entity.Entry{ID: 5, FeedID: 2, Title: "Tomatoes everywhere"},
+			TitleHighlight: "\x02Tomatoes\x03 everywhere",
+			Snippet:        "More \x02tomatoes\x03.",
+			Score:          3.5,
+		},
+		{
+			Entry:          &entity.Entry{ID: 1, FeedID: 3, Title: "Gardening"},
+			TitleHighlight: "Gardening",
+			Snippet:        "How to grow \x02tomatoes\x03.",
+			Score:          1.25,
+		},
+	}
+	ds.EXPECT().
+		SearchEntries(gomock.Any(), "tomato*", []entity.ID{2, 3}, pointer(uint32(10))).
+		Return(results, nil)
+
+	req := api.SearchEntriesRequest{
+		Query:      "tomato*",
+		FeedIds:    []uint32{2, 3},
+		MaxResults: pointer(uint32(10)),
+	}
+	rsp, err := client.SearchEntries(context.Background(), &req)
+	r.NoError(err)
+
+	r.Len(rsp.GetResults(), 2)
+	res0 := rsp.GetResults()[0]
+	a.Equal(uint32(5), res0.GetEntry().GetId())
+	a.Equal("\x02Tomatoes\x03 everywhere", res0.GetTitleHighlight())
+	a.Equal("More \x02tomatoes\x03.", res0.GetSnippet())
+	a.Equal(3.5, res0.GetScore())
+	a.Equal(uint32(1), rsp.GetResults()[1].GetEntry().GetId())
+}
+
+func TestSearchEntriesErrEmptyQuery(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		SearchEntries(gomock.Any(), "", nil, nil).
+		Return(nil, fmt.Errorf("wrapped: %w", entity.InvalidArgumentError{Reason: "search query is empty"}))
+
+	rsp, err := client.SearchEntries(context.Background(), &api.SearchEntriesRequest{})
+
+	r.Nil(rsp)
+	a.EqualError(
+		err,
+		"rpc error: code = InvalidArgument desc = invalid argument: search query is empty",
+	)
+}
+
+func TestPruneEntriesOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	results := []*entity.PruneResult{
+		{FeedID: 2, FeedTitle: "Feed B", NumPruned: 5, NumKept: 10},
+		{FeedID: 3, FeedTitle: "Feed C", NumPruned: 0, NumKept: 2},
+	}
+	ds.EXPECT().
+		PruneEntries(gomock.Any(), []entity.ID{2, 3}, true).
+		Return(results, nil)
+
+	req := api.PruneEntriesRequest{FeedIds: []uint32{2, 3}, DryRun: true}
+	rsp, err := client.PruneEntries(context.Background(), &req)
+	r.NoError(err)
+
+	r.Len(rsp.GetResults(), 2)
+	a.Equal(results, entity.FromPruneResultPbs(rsp.GetResults()))
+}
+
+func TestPruneEntriesErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		PruneEntries(gomock.Any(), []entity.ID{7}, false).
+		Return(nil, fmt.Errorf("wrapped: %w", entity.FeedNotFoundError{ID: 7}))
+
+	req := api.PruneEntriesRequest{FeedIds: []uint32{7}}
+	rsp, err := client.PruneEntries(context.Background(), &req)
+
+	r.Nil(rsp)
+	a.EqualError(err, "rpc error: code = NotFound desc = feed with ID=7 not found")
+}
+
+func TestListRetentionPoliciesOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	maxAge := 720 * time.Hour
+	policies := []*entity.RetentionPolicy{
+		{MaxAge: &maxAge, KeepBookmarked: true},
+		{FeedID: pointer(entity.ID(2)), MaxEntries: pointer(uint32(10)), KeepUnread: true},
+	}
+	ds.EXPECT().
+		ListRetentionPolicies(gomock.Any()).
+		Return(policies, nil)
+
+	req := api.ListRetentionPoliciesRequest{}
+	rsp, err := client.ListRetentionPolicies(context.Background(), &req)
+	r.NoError(err)
+
+	r.Len(rsp.GetPolicies(), 2)
+	a.Equal(policies, entity.FromRetentionPolicyPbs(rsp.GetPolicies()))
+}
+
+func TestSetRetentionPolicyOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	maxAge := 48 * time.Hour
+	policy := entity.RetentionPolicy{FeedID: pointer(entity.ID(3)), MaxAge: &maxAge}
+	ds.EXPECT().
+		SetRetentionPolicy(gomock.Any(), &policy).
+		Return(nil)
+
+	req := api.SetRetentionPolicyRequest{Policy: entity.ToRetentionPolicyPb(&policy)}
+	_, err := client.SetRetentionPolicy(context.Background(), &req)
+	r.NoError(err)
+}
+
+func TestSetRetentionPolicyErrInvalidArgument(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		SetRetentionPolicy(gomock.Any(), nil).
+		Return(entity.InvalidArgumentError{Reason: "retention policy is missing"})
+
+	req := api.SetRetentionPolicyRequest{}
+	_, err := client.SetRetentionPolicy(context.Background(), &req)
+
+	a.EqualError(err,
+		"rpc error: code = InvalidArgument desc = invalid argument: retention policy is missing",
+	)
+}
+
+func TestDeleteRetentionPolicyOk(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		DeleteRetentionPolicy(gomock.Any(), pointer(entity.ID(5))).
+		Return(nil)
+
+	req := api.DeleteRetentionPolicyRequest{FeedId: pointer(uint32(5))}
+	_, err := client.DeleteRetentionPolicy(context.Background(), &req)
+	r.NoError(err)
+}
+
+func TestDeleteRetentionPolicyErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	client, ds := setupServerTest(t)
+
+	ds.EXPECT().
+		DeleteRetentionPolicy(gomock.Any(), pointer(entity.ID(5))).
+		Return(fmt.Errorf("wrapped: %w", entity.FeedNotFoundError{ID: 5}))
+
+	req := api.DeleteRetentionPolicyRequest{FeedId: pointer(uint32(5))}
+	_, err := client.DeleteRetentionPolicy(context.Background(), &req)
+
+	a.EqualError(err, "rpc error: code = NotFound desc = feed with ID=5 not found")
+}
+
 func TestExportOPMLOk(t *testing.T) {
 	t.Parallel()
 
@@ -911,8 +1896,6 @@ func TestGetInfoOk(t *testing.T) {
 	a.Equal(want.GitCommit, rsp.GitCommit)
 }
 
-func pointer[T any](value T) *T { return &value }
-
 func mustTimeVV(t *testing.T, v string) time.Time {
 	t.Helper()
 	pv, err := time.Parse(time.RFC3339, v)
@@ -0,0 +1,153 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package tlstest provides certificates generated at test time for TLS tests.
+package tlstest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// CA is a self-signed certificate authority.
+type CA struct {
+	t    *testing.T
+	dir  string
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	// CertFile is the path to the PEM-encoded CA certificate.
+	CertFile string
+}
+
+// KeyPair contains the paths to a PEM-encoded certificate and its key.
+type KeyPair struct {
+	CertFile string
+	KeyFile  string
+}
+
+// NewCA creates a new CA whose files are written into a temporary directory.
+func NewCA(t *testing.T, name string) *CA {
+	t.Helper()
+
+	key := newKey(t)
+	tmpl := x509.Certificate{
+		SerialNumber:          newSerial(t),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create CA certificate: %s", err)
+	}
+	cert, err := x509.ParseCertificate(raw)
+	if err != nil {
+		t.Fatalf("parse CA certificate: %s", err)
+	}
+
+	dir := t.TempDir()
+	ca := CA{t: t, dir: dir, cert: cert, key: key}
+	ca.CertFile = writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", raw)
+
+	return &ca
+}
+
+// ServerKeyPair creates a server certificate signed by the CA, valid for the given host names
+// and IP addresses.
+func (ca *CA) ServerKeyPair(name string, hosts ...string) KeyPair {
+	ca.t.Helper()
+
+	tmpl := x509.Certificate{
+		Subject:     pkix.Name{CommonName: name},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, host)
+		}
+	}
+
+	return ca.keyPair(name, &tmpl)
+}
+
+// ClientKeyPair creates a client certificate signed by the CA.
+func (ca *CA) ClientKeyPair(name string) KeyPair {
+	ca.t.Helper()
+
+	tmpl := x509.Certificate{
+		Subject:     pkix.Name{CommonName: name},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	return ca.keyPair(name, &tmpl)
+}
+
+func (ca *CA) keyPair(name string, tmpl *x509.Certificate) KeyPair {
+	t := ca.t
+	t.Helper()
+
+	tmpl.SerialNumber = newSerial(t)
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+
+	key := newKey(t)
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("create certificate: %s", err)
+	}
+	rawKey, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %s", err)
+	}
+
+	return KeyPair{
+		CertFile: writePEM(t, filepath.Join(ca.dir, name+".crt"), "CERTIFICATE", raw),
+		KeyFile:  writePEM(t, filepath.Join(ca.dir, name+".key"), "EC PRIVATE KEY", rawKey),
+	}
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %s", err)
+	}
+	return key
+}
+
+func newSerial(t *testing.T) *big.Int {
+	t.Helper()
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 62))
+	if err != nil {
+		t.Fatalf("generate serial number: %s", err)
+	}
+	return serial
+}
+
+func writePEM(t *testing.T, path string, blockType string, raw []byte) string {
+	t.Helper()
+
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: raw})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write %s: %s", path, err)
+	}
+	return path
+}
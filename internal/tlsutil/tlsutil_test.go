@@ -0,0 +1,112 @@
+// Copyright (c) 2024 Wibowo Arindrarto <contact@arindrarto.dev>
+// SPDX-License-Identifier: BSD-3-Clause
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bow/neon/internal/tlsutil/tlstest"
+)
+
+func TestServerConfigOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	ca := tlstest.NewCA(t, "ca")
+	kp := ca.ServerKeyPair("server", "localhost", "127.0.0.1")
+
+	cfg, err := ServerConfig(kp.CertFile, kp.KeyFile, "")
+	r.NoError(err)
+	a.Len(cfg.Certificates, 1)
+	a.Equal(tls.NoClientCert, cfg.ClientAuth)
+	a.Nil(cfg.ClientCAs)
+
+	cfg, err = ServerConfig(kp.CertFile, kp.KeyFile, ca.CertFile)
+	r.NoError(err)
+	a.Equal(tls.RequireAndVerifyClientCert, cfg.ClientAuth)
+	a.NotNil(cfg.ClientCAs)
+}
+
+func TestServerConfigErr(t *testing.T) {
+	t.Parallel()
+
+	ca := tlstest.NewCA(t, "ca")
+	kp := ca.ServerKeyPair("server", "localhost")
+
+	notPEM := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0o600))
+
+	tests := []struct {
+		name                        string
+		certFile, keyFile, clientCA string
+		wantErr                     string
+	}{
+		{
+			name:     "no key",
+			certFile: kp.CertFile,
+			wantErr:  "TLS certificate and key must both be specified",
+		},
+		{
+			name:     "mismatched key",
+			certFile: kp.CertFile,
+			keyFile:  ca.CertFile,
+			wantErr:  "load server certificate: ",
+		},
+		{
+			name:     "invalid client CA",
+			certFile: kp.CertFile,
+			keyFile:  kp.KeyFile,
+			clientCA: notPEM,
+			wantErr:  "load CA certificates: no certificates found in " + notPEM,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cfg, err := ServerConfig(test.certFile, test.keyFile, test.clientCA)
+			assert.Nil(t, cfg)
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func TestClientConfigOk(t *testing.T) {
+	t.Parallel()
+
+	a := assert.New(t)
+	r := require.New(t)
+
+	ca := tlstest.NewCA(t, "ca")
+	kp := ca.ClientKeyPair("client")
+
+	cfg, err := ClientConfig("", "", "", "")
+	r.NoError(err)
+	a.Nil(cfg.RootCAs)
+	a.Empty(cfg.Certificates)
+
+	cfg, err = ClientConfig(ca.CertFile, kp.CertFile, kp.KeyFile, "neon.local")
+	r.NoError(err)
+	a.NotNil(cfg.RootCAs)
+	a.Len(cfg.Certificates, 1)
+	a.Equal("neon.local", cfg.ServerName)
+}
+
+func TestClientConfigErrCertWithoutKey(t *testing.T) {
+	t.Parallel()
+
+	kp := tlstest.NewCA(t, "ca").ClientKeyPair("client")
+
+	cfg, err := ClientConfig("", kp.CertFile, "", "")
+	assert.Nil(t, cfg)
+	assert.EqualError(t, err, "TLS client certificate and key must both be specified")
+}